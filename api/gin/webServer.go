@@ -19,23 +19,29 @@ const (
 
 // ArgsWebServer holds the arguments needed for a webServer
 type ArgsWebServer struct {
-	Facade    shared.FacadeHandler
-	ApiConfig config.ApiRoutesConfig
+	Facade         shared.FacadeHandler
+	LiveFeed       shared.LiveFeedHandler
+	PayloadsReader shared.PayloadsReaderHandler
+	ApiConfig      config.ApiRoutesConfig
 }
 
 type webServer struct {
 	sync.RWMutex
-	facade     shared.FacadeHandler
-	apiConfig  config.ApiRoutesConfig
-	groups     map[string]shared.GroupHandler
-	httpServer shared.HttpServerCloser
+	facade         shared.FacadeHandler
+	liveFeed       shared.LiveFeedHandler
+	payloadsReader shared.PayloadsReaderHandler
+	apiConfig      config.ApiRoutesConfig
+	groups         map[string]shared.GroupHandler
+	httpServer     shared.HttpServerCloser
 }
 
 // NewWebServer will create a new instance of the webServer
 func NewWebServer(args ArgsWebServer) (*webServer, error) {
 	return &webServer{
-		facade:    args.Facade,
-		apiConfig: args.ApiConfig,
+		facade:         args.Facade,
+		liveFeed:       args.LiveFeed,
+		payloadsReader: args.PayloadsReader,
+		apiConfig:      args.ApiConfig,
 	}, nil
 }
 
@@ -91,6 +97,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["status"] = statusGroup
 
+	feedGroup, err := groups.NewFeedGroup(ws.liveFeed)
+	if err != nil {
+		return err
+	}
+	groupsMap["feed"] = feedGroup
+
+	payloadsGroup, err := groups.NewPayloadsGroup(ws.payloadsReader)
+	if err != nil {
+		return err
+	}
+	groupsMap["payloads"] = payloadsGroup
+
 	ws.groups = groupsMap
 
 	return nil
@@ -0,0 +1,128 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/api/shared"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+const (
+	subscribePath = "/subscribe"
+
+	addressParam    = "address"
+	tokenParam      = "token"
+	identifierParam = "identifier"
+	shardParam      = "shard"
+	pipelineParam   = "pipeline"
+)
+
+type feedGroup struct {
+	*baseGroup
+	liveFeed shared.LiveFeedHandler
+	upgrader websocket.Upgrader
+}
+
+// NewFeedGroup returns a new instance of feed group
+func NewFeedGroup(liveFeed shared.LiveFeedHandler) (*feedGroup, error) {
+	if check.IfNil(liveFeed) {
+		return nil, fmt.Errorf("%w for feed group", core.ErrNilLiveFeedHandler)
+	}
+
+	fg := &feedGroup{
+		liveFeed:  liveFeed,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			// the origins are not restricted, the same as for the rest of the web server
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    subscribePath,
+			Handler: fg.subscribe,
+			Method:  http.MethodGet,
+		},
+	}
+	fg.endpoints = endpoints
+
+	return fg, nil
+}
+
+// subscribe will upgrade the connection to websocket and will register it in the live feed
+func (fg *feedGroup) subscribe(c *gin.Context) {
+	filter, err := parseFeedFilter(c)
+	if err != nil {
+		returnStatus(c, nil, http.StatusBadRequest, err.Error(), "bad_request")
+		return
+	}
+
+	conn, err := fg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("cannot upgrade live feed connection", "error", err)
+		return
+	}
+
+	err = fg.liveFeed.AddSubscriber(conn, filter)
+	if err != nil {
+		log.Debug("cannot add live feed subscriber", "error", err)
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()))
+		_ = conn.Close()
+	}
+}
+
+func parseFeedFilter(c *gin.Context) (*data.FeedFilter, error) {
+	filter := data.NewFeedFilter()
+	for _, address := range getQueryValues(c, addressParam) {
+		filter.Addresses[address] = struct{}{}
+	}
+	for _, token := range getQueryValues(c, tokenParam) {
+		filter.Tokens[token] = struct{}{}
+	}
+	for _, identifier := range getQueryValues(c, identifierParam) {
+		filter.Identifiers[identifier] = struct{}{}
+	}
+	for _, shard := range getQueryValues(c, shardParam) {
+		shardID, err := strconv.ParseUint(shard, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s parameter: %s", shardParam, shard)
+		}
+		filter.ShardIDs[uint32(shardID)] = struct{}{}
+	}
+	for _, pipeline := range getQueryValues(c, pipelineParam) {
+		filter.Pipelines[pipeline] = struct{}{}
+	}
+
+	return filter, nil
+}
+
+// getQueryValues accepts both repeated parameters and comma separated values
+func getQueryValues(c *gin.Context, param string) []string {
+	values := make([]string, 0)
+	for _, value := range c.QueryArray(param) {
+		for _, item := range strings.Split(value, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+
+	return values
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fg *feedGroup) IsInterfaceNil() bool {
+	return fg == nil
+}
@@ -0,0 +1,68 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/gin-gonic/gin"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/api/shared"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/payloads"
+)
+
+const (
+	hashParam   = "hash"
+	payloadPath = "/:" + hashParam
+)
+
+type payloadsGroup struct {
+	*baseGroup
+	payloadsReader shared.PayloadsReaderHandler
+}
+
+// NewPayloadsGroup returns a new instance of payloads group
+func NewPayloadsGroup(payloadsReader shared.PayloadsReaderHandler) (*payloadsGroup, error) {
+	if check.IfNil(payloadsReader) {
+		return nil, fmt.Errorf("%w for payloads group", core.ErrNilPayloadsReader)
+	}
+
+	pg := &payloadsGroup{
+		payloadsReader: payloadsReader,
+		baseGroup:      &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    payloadPath,
+			Handler: pg.getPayload,
+			Method:  http.MethodGet,
+		},
+	}
+	pg.endpoints = endpoints
+
+	return pg, nil
+}
+
+// getPayload will return the full data field with the provided hash, base64 encoded, the same as in the transactions
+func (pg *payloadsGroup) getPayload(c *gin.Context) {
+	hash := c.Param(hashParam)
+	payload, err := pg.payloadsReader.GetPayload(hash)
+	if errors.Is(err, payloads.ErrPayloadNotFound) {
+		returnStatus(c, nil, http.StatusNotFound, err.Error(), "not_found")
+		return
+	}
+	if err != nil {
+		returnStatus(c, nil, http.StatusInternalServerError, err.Error(), "internal_issue")
+		return
+	}
+
+	returnStatus(c, gin.H{"hash": hash, "data": payload, "length": len(payload)}, http.StatusOK, "", "successful")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pg *payloadsGroup) IsInterfaceNil() bool {
+	return pg == nil
+}
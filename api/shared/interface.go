@@ -2,7 +2,9 @@ package shared
 
 import (
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
 	"github.com/gin-gonic/gin"
 )
 
@@ -22,6 +24,18 @@ type FacadeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// LiveFeedHandler defines the actions needed by the API in order to register new live feed subscribers
+type LiveFeedHandler interface {
+	AddSubscriber(conn core.WSConnection, filter *data.FeedFilter) error
+	IsInterfaceNil() bool
+}
+
+// PayloadsReaderHandler defines the actions needed by the API in order to return the offloaded data fields
+type PayloadsReaderHandler interface {
+	GetPayload(hash string) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
 // HttpServerCloser defines the basic actions of starting and closing that a web server should be able to do
 type HttpServerCloser interface {
 	Start()
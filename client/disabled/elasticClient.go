@@ -32,6 +32,11 @@ func (ec *elasticClient) DoScrollRequest(_ context.Context, _ string, _ []byte,
 	return nil
 }
 
+// DoSearchRequest -
+func (ec *elasticClient) DoSearchRequest(_ context.Context, _ string, _ []byte, _ interface{}) error {
+	return nil
+}
+
 // DoCountRequest -
 func (ec *elasticClient) DoCountRequest(_ context.Context, _ string, _ []byte) (uint64, error) {
 	return 0, nil
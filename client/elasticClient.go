@@ -164,6 +164,29 @@ func (ec *elasticClient) DoMultiGet(ctx context.Context, ids []string, index str
 	return nil
 }
 
+// DoSearchRequest will do a search request to Elasticsearch server and will decode the response in the provided structure
+func (ec *elasticClient) DoSearchRequest(ctx context.Context, index string, body []byte, resBody interface{}) error {
+	res, err := ec.client.Search(
+		ec.client.Search.WithIndex(index),
+		ec.client.Search.WithBody(bytes.NewBuffer(body)),
+		ec.client.Search.WithContext(ctx),
+	)
+	if err != nil {
+		log.Warn("elasticClient.DoSearchRequest",
+			"cannot do search request no response", err.Error())
+		return err
+	}
+
+	err = parseResponse(res, &resBody, elasticDefaultErrorResponseHandler)
+	if err != nil {
+		log.Warn("elasticClient.DoSearchRequest",
+			"error parsing response", err.Error())
+		return err
+	}
+
+	return nil
+}
+
 // DoQueryRemove will do a query remove to elasticsearch server
 func (ec *elasticClient) DoQueryRemove(ctx context.Context, index string, body *bytes.Buffer) error {
 	err := ec.doRefresh(index)
@@ -76,6 +76,26 @@ func TestElasticClient_DoMultiGet(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestElasticClient_DoSearchRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accountsdcdt/_search", r.URL.Path)
+		_, _ = w.Write([]byte(`{"aggregations":{"addresses":{"buckets":[{"key":"alice","collections":{"buckets":[{"key":"NFT-abcd","doc_count":3}]}}]}}}`))
+	}))
+	defer ts.Close()
+
+	esClient, _ := NewElasticClient(elasticsearch.Config{
+		Addresses: []string{ts.URL},
+		Logger:    &logging.CustomLogger{},
+	})
+
+	res := &data.ResponseCollectionsHeld{}
+	err := esClient.DoSearchRequest(context.Background(), "accountsdcdt", []byte(`{}`), res)
+	require.Nil(t, err)
+	require.Len(t, res.Aggregations.Addresses.Buckets, 1)
+	require.Equal(t, "alice", res.Aggregations.Addresses.Buckets[0].Key)
+	require.Equal(t, uint64(3), res.Aggregations.Addresses.Buckets[0].Collections.Buckets[0].DocCount)
+}
+
 func TestElasticClient_GetWriteIndexMultipleIndicesBehind(t *testing.T) {
 	handler := http.NotFound
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,440 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+)
+
+const (
+	fieldPathSeparator = "."
+	bulkUpdateAction   = "update"
+	updateDocKey       = "doc"
+	updateUpsertKey    = "upsert"
+	updateScriptKey    = "script"
+	scriptParamsKey    = "params"
+	templateKey        = "template"
+	mappingsKey        = "mappings"
+	propertiesKey      = "properties"
+	hashedFieldType    = "keyword"
+)
+
+// FieldsProjectionRule holds the fields of the documents of an index that are dropped or replaced by their hash before
+// being indexed. A field is given by its dotted path in the document, for example "events.data" for the logs index
+type FieldsProjectionRule struct {
+	Index string
+	Drop  []string
+	Hash  []string
+}
+
+type fieldsProjection struct {
+	drop [][]string
+	hash [][]string
+}
+
+// fieldsProjectionElasticClient drops or hashes the configured fields of the documents before they are indexed. The
+// templates of the indexes are updated as well: the dropped fields are removed from the mappings and the hashed fields
+// are mapped as keywords
+type fieldsProjectionElasticClient struct {
+	elasticproc.DatabaseClientHandler
+	hasher      hashing.Hasher
+	projections map[string]*fieldsProjection
+}
+
+// NewFieldsProjectionElasticClient creates a new fields projection elastic client
+func NewFieldsProjectionElasticClient(esClient elasticproc.DatabaseClientHandler, hasher hashing.Hasher, rules []FieldsProjectionRule) (*fieldsProjectionElasticClient, error) {
+	if check.IfNil(esClient) {
+		return nil, dataindexer.ErrNilDatabaseClient
+	}
+	if check.IfNil(hasher) {
+		return nil, dataindexer.ErrNilHasher
+	}
+
+	projections, err := createFieldsProjections(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldsProjectionElasticClient{
+		DatabaseClientHandler: esClient,
+		hasher:                hasher,
+		projections:           projections,
+	}, nil
+}
+
+func createFieldsProjections(rules []FieldsProjectionRule) (map[string]*fieldsProjection, error) {
+	projections := make(map[string]*fieldsProjection)
+	for _, rule := range rules {
+		if rule.Index == "" {
+			return nil, fmt.Errorf("%w: empty index", dataindexer.ErrInvalidFieldsProjectionRule)
+		}
+
+		projection, found := projections[rule.Index]
+		if !found {
+			projection = &fieldsProjection{}
+			projections[rule.Index] = projection
+		}
+
+		dropPaths, err := splitFieldPaths(rule.Index, rule.Drop)
+		if err != nil {
+			return nil, err
+		}
+		hashPaths, err := splitFieldPaths(rule.Index, rule.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		projection.drop = append(projection.drop, dropPaths...)
+		projection.hash = append(projection.hash, hashPaths...)
+	}
+
+	for index, projection := range projections {
+		err := checkDuplicatedFields(index, projection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return projections, nil
+}
+
+func splitFieldPaths(index string, fields []string) ([][]string, error) {
+	paths := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		path := strings.Split(field, fieldPathSeparator)
+		for _, key := range path {
+			if key == "" {
+				return nil, fmt.Errorf("%w: invalid field %s for index %s", dataindexer.ErrInvalidFieldsProjectionRule, field, index)
+			}
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func checkDuplicatedFields(index string, projection *fieldsProjection) error {
+	fields := make(map[string]struct{})
+	for _, path := range append(projection.drop, projection.hash...) {
+		field := strings.Join(path, fieldPathSeparator)
+		_, found := fields[field]
+		if found {
+			return fmt.Errorf("%w: field %s of index %s is configured more than once", dataindexer.ErrInvalidFieldsProjectionRule, field, index)
+		}
+		fields[field] = struct{}{}
+	}
+
+	return nil
+}
+
+// DoBulkRequest will do a bulk request after the configured fields were dropped or hashed from the documents. The bulk
+// requests that cannot hold documents of a projected index are forwarded as they are
+func (fpc *fieldsProjectionElasticClient) DoBulkRequest(ctx context.Context, buff *bytes.Buffer, index string) error {
+	if !fpc.mayHoldProjectedDocuments(buff, index) {
+		return fpc.DatabaseClientHandler.DoBulkRequest(ctx, buff, index)
+	}
+
+	projectedBuff, err := fpc.projectBulkDocuments(buff, index)
+	if err != nil {
+		return err
+	}
+
+	return fpc.DatabaseClientHandler.DoBulkRequest(ctx, projectedBuff, index)
+}
+
+// CheckAndCreateTemplate creates the template, without the mappings of the dropped fields, if it does not already exist
+func (fpc *fieldsProjectionElasticClient) CheckAndCreateTemplate(templateName string, template *bytes.Buffer) error {
+	projection, found := fpc.projections[templateName]
+	if !found {
+		return fpc.DatabaseClientHandler.CheckAndCreateTemplate(templateName, template)
+	}
+
+	templateObj := make(objectsMap)
+	err := json.Unmarshal(template.Bytes(), &templateObj)
+	if err != nil {
+		return err
+	}
+
+	mappings, ok := templateObj[mappingsKey].(objectsMap)
+	innerTemplate, isTemplate := templateObj[templateKey].(objectsMap)
+	if isTemplate {
+		mappings, ok = innerTemplate[mappingsKey].(objectsMap)
+	}
+	if ok {
+		projectMappings(mappings, projection)
+	}
+
+	projectedTemplate, err := json.Marshal(templateObj)
+	if err != nil {
+		return err
+	}
+
+	return fpc.DatabaseClientHandler.CheckAndCreateTemplate(templateName, bytes.NewBuffer(projectedTemplate))
+}
+
+// PutMappings will put the provided mappings, without the mappings of the dropped fields
+func (fpc *fieldsProjectionElasticClient) PutMappings(indexName string, mappings *bytes.Buffer) error {
+	projection, found := fpc.projections[indexName]
+	if !found {
+		return fpc.DatabaseClientHandler.PutMappings(indexName, mappings)
+	}
+
+	mappingsObj := make(objectsMap)
+	err := json.Unmarshal(mappings.Bytes(), &mappingsObj)
+	if err != nil {
+		return err
+	}
+
+	projectMappings(mappingsObj, projection)
+
+	projectedMappings, err := json.Marshal(mappingsObj)
+	if err != nil {
+		return err
+	}
+
+	return fpc.DatabaseClientHandler.PutMappings(indexName, bytes.NewBuffer(projectedMappings))
+}
+
+func (fpc *fieldsProjectionElasticClient) mayHoldProjectedDocuments(buff *bytes.Buffer, index string) bool {
+	_, found := fpc.projections[index]
+	if found {
+		return true
+	}
+
+	for projectedIndex := range fpc.projections {
+		if bytes.Contains(buff.Bytes(), []byte(`"`+projectedIndex+`"`)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// every action line of a bulk request is followed by the document, except for the delete actions. Only the documents
+// of the projected indexes are decoded, and the request body is rebuilt only if a document was changed
+func (fpc *fieldsProjectionElasticClient) projectBulkDocuments(buff *bytes.Buffer, index string) (*bytes.Buffer, error) {
+	lines := strings.Split(buff.String(), bulkLinesSeparator)
+	changed := false
+	isActionLine := true
+	action, actionIndex := "", ""
+	for idx, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if isActionLine {
+			var err error
+			action, actionIndex, err = parseBulkAction(line, index)
+			if err != nil {
+				return nil, err
+			}
+
+			isActionLine = action == bulkDeleteAction
+			continue
+		}
+
+		isActionLine = true
+		projection, found := fpc.projections[actionIndex]
+		if !found {
+			continue
+		}
+
+		projectedLine, err := fpc.projectDocumentLine(line, action, projection)
+		if err != nil {
+			return nil, err
+		}
+		lines[idx] = projectedLine
+		changed = true
+	}
+
+	if !changed {
+		return buff, nil
+	}
+
+	return bytes.NewBufferString(strings.Join(lines, bulkLinesSeparator)), nil
+}
+
+// the action lines are written by the serializers as { "<action>" : { "_index":"<index>", ... } }, so the action and
+// the index are read without decoding the line. The lines in other formats are decoded
+func parseBulkAction(line string, defaultIndex string) (string, string, error) {
+	action, rest, ok := readQuotedString(line)
+	if !ok {
+		return decodeBulkAction(line, defaultIndex)
+	}
+
+	keyPosition := strings.Index(rest, `"`+bulkIndexKey+`"`)
+	if keyPosition < 0 {
+		return action, defaultIndex, nil
+	}
+
+	rest = strings.TrimLeft(rest[keyPosition+len(bulkIndexKey)+2:], " ")
+	if !strings.HasPrefix(rest, ":") {
+		return decodeBulkAction(line, defaultIndex)
+	}
+
+	index, _, ok := readQuotedString(rest[1:])
+	if !ok {
+		return decodeBulkAction(line, defaultIndex)
+	}
+	if index == "" {
+		index = defaultIndex
+	}
+
+	return action, index, nil
+}
+
+func readQuotedString(str string) (string, string, bool) {
+	start := strings.IndexByte(str, '"')
+	if start < 0 {
+		return "", "", false
+	}
+
+	length := strings.IndexByte(str[start+1:], '"')
+	if length < 0 {
+		return "", "", false
+	}
+
+	end := start + 1 + length
+	return str[start+1 : end], str[end+1:], true
+}
+
+func decodeBulkAction(line string, defaultIndex string) (string, string, error) {
+	actionObj := make(map[string]map[string]interface{})
+	err := json.Unmarshal([]byte(line), &actionObj)
+	if err != nil {
+		return "", "", err
+	}
+
+	for action, actionMeta := range actionObj {
+		index, ok := actionMeta[bulkIndexKey].(string)
+		if !ok || index == "" {
+			index = defaultIndex
+		}
+
+		return action, index, nil
+	}
+
+	return "", defaultIndex, nil
+}
+
+// the updates hold the document in the partial document, in the upsert document or in the parameters of the script
+func (fpc *fieldsProjectionElasticClient) projectDocumentLine(line string, action string, projection *fieldsProjection) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(line))
+	decoder.UseNumber()
+
+	document := make(objectsMap)
+	err := decoder.Decode(&document)
+	if err != nil {
+		return "", err
+	}
+
+	if action != bulkUpdateAction {
+		fpc.projectDocument(document, projection)
+	} else {
+		fpc.projectDocument(document[updateDocKey], projection)
+		fpc.projectDocument(document[updateUpsertKey], projection)
+
+		script, isObject := document[updateScriptKey].(objectsMap)
+		params, hasParams := script[scriptParamsKey].(objectsMap)
+		if isObject && hasParams {
+			for _, param := range params {
+				fpc.projectDocument(param, projection)
+			}
+		}
+	}
+
+	projectedLine, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return string(projectedLine), nil
+}
+
+func (fpc *fieldsProjectionElasticClient) projectDocument(document interface{}, projection *fieldsProjection) {
+	for _, path := range projection.drop {
+		applyOnField(document, path, func(object objectsMap, key string) {
+			delete(object, key)
+		})
+	}
+	for _, path := range projection.hash {
+		applyOnField(document, path, fpc.hashField)
+	}
+}
+
+func (fpc *fieldsProjectionElasticClient) hashField(object objectsMap, key string) {
+	value, found := object[key]
+	if !found || value == nil {
+		return
+	}
+
+	strValue, isString := value.(string)
+	if !isString {
+		marshaledValue, err := json.Marshal(value)
+		if err != nil {
+			return
+		}
+		strValue = string(marshaledValue)
+	}
+
+	object[key] = hex.EncodeToString(fpc.hasher.Compute(strValue))
+}
+
+// applyOnField calls the handler for the object that holds the last key of the path. The arrays found on the path are
+// traversed, so "events.data" applies on the data of every event
+func applyOnField(value interface{}, path []string, handler func(object objectsMap, key string)) {
+	switch typedValue := value.(type) {
+	case objectsMap:
+		if len(path) == 1 {
+			handler(typedValue, path[0])
+			return
+		}
+		applyOnField(typedValue[path[0]], path[1:], handler)
+	case []interface{}:
+		for _, element := range typedValue {
+			applyOnField(element, path, handler)
+		}
+	}
+}
+
+// the mappings of the nested fields are under the properties of their parent field
+func projectMappings(mappings objectsMap, projection *fieldsProjection) {
+	for _, path := range projection.drop {
+		applyOnMappingsField(mappings, path, func(properties objectsMap, key string) {
+			delete(properties, key)
+		})
+	}
+	for _, path := range projection.hash {
+		applyOnMappingsField(mappings, path, func(properties objectsMap, key string) {
+			_, found := properties[key]
+			if found {
+				properties[key] = objectsMap{"type": hashedFieldType}
+			}
+		})
+	}
+}
+
+func applyOnMappingsField(mappings objectsMap, path []string, handler func(properties objectsMap, key string)) {
+	properties, ok := mappings[propertiesKey].(objectsMap)
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		handler(properties, path[0])
+		return
+	}
+
+	fieldMappings, ok := properties[path[0]].(objectsMap)
+	if ok {
+		applyOnMappingsField(fieldMappings, path[1:], handler)
+	}
+}
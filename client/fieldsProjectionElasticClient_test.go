@@ -0,0 +1,162 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func createMockFieldsProjectionRules() []FieldsProjectionRule {
+	return []FieldsProjectionRule{
+		{
+			Index: "transactions",
+			Drop:  []string{"data", "signature"},
+			Hash:  []string{"guardianSignature"},
+		},
+		{
+			Index: "logs",
+			Drop:  []string{"events.data"},
+		},
+	}
+}
+
+func hashHex(value string) string {
+	return hex.EncodeToString(mock.HasherMock{}.Compute(value))
+}
+
+func TestNewFieldsProjectionElasticClient(t *testing.T) {
+	t.Parallel()
+
+	fpc, err := NewFieldsProjectionElasticClient(nil, &mock.HasherMock{}, nil)
+	require.Nil(t, fpc)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	fpc, err = NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{}, nil, nil)
+	require.Nil(t, fpc)
+	require.Equal(t, dataindexer.ErrNilHasher, err)
+
+	fpc, err = NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{}, &mock.HasherMock{}, []FieldsProjectionRule{{Drop: []string{"data"}}})
+	require.Nil(t, fpc)
+	require.True(t, errors.Is(err, dataindexer.ErrInvalidFieldsProjectionRule))
+
+	fpc, err = NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{}, &mock.HasherMock{}, []FieldsProjectionRule{{Index: "logs", Drop: []string{"events..data"}}})
+	require.Nil(t, fpc)
+	require.True(t, errors.Is(err, dataindexer.ErrInvalidFieldsProjectionRule))
+
+	fpc, err = NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{}, &mock.HasherMock{}, []FieldsProjectionRule{
+		{Index: "transactions", Drop: []string{"data"}},
+		{Index: "transactions", Hash: []string{"data"}},
+	})
+	require.Nil(t, fpc)
+	require.True(t, errors.Is(err, dataindexer.ErrInvalidFieldsProjectionRule))
+
+	fpc, err = NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{}, &mock.HasherMock{}, createMockFieldsProjectionRules())
+	require.Nil(t, err)
+	require.False(t, fpc.IsInterfaceNil())
+}
+
+func TestFieldsProjectionElasticClient_DoBulkRequest(t *testing.T) {
+	t.Parallel()
+
+	bulkBody := `{ "index" : { "_index":"transactions", "_id" : "h1" } }
+{"nonce":18446744073709551615,"data":"ZGF0YQ==","signature":"aa","guardianSignature":"bb"}
+{ "delete" : { "_index": "transactions", "_id" : "h2" } }
+{ "update" : {"_index":"transactions", "_id" : "h3" } }
+{"scripted_upsert": true, "script": {"source": "ctx._source = params.tx","params": {"tx": {"nonce":2,"data":"ZA==","guardianSignature":"cc"}}},"upsert": {}}
+{ "update" : {"_index":"logs", "_id" : "h4" } }
+{"script": {"source": "return"},"upsert": {"address":"drt1","events":[{"identifier":"a","data":"01"},{"identifier":"b","data":"02"}]}}
+{ "index" : { "_index":"blocks", "_id" : "b1" } }
+{"pubKeyBitmap":"ff"}
+`
+
+	called := false
+	fpc, _ := NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			called = true
+			require.Equal(t, "", index)
+			require.Equal(t, `{ "index" : { "_index":"transactions", "_id" : "h1" } }
+{"guardianSignature":"`+hashHex("bb")+`","nonce":18446744073709551615}
+{ "delete" : { "_index": "transactions", "_id" : "h2" } }
+{ "update" : {"_index":"transactions", "_id" : "h3" } }
+{"script":{"params":{"tx":{"guardianSignature":"`+hashHex("cc")+`","nonce":2}},"source":"ctx._source = params.tx"},"scripted_upsert":true,"upsert":{}}
+{ "update" : {"_index":"logs", "_id" : "h4" } }
+{"script":{"source":"return"},"upsert":{"address":"drt1","events":[{"identifier":"a"},{"identifier":"b"}]}}
+{ "index" : { "_index":"blocks", "_id" : "b1" } }
+{"pubKeyBitmap":"ff"}
+`, buff.String())
+			return nil
+		},
+	}, &mock.HasherMock{}, createMockFieldsProjectionRules())
+
+	err := fpc.DoBulkRequest(context.Background(), bytes.NewBufferString(bulkBody), "")
+	require.Nil(t, err)
+	require.True(t, called)
+}
+
+func TestFieldsProjectionElasticClient_DoBulkRequestUsesTheRequestIndex(t *testing.T) {
+	t.Parallel()
+
+	fpc, _ := NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, "{ \"index\" : { \"_id\" : \"h1\" } }\n{\"nonce\":1}\n", buff.String())
+			return nil
+		},
+	}, &mock.HasherMock{}, createMockFieldsProjectionRules())
+
+	err := fpc.DoBulkRequest(context.Background(), bytes.NewBufferString("{ \"index\" : { \"_id\" : \"h1\" } }\n{\"nonce\":1,\"data\":\"ZA==\"}\n"), "transactions")
+	require.Nil(t, err)
+}
+
+func TestFieldsProjectionElasticClient_DoBulkRequestForwardsTheIndicesWithoutRules(t *testing.T) {
+	t.Parallel()
+
+	buff := bytes.NewBufferString("{ \"index\" : { \"_id\" : \"h1\" } }\n{\"nonce\":1,\"data\":\"ZA==\"}\n")
+	fpc, _ := NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(receivedBuff *bytes.Buffer, index string) error {
+			require.True(t, buff == receivedBuff)
+			return nil
+		},
+	}, &mock.HasherMock{}, createMockFieldsProjectionRules())
+
+	err := fpc.DoBulkRequest(context.Background(), buff, "rounds")
+	require.Nil(t, err)
+}
+
+func TestFieldsProjectionElasticClient_Templates(t *testing.T) {
+	t.Parallel()
+
+	templates := make(map[string]string)
+	fpc, _ := NewFieldsProjectionElasticClient(&mock.DatabaseWriterStub{
+		CheckAndCreateTemplateCalled: func(templateName string, template *bytes.Buffer) error {
+			templates[templateName] = template.String()
+			return nil
+		},
+		PutMappingsCalled: func(indexName string, mappings *bytes.Buffer) error {
+			templates["mappings-"+indexName] = mappings.String()
+			return nil
+		},
+	}, &mock.HasherMock{}, createMockFieldsProjectionRules())
+
+	err := fpc.CheckAndCreateTemplate("transactions", bytes.NewBufferString(`{"index_patterns":["transactions-*"],"template":{"mappings":{"properties":{"data":{"type":"text"},"nonce":{"type":"double"},"guardianSignature":{"index":"false","type":"keyword"}}}}}`))
+	require.Nil(t, err)
+	err = fpc.CheckAndCreateTemplate("logs", bytes.NewBufferString(`{"index_patterns":["logs-*"],"mappings":{"properties":{"events":{"type":"nested","properties":{"data":{"type":"text"},"identifier":{"type":"keyword"}}}}}}`))
+	require.Nil(t, err)
+	err = fpc.CheckAndCreateTemplate("blocks", bytes.NewBufferString(`{"index_patterns": ["blocks-*"]}`))
+	require.Nil(t, err)
+	err = fpc.PutMappings("transactions", bytes.NewBufferString(`{"properties":{"signature":{"type":"keyword"}}}`))
+	require.Nil(t, err)
+
+	require.Equal(t, map[string]string{
+		"transactions":          `{"index_patterns":["transactions-*"],"template":{"mappings":{"properties":{"guardianSignature":{"type":"keyword"},"nonce":{"type":"double"}}}}}`,
+		"logs":                  `{"index_patterns":["logs-*"],"mappings":{"properties":{"events":{"properties":{"identifier":{"type":"keyword"}},"type":"nested"}}}}`,
+		"blocks":                `{"index_patterns": ["blocks-*"]}`,
+		"mappings-transactions": `{"properties":{}}`,
+	}, templates)
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+)
+
+const (
+	namespaceSeparator  = "-"
+	systemIndexPrefix   = "."
+	indexPatternsKey    = "index_patterns"
+	bulkIndexKey        = "_index"
+	bulkDeleteAction    = "delete"
+	bulkLinesSeparator  = "\n"
+	multipleIndexesSign = ","
+)
+
+// namespacedElasticClient prefixes the names of all the indexes, aliases and templates with the namespace, so several
+// pipelines can index their data in the same cluster. The system indexes, starting with ".", are not namespaced
+type namespacedElasticClient struct {
+	elasticproc.DatabaseClientHandler
+	namespace string
+}
+
+// NewNamespacedElasticClient creates a new namespaced elastic client
+func NewNamespacedElasticClient(esClient elasticproc.DatabaseClientHandler, namespace string) (*namespacedElasticClient, error) {
+	if check.IfNil(esClient) {
+		return nil, dataindexer.ErrNilDatabaseClient
+	}
+	if namespace == "" {
+		return nil, dataindexer.ErrEmptyIndexNamespace
+	}
+
+	return &namespacedElasticClient{
+		DatabaseClientHandler: esClient,
+		namespace:             namespace,
+	}, nil
+}
+
+// DoBulkRequest will do a bulk request after the indexes of the actions were namespaced
+func (nec *namespacedElasticClient) DoBulkRequest(ctx context.Context, buff *bytes.Buffer, index string) error {
+	namespacedBuff, err := nec.namespaceBulkActions(buff)
+	if err != nil {
+		return err
+	}
+
+	return nec.DatabaseClientHandler.DoBulkRequest(ctx, namespacedBuff, nec.indexName(index))
+}
+
+// DoQueryRemove will do a query remove request in the namespaced index
+func (nec *namespacedElasticClient) DoQueryRemove(ctx context.Context, index string, buff *bytes.Buffer) error {
+	return nec.DatabaseClientHandler.DoQueryRemove(ctx, nec.indexName(index), buff)
+}
+
+// DoMultiGet will do a multi get request in the namespaced index
+func (nec *namespacedElasticClient) DoMultiGet(ctx context.Context, ids []string, index string, withSource bool, res interface{}) error {
+	return nec.DatabaseClientHandler.DoMultiGet(ctx, ids, nec.indexName(index), withSource, res)
+}
+
+// DoScrollRequest will do a scroll request in the namespaced index
+func (nec *namespacedElasticClient) DoScrollRequest(ctx context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+	return nec.DatabaseClientHandler.DoScrollRequest(ctx, nec.indexName(index), body, withSource, handlerFunc)
+}
+
+// DoSearchRequest will do a search request in the namespaced index
+func (nec *namespacedElasticClient) DoSearchRequest(ctx context.Context, index string, body []byte, res interface{}) error {
+	return nec.DatabaseClientHandler.DoSearchRequest(ctx, nec.indexName(index), body, res)
+}
+
+// DoCountRequest will do a count request in the namespaced index
+func (nec *namespacedElasticClient) DoCountRequest(ctx context.Context, index string, body []byte) (uint64, error) {
+	return nec.DatabaseClientHandler.DoCountRequest(ctx, nec.indexName(index), body)
+}
+
+// UpdateByQuery will do an update by query request in the namespaced index
+func (nec *namespacedElasticClient) UpdateByQuery(ctx context.Context, index string, buff *bytes.Buffer) error {
+	return nec.DatabaseClientHandler.UpdateByQuery(ctx, nec.indexName(index), buff)
+}
+
+// PutMappings will put the provided mappings to the namespaced index
+func (nec *namespacedElasticClient) PutMappings(indexName string, mappings *bytes.Buffer) error {
+	return nec.DatabaseClientHandler.PutMappings(nec.indexName(indexName), mappings)
+}
+
+// CheckAndCreateIndex creates the namespaced index if it does not already exist
+func (nec *namespacedElasticClient) CheckAndCreateIndex(index string) error {
+	return nec.DatabaseClientHandler.CheckAndCreateIndex(nec.indexName(index))
+}
+
+// CheckAndCreateAlias creates the namespaced alias if it does not already exist
+func (nec *namespacedElasticClient) CheckAndCreateAlias(alias string, index string) error {
+	return nec.DatabaseClientHandler.CheckAndCreateAlias(nec.indexName(alias), nec.indexName(index))
+}
+
+// CheckAndCreateTemplate creates the namespaced template, which applies to the namespaced indexes, if it does not
+// already exist. The templates of the system indexes are shared by all the namespaces
+func (nec *namespacedElasticClient) CheckAndCreateTemplate(templateName string, template *bytes.Buffer) error {
+	templateObj := make(objectsMap)
+	err := json.Unmarshal(template.Bytes(), &templateObj)
+	if err != nil {
+		return err
+	}
+
+	patterns, ok := templateObj[indexPatternsKey].([]interface{})
+	if !ok || !nec.hasNamespacedPatterns(patterns) {
+		return nec.DatabaseClientHandler.CheckAndCreateTemplate(templateName, template)
+	}
+
+	for idx, pattern := range patterns {
+		patternStr, isString := pattern.(string)
+		if isString {
+			patterns[idx] = nec.indexName(patternStr)
+		}
+	}
+
+	namespacedTemplate, err := json.Marshal(templateObj)
+	if err != nil {
+		return err
+	}
+
+	return nec.DatabaseClientHandler.CheckAndCreateTemplate(nec.indexName(templateName), bytes.NewBuffer(namespacedTemplate))
+}
+
+func (nec *namespacedElasticClient) hasNamespacedPatterns(patterns []interface{}) bool {
+	for _, pattern := range patterns {
+		patternStr, isString := pattern.(string)
+		if isString && !strings.HasPrefix(patternStr, systemIndexPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// every action line of a bulk request is followed by the document, except for the delete actions
+func (nec *namespacedElasticClient) namespaceBulkActions(buff *bytes.Buffer) (*bytes.Buffer, error) {
+	lines := strings.Split(buff.String(), bulkLinesSeparator)
+	isActionLine := true
+	for idx, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if !isActionLine {
+			isActionLine = true
+			continue
+		}
+
+		namespacedLine, action, err := nec.namespaceBulkAction(line)
+		if err != nil {
+			return nil, err
+		}
+
+		lines[idx] = namespacedLine
+		isActionLine = action == bulkDeleteAction
+	}
+
+	return bytes.NewBufferString(strings.Join(lines, bulkLinesSeparator)), nil
+}
+
+func (nec *namespacedElasticClient) namespaceBulkAction(line string) (string, string, error) {
+	actionObj := make(map[string]map[string]interface{})
+	err := json.Unmarshal([]byte(line), &actionObj)
+	if err != nil {
+		return "", "", err
+	}
+
+	action := ""
+	for actionName, actionMeta := range actionObj {
+		action = actionName
+		index, ok := actionMeta[bulkIndexKey].(string)
+		if ok {
+			actionMeta[bulkIndexKey] = nec.indexName(index)
+		}
+	}
+
+	namespacedLine, err := json.Marshal(actionObj)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(namespacedLine), action, nil
+}
+
+func (nec *namespacedElasticClient) indexName(index string) string {
+	if index == "" {
+		return ""
+	}
+
+	indexes := strings.Split(index, multipleIndexesSign)
+	for idx, name := range indexes {
+		if strings.HasPrefix(name, systemIndexPrefix) {
+			continue
+		}
+
+		indexes[idx] = nec.namespace + namespaceSeparator + name
+	}
+
+	return strings.Join(indexes, multipleIndexesSign)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nec *namespacedElasticClient) IsInterfaceNil() bool {
+	return nec == nil
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func TestNewNamespacedElasticClient(t *testing.T) {
+	t.Parallel()
+
+	nec, err := NewNamespacedElasticClient(nil, "sovereign")
+	require.Nil(t, nec)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	nec, err = NewNamespacedElasticClient(&mock.DatabaseWriterStub{}, "")
+	require.Nil(t, nec)
+	require.Equal(t, dataindexer.ErrEmptyIndexNamespace, err)
+
+	nec, err = NewNamespacedElasticClient(&mock.DatabaseWriterStub{}, "sovereign")
+	require.Nil(t, err)
+	require.False(t, nec.IsInterfaceNil())
+}
+
+func TestNamespacedElasticClient_DoBulkRequest(t *testing.T) {
+	t.Parallel()
+
+	bulkBody := `{ "index" : { "_index":"transactions", "_id" : "h1" } }
+{"nonce":1,"data":"{ \"index\" : { \"_index\":\"other\" } }"}
+{ "delete" : { "_index": "delegators", "_id" : "d1" } }
+{ "update" : {"_index":"tokens", "_id" : "TKN-abcdef" } }
+{"script": {"source": "ctx._source.a = 1"},"upsert": {}}
+`
+
+	called := false
+	nec, _ := NewNamespacedElasticClient(&mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			called = true
+			require.Equal(t, "", index)
+			require.Equal(t, `{"index":{"_id":"h1","_index":"sovereign-transactions"}}
+{"nonce":1,"data":"{ \"index\" : { \"_index\":\"other\" } }"}
+{"delete":{"_id":"d1","_index":"sovereign-delegators"}}
+{"update":{"_id":"TKN-abcdef","_index":"sovereign-tokens"}}
+{"script": {"source": "ctx._source.a = 1"},"upsert": {}}
+`, buff.String())
+			return nil
+		},
+	}, "sovereign")
+
+	err := nec.DoBulkRequest(context.Background(), bytes.NewBufferString(bulkBody), "")
+	require.Nil(t, err)
+	require.True(t, called)
+}
+
+func TestNamespacedElasticClient_IndexRequests(t *testing.T) {
+	t.Parallel()
+
+	indexes := make([]string, 0)
+	nec, _ := NewNamespacedElasticClient(&mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			indexes = append(indexes, index)
+			return nil
+		},
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			indexes = append(indexes, index)
+			return nil
+		},
+		DoSearchRequestCalled: func(index string, body []byte, response interface{}) error {
+			indexes = append(indexes, index)
+			return nil
+		},
+		CheckAndCreateIndexCalled: func(index string) error {
+			indexes = append(indexes, index)
+			return nil
+		},
+		CheckAndCreateAliasCalled: func(alias string, index string) error {
+			indexes = append(indexes, alias, index)
+			return nil
+		},
+	}, "sovereign")
+
+	_ = nec.DoMultiGet(context.Background(), []string{"id"}, "tokens", true, nil)
+	_ = nec.DoScrollRequest(context.Background(), "accountsdcdt,tokens", nil, true, nil)
+	_ = nec.DoSearchRequest(context.Background(), "accountsdcdt", nil, nil)
+	_ = nec.CheckAndCreateIndex("blocks-000001")
+	_ = nec.CheckAndCreateAlias("blocks", "blocks-000001")
+	_ = nec.CheckAndCreateIndex(".opendistro-job-scheduler-lock")
+
+	require.Equal(t, []string{
+		"sovereign-tokens",
+		"sovereign-accountsdcdt,sovereign-tokens",
+		"sovereign-accountsdcdt",
+		"sovereign-blocks-000001",
+		"sovereign-blocks",
+		"sovereign-blocks-000001",
+		".opendistro-job-scheduler-lock",
+	}, indexes)
+}
+
+func TestNamespacedElasticClient_CheckAndCreateTemplate(t *testing.T) {
+	t.Parallel()
+
+	templates := make(map[string]string)
+	nec, _ := NewNamespacedElasticClient(&mock.DatabaseWriterStub{
+		CheckAndCreateTemplateCalled: func(templateName string, template *bytes.Buffer) error {
+			templates[templateName] = template.String()
+			return nil
+		},
+	}, "sovereign")
+
+	err := nec.CheckAndCreateTemplate("blocks", bytes.NewBufferString(`{"index_patterns":["blocks-*"],"settings":{"number_of_shards":3}}`))
+	require.Nil(t, err)
+	err = nec.CheckAndCreateTemplate("opendistro", bytes.NewBufferString(`{"index_patterns":[".opendistro-*"]}`))
+	require.Nil(t, err)
+
+	require.Equal(t, map[string]string{
+		"sovereign-blocks": `{"index_patterns":["sovereign-blocks-*"],"settings":{"number_of_shards":3}}`,
+		"opendistro":       `{"index_patterns":[".opendistro-*"]}`,
+	}, templates)
+
+	err = nec.CheckAndCreateTemplate("invalid", bytes.NewBufferString("not a template"))
+	require.NotNil(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,7 +19,6 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/factory"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/metrics"
-	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/wsindexer"
 )
 
 var (
@@ -101,7 +101,17 @@ func startIndexer(ctx *cli.Context) error {
 	}
 
 	statusMetrics := metrics.NewStatusMetrics()
-	wsHost, err := factory.CreateWsIndexer(cfg, clusterCfg, statusMetrics, ctx.App.Version)
+	liveFeed, err := factory.CreateLiveFeed(clusterCfg)
+	if err != nil {
+		return fmt.Errorf("%w while creating the live feed", err)
+	}
+
+	webhooksNotifier, err := factory.CreateWebhooksNotifier(clusterCfg)
+	if err != nil {
+		return fmt.Errorf("%w while creating the webhooks notifier", err)
+	}
+
+	pipelines, err := factory.CreatePipelines(cfg, clusterCfg, statusMetrics, liveFeed, webhooksNotifier, ctx.App.Version)
 	if err != nil {
 		return fmt.Errorf("%w while creating the indexer", err)
 	}
@@ -111,7 +121,12 @@ func startIndexer(ctx *cli.Context) error {
 		return fmt.Errorf("%w while loading the api config file", err)
 	}
 
-	webServer, err := factory.CreateWebServer(apiConfig, statusMetrics)
+	payloadsReader, err := factory.CreatePayloadsReader(cfg, clusterCfg)
+	if err != nil {
+		return fmt.Errorf("%w while creating the payloads reader", err)
+	}
+
+	webServer, err := factory.CreateWebServer(apiConfig, statusMetrics, liveFeed, payloadsReader)
 	if err != nil {
 		return fmt.Errorf("%w while creating the web server", err)
 	}
@@ -124,23 +139,38 @@ func startIndexer(ctx *cli.Context) error {
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
 
-	retryDuration := time.Duration(clusterCfg.Config.WebSocket.RetryDurationInSec) * time.Second
-	closed := requestSettings(wsHost, retryDuration, interrupt)
-	if !closed {
-		<-interrupt
+	stopRequests := make(chan struct{})
+	wg := sync.WaitGroup{}
+	for _, pipeline := range pipelines {
+		wg.Add(1)
+		go func(pipeline *factory.Pipeline) {
+			defer wg.Done()
+			requestSettings(pipeline, stopRequests)
+		}(pipeline)
 	}
 
+	<-interrupt
+	close(stopRequests)
+	wg.Wait()
+
 	log.Info("closing app at user's signal")
-	err = wsHost.Close()
-	if err != nil {
-		log.Error("cannot close ws indexer", "error", err)
-	}
+	factory.ClosePipelines(pipelines)
 
 	err = webServer.Close()
 	if err != nil {
 		log.Error("cannot close web server", "error", err)
 	}
 
+	err = liveFeed.Close()
+	if err != nil {
+		log.Error("cannot close live feed", "error", err)
+	}
+
+	err = webhooksNotifier.Close()
+	if err != nil {
+		log.Error("cannot close webhooks notifier", "error", err)
+	}
+
 	if !check.IfNilReflect(fileLogging) {
 		err = fileLogging.Close()
 		log.LogIfError(err)
@@ -148,7 +178,7 @@ func startIndexer(ctx *cli.Context) error {
 	return nil
 }
 
-func requestSettings(host wsindexer.WSClient, retryDuration time.Duration, close chan os.Signal) bool {
+func requestSettings(pipeline *factory.Pipeline, stop chan struct{}) {
 	timer := time.NewTimer(0)
 	defer timer.Stop()
 
@@ -156,15 +186,15 @@ func requestSettings(host wsindexer.WSClient, retryDuration time.Duration, close
 	for {
 		select {
 		case <-timer.C:
-			err := host.Send(emptyMessage, outport.TopicSettings)
+			err := pipeline.Host.Send(emptyMessage, outport.TopicSettings)
 			if err == nil {
-				return false
+				return
 			}
-			log.Debug("unable to request settings - will retry", "error", err)
+			log.Debug("unable to request settings - will retry", "pipeline", pipeline.Name, "error", err)
 
-			timer.Reset(retryDuration)
-		case <-close:
-			return true
+			timer.Reset(pipeline.RetryDuration)
+		case <-stop:
+			return
 		}
 	}
 }
@@ -3,18 +3,11 @@ package config
 // Config will hold the whole config file's data
 type Config struct {
 	Config struct {
-		AvailableIndices []string `toml:"available-indices"`
-		DCDTPrefix       string   `toml:"dcdt-prefix"`
-		AddressConverter struct {
-			Length int    `toml:"length"`
-			Type   string `toml:"type"`
-			Prefix string `toml:"prefix"`
-		} `toml:"address-converter"`
-		ValidatorKeysConverter struct {
-			Length int    `toml:"length"`
-			Type   string `toml:"type"`
-		} `toml:"validator-keys-converter"`
-		Hasher struct {
+		AvailableIndices       []string               `toml:"available-indices"`
+		DCDTPrefix             string                 `toml:"dcdt-prefix"`
+		AddressConverter       AddressConverterConfig `toml:"address-converter"`
+		ValidatorKeysConverter PubkeyConverterConfig  `toml:"validator-keys-converter"`
+		Hasher                 struct {
 			Type string `toml:"type"`
 		} `toml:"hasher"`
 		Marshaller struct {
@@ -23,45 +16,196 @@ type Config struct {
 		Economics struct {
 			Denomination int `toml:"denomination"`
 		} `toml:"economics"`
+		Guardians struct {
+			ActivationEpochsDelay uint32 `toml:"activation-epochs-delay"`
+		} `toml:"guardians"`
+		Bridge struct {
+			ContractAddress string `toml:"contract-address"`
+		} `toml:"bridge"`
 		Logs struct {
 			LogFileLifeSpanInMB  int    `toml:"log-file-life-span-in-mb"`
 			LogFileLifeSpanInSec int    `toml:"log-file-life-span-in-sec"`
 			LogFilePrefix        string `toml:"log-file-prefix"`
 			LogsPath             string `toml:"logs-path"`
 		} `toml:"logs"`
+		CustomEvents CustomEventsConfig `toml:"custom-events"`
+		AbiDecoder   struct {
+			Enabled   bool                `toml:"enabled"`
+			Contracts []ContractAbiConfig `toml:"contracts"`
+		} `toml:"abi-decoder"`
+		WatchedAddresses   WatchedAddressesConfig   `toml:"watched-addresses"`
+		PayloadsOffloading PayloadsOffloadingConfig `toml:"payloads-offloading"`
 	} `toml:"config"`
 	Sovereign bool
 }
 
+// PubkeyConverterConfig holds the configuration of a public keys converter. The prefix is used only by the bech32 type
+type PubkeyConverterConfig struct {
+	Length int    `toml:"length"`
+	Type   string `toml:"type"`
+	Prefix string `toml:"prefix"`
+}
+
+// AddressConverterConfig holds the configuration of the addresses converter and of the optional secondary encoding
+// of the addresses
+type AddressConverterConfig struct {
+	Length          int    `toml:"length"`
+	Type            string `toml:"type"`
+	Prefix          string `toml:"prefix"`
+	SecondaryType   string `toml:"secondary-type"`
+	SecondaryPrefix string `toml:"secondary-prefix"`
+}
+
+// WatchedAddressesConfig holds the configuration of the light indexing mode. The watched addresses are read either from
+// a file, with an address per line, or from an Elasticsearch index, where the ID of every document is an address
+type WatchedAddressesConfig struct {
+	Enabled             bool   `toml:"enabled"`
+	Source              string `toml:"source"`
+	File                string `toml:"file"`
+	Index               string `toml:"index"`
+	ReloadIntervalInSec uint32 `toml:"reload-interval-in-seconds"`
+}
+
+// FieldsProjectionConfig holds the fields of the documents of an index that are not stored or that are stored as their hash
+type FieldsProjectionConfig struct {
+	Index string   `toml:"index"`
+	Drop  []string `toml:"drop"`
+	Hash  []string `toml:"hash"`
+}
+
+// PayloadsOffloadingConfig holds the configuration of the offloading of the large data fields into the payloads index
+type PayloadsOffloadingConfig struct {
+	Enabled             bool `toml:"enabled"`
+	ThresholdInBytes    int  `toml:"threshold-in-bytes"`
+	PrefixLengthInBytes int  `toml:"prefix-length-in-bytes"`
+}
+
 // ClusterConfig will hold the config for the Elasticsearch cluster
 type ClusterConfig struct {
 	Config struct {
-		DisabledIndices []string `toml:"disabled-indices"`
-		WebSocket       struct {
-			URL                string `toml:"url"`
-			Mode               string `toml:"mode"`
-			DataMarshallerType string `toml:"data-marshaller-type"`
-			RetryDurationInSec uint32 `toml:"retry-duration-in-seconds"`
-			BlockingAckOnError bool   `toml:"blocking-ack-on-error"`
-			WithAcknowledge    bool   `toml:"with-acknowledge"`
-			AckTimeoutInSec    uint32 `toml:"acknowledge-timeout-in-seconds"`
-		} `toml:"web-socket"`
-		ElasticCluster struct {
-			UseKibana                 bool   `toml:"use-kibana"`
-			URL                       string `toml:"url"`
-			UserName                  string `toml:"username"`
-			Password                  string `toml:"password"`
-			BulkRequestMaxSizeInBytes int    `toml:"bulk-request-max-size-in-bytes"`
-		} `toml:"elastic-cluster"`
+		DisabledIndices  []string             `toml:"disabled-indices"`
+		WebSocket        WebSocketConfig      `toml:"web-socket"`
+		ElasticCluster   ElasticClusterConfig `toml:"elastic-cluster"`
 		MainChainCluster struct {
-			Enabled  bool   `toml:"enabled"`
-			URL      string `toml:"url"`
-			UserName string `toml:"username"`
-			Password string `toml:"password"`
+			Enabled                     bool   `toml:"enabled"`
+			URL                         string `toml:"url"`
+			UserName                    string `toml:"username"`
+			Password                    string `toml:"password"`
+			TokensCacheSize             int    `toml:"tokens-cache-size"`
+			TokensNotFoundCacheTTLInSec uint32 `toml:"tokens-not-found-cache-ttl-in-seconds"`
+			RetryIntervalInSec          uint32 `toml:"retry-interval-in-seconds"`
+			TokensSyncIntervalInSec     uint32 `toml:"tokens-sync-interval-in-seconds"`
 		} `toml:"main-chain-elastic-cluster"`
+		LiveFeed struct {
+			Enabled           bool   `toml:"enabled"`
+			MaxSubscribers    int    `toml:"max-subscribers"`
+			SendBufferSize    int    `toml:"send-buffer-size"`
+			WriteTimeoutInSec uint32 `toml:"write-timeout-in-seconds"`
+		} `toml:"live-feed"`
+		Webhooks     WebhooksConfig `toml:"webhooks"`
+		TokenHolders struct {
+			FullCountIntervalInMinutes uint32 `toml:"full-count-interval-in-minutes"`
+		} `toml:"token-holders"`
+		CrossShardReconciliation struct {
+			IntervalInSeconds       uint32 `toml:"interval-in-seconds"`
+			PendingTimeoutInSeconds uint32 `toml:"pending-timeout-in-seconds"`
+		} `toml:"cross-shard-reconciliation"`
+		Pipelines        []PipelineConfig         `toml:"pipelines"`
+		FieldsProjection []FieldsProjectionConfig `toml:"fields-projection"`
 	} `toml:"config"`
 }
 
+// WebSocketConfig holds the configuration of the websocket connection with the node
+type WebSocketConfig struct {
+	URL                string `toml:"url"`
+	Mode               string `toml:"mode"`
+	DataMarshallerType string `toml:"data-marshaller-type"`
+	RetryDurationInSec uint32 `toml:"retry-duration-in-seconds"`
+	BlockingAckOnError bool   `toml:"blocking-ack-on-error"`
+	WithAcknowledge    bool   `toml:"with-acknowledge"`
+	AckTimeoutInSec    uint32 `toml:"acknowledge-timeout-in-seconds"`
+}
+
+// ElasticClusterConfig holds the configuration of the Elasticsearch cluster where the data is indexed
+type ElasticClusterConfig struct {
+	UseKibana                 bool   `toml:"use-kibana"`
+	URL                       string `toml:"url"`
+	UserName                  string `toml:"username"`
+	Password                  string `toml:"password"`
+	BulkRequestMaxSizeInBytes int    `toml:"bulk-request-max-size-in-bytes"`
+}
+
+// PipelineConfig holds the configuration of an indexing pipeline. Every pipeline receives the data of a chain on its
+// own websocket endpoint and indexes it in its own cluster, under its own index namespace
+type PipelineConfig struct {
+	Name           string               `toml:"name"`
+	RunType        string               `toml:"run-type"`
+	IndexNamespace string               `toml:"index-namespace"`
+	WebSocket      WebSocketConfig      `toml:"web-socket"`
+	ElasticCluster ElasticClusterConfig `toml:"elastic-cluster"`
+}
+
+// CustomEventsConfig holds the configuration for the events that are saved as documents in custom indices
+type CustomEventsConfig struct {
+	Plugins []string                `toml:"plugins"`
+	Rules   []CustomEventRuleConfig `toml:"rules"`
+}
+
+// CustomEventRuleConfig holds the configuration of a declarative custom event rule
+type CustomEventRuleConfig struct {
+	Name       string                   `toml:"name"`
+	Identifier string                   `toml:"identifier"`
+	Contract   string                   `toml:"contract"`
+	Index      string                   `toml:"index"`
+	IDTemplate string                   `toml:"id-template"`
+	Topics     []CustomEventTopicConfig `toml:"topics"`
+}
+
+// CustomEventTopicConfig holds the name and the decoder of a custom event topic
+type CustomEventTopicConfig struct {
+	Name    string `toml:"name"`
+	Decoder string `toml:"decoder"`
+}
+
+// ContractAbiConfig links an ABI file to a contract address or to all the contracts with the code hash
+type ContractAbiConfig struct {
+	Address  string `toml:"address"`
+	CodeHash string `toml:"code-hash"`
+	AbiFile  string `toml:"abi-file"`
+}
+
+// WebhooksConfig holds the configuration for the webhook notifications
+type WebhooksConfig struct {
+	Enabled             bool                    `toml:"enabled"`
+	QueueSize           int                     `toml:"queue-size"`
+	NumWorkers          int                     `toml:"num-workers"`
+	MaxRetries          int                     `toml:"max-retries"`
+	RetryIntervalInSec  uint32                  `toml:"retry-interval-in-seconds"`
+	RequestTimeoutInSec uint32                  `toml:"request-timeout-in-seconds"`
+	DeadLetterFile      string                  `toml:"dead-letter-file"`
+	Endpoints           []WebhookEndpointConfig `toml:"endpoints"`
+	Rules               []WebhookRuleConfig     `toml:"rules"`
+}
+
+// WebhookEndpointConfig holds the configuration for a URL that receives webhook notifications
+type WebhookEndpointConfig struct {
+	Name   string `toml:"name"`
+	URL    string `toml:"url"`
+	Secret string `toml:"secret"`
+}
+
+// WebhookRuleConfig holds the configuration for a watch rule
+type WebhookRuleConfig struct {
+	Name       string   `toml:"name"`
+	Type       string   `toml:"type"`
+	Address    string   `toml:"address"`
+	Token      string   `toml:"token"`
+	MinAmount  string   `toml:"min-amount"`
+	Identifier string   `toml:"identifier"`
+	Contract   string   `toml:"contract"`
+	Endpoints  []string `toml:"endpoints"`
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	RestApiInterface string                      `toml:"rest-api-interface"`
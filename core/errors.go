@@ -7,3 +7,9 @@ var ErrNilMetricsHandler = errors.New("nil metrics handler")
 
 // ErrNilFacadeHandler signal that a nil facade handler has been provided
 var ErrNilFacadeHandler = errors.New("nil facade handler")
+
+// ErrNilLiveFeedHandler signals that a nil live feed handler has been provided
+var ErrNilLiveFeedHandler = errors.New("nil live feed handler")
+
+// ErrNilPayloadsReader signals that a nil payloads reader has been provided
+var ErrNilPayloadsReader = errors.New("nil payloads reader")
@@ -1,13 +1,17 @@
 package core
 
 import (
+	"time"
+
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/metrics"
 )
 
 // StatusMetricsHandler defines the behavior of a component that handles status metrics
 type StatusMetricsHandler interface {
 	AddIndexingData(args metrics.ArgsAddIndexingData)
+	SetGauge(name string, value uint64)
 	GetMetrics() map[string]*request.MetricsResponse
 	GetMetricsForPrometheus() string
 	IsInterfaceNil() bool
@@ -18,3 +22,50 @@ type WebServerHandler interface {
 	StartHttpServer() error
 	Close() error
 }
+
+// LiveFeedHandler defines the behavior of a component that pushes the indexed documents to live subscribers
+type LiveFeedHandler interface {
+	AddSubscriber(conn WSConnection, filter *data.FeedFilter) error
+	Publish(indexedData *data.IndexedBlockData)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// PayloadsReaderHandler defines the behavior of a component that returns the data fields offloaded into the payloads index
+type PayloadsReaderHandler interface {
+	GetPayload(hash string) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// WebhooksNotifier defines the behavior of a component that sends notifications for the indexed documents that match the watch rules
+type WebhooksNotifier interface {
+	Publish(indexedData *data.IndexedBlockData)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TokenHoldersFullCountJob defines the behavior of a component that periodically recomputes the token holders counters
+type TokenHoldersFullCountJob interface {
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// CrossShardReconciler defines the behavior of a component that periodically reconciles the pending cross-shard items
+type CrossShardReconciler interface {
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// MainChainTokensSync defines the behavior of a component that periodically mirrors the main chain tokens changes
+type MainChainTokensSync interface {
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// WSConnection defines the behavior of a websocket connection of a live feed subscriber
+type WSConnection interface {
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	SetWriteDeadline(t time.Time) error
+	Close() error
+}
@@ -15,6 +15,8 @@ const (
 	// ContextKey the key for the value that will be added in the context
 	ContextKey StringKeyType = "key"
 	separator  string        = "_"
+	// PipelineSeparator separates the name of the pipeline from the topic of the metrics
+	PipelineSeparator string = "/"
 	// RemoveTopic is the identifier for the remove requests metrics
 	RemoveTopic string = "req_remove"
 	// GetTopic is the identifier for the get requests metrics
@@ -57,3 +59,23 @@ func SplitTopicAndShardID(topicWithShardID string) (string, string) {
 
 	return strings.Join(split[:shardIDIndex], separator), shardIDStr
 }
+
+// ExtendTopicWithPipeline will prefix the topic with the name of the pipeline. The topics of the unnamed pipeline are
+// not changed
+func ExtendTopicWithPipeline(pipeline string, topic string) string {
+	if pipeline == "" {
+		return topic
+	}
+
+	return pipeline + PipelineSeparator + topic
+}
+
+// SplitPipelineAndTopic will extract the name of the pipeline from the provided topic
+func SplitPipelineAndTopic(topicWithPipeline string) (string, string) {
+	split := strings.SplitN(topicWithPipeline, PipelineSeparator, 2)
+	if len(split) < 2 {
+		return "", topicWithPipeline
+	}
+
+	return split[0], split[1]
+}
@@ -35,3 +35,22 @@ func TestSplitTopicAndShardID(t *testing.T) {
 	require.Equal(t, "req_aaaa", topic)
 	require.Equal(t, noShardID, shardID)
 }
+
+func TestExtendTopicWithPipeline(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "req_bulk_0", ExtendTopicWithPipeline("", "req_bulk_0"))
+	require.Equal(t, "sovereign/req_bulk_0", ExtendTopicWithPipeline("sovereign", "req_bulk_0"))
+}
+
+func TestSplitPipelineAndTopic(t *testing.T) {
+	t.Parallel()
+
+	pipeline, topic := SplitPipelineAndTopic("req_bulk_0")
+	require.Equal(t, "", pipeline)
+	require.Equal(t, "req_bulk_0", topic)
+
+	pipeline, topic = SplitPipelineAndTopic("sovereign/req_bulk_0")
+	require.Equal(t, "sovereign", pipeline)
+	require.Equal(t, "req_bulk_0", topic)
+}
@@ -9,6 +9,7 @@ import (
 // AccountInfo holds (serializable) data about an account
 type AccountInfo struct {
 	Address             string         `json:"address,omitempty"`
+	AddressSecondary    string         `json:"addressSecondary,omitempty"`
 	Nonce               uint64         `json:"nonce,omitempty"`
 	Balance             string         `json:"balance"`
 	BalanceNum          float64        `json:"balanceNum"`
@@ -76,3 +77,15 @@ type AccountDCDT struct {
 	IsNFTOperation  bool
 	IsNFTCreate     bool
 }
+
+// ResponseAccountsDCDT is the structure for the accounts dcdt response
+type ResponseAccountsDCDT struct {
+	Docs []ResponseAccountDCDTDB `json:"docs"`
+}
+
+// ResponseAccountDCDTDB is the structure for the account dcdt response
+type ResponseAccountDCDTDB struct {
+	Found  bool        `json:"found"`
+	ID     string      `json:"_id"`
+	Source AccountInfo `json:"_source"`
+}
@@ -0,0 +1,20 @@
+package data
+
+import "time"
+
+// AddressActivity holds the activity of an address. The document that is serialized for a block holds only the activity
+// of that block, which is added to the already indexed one.
+type AddressActivity struct {
+	Address     string        `json:"address"`
+	ShardID     uint32        `json:"shardID"`
+	FirstSeen   time.Duration `json:"firstSeen"`
+	LastSeen    time.Duration `json:"lastSeen"`
+	SentTxs     uint64        `json:"sentTxs"`
+	ReceivedTxs uint64        `json:"receivedTxs"`
+	ScResults   uint64        `json:"scResults"`
+	Fees        string        `json:"fees"`
+	FeesNum     float64       `json:"feesNum"`
+	Tokens      []string      `json:"tokens"`
+	Contracts   []string      `json:"contracts"`
+	Timestamp   time.Duration `json:"timestamp"`
+}
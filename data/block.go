@@ -47,6 +47,31 @@ type Block struct {
 	SoftwareVersion       string                 `json:"softwareVersion,omitempty"`
 	ReceiptsHash          string                 `json:"receiptsHash,omitempty"`
 	Reserved              []byte                 `json:"reserved,omitempty"`
+
+	ValidatorStatsRootHash        string                    `json:"validatorStatsRootHash,omitempty"`
+	ExtendedShardHeaderHashes     []string                  `json:"extendedShardHeaderHashes,omitempty"`
+	OutGoingMiniBlockHeader       *OutGoingMiniBlockHeader  `json:"outGoingMiniBlockHeader,omitempty"`
+	AccumulatedFeesInEpoch        string                    `json:"accumulatedFeesInEpoch,omitempty"`
+	DeveloperFeesInEpoch          string                    `json:"developerFeesInEpoch,omitempty"`
+	LastFinalizedCrossChainHeader *EpochStartCrossChainData `json:"lastFinalizedCrossChainHeader,omitempty"`
+}
+
+// OutGoingMiniBlockHeader is a structure that holds information about the outgoing bridge operations of a sovereign block
+type OutGoingMiniBlockHeader struct {
+	Hash                                  string   `json:"hash,omitempty"`
+	OutGoingOperationsHash                string   `json:"outGoingOperationsHash,omitempty"`
+	AggregatedSignatureOutGoingOperations string   `json:"aggregatedSignatureOutGoingOperations,omitempty"`
+	LeaderSignatureOutGoingOperations     string   `json:"leaderSignatureOutGoingOperations,omitempty"`
+	OperationsHashes                      []string `json:"operationsHashes,omitempty"`
+}
+
+// EpochStartCrossChainData is a structure that holds information about the last main chain header finalized by an epoch start sovereign block
+type EpochStartCrossChainData struct {
+	ShardID    uint32 `json:"shardID"`
+	Epoch      uint32 `json:"epoch"`
+	Round      uint64 `json:"round"`
+	Nonce      uint64 `json:"nonce"`
+	HeaderHash string `json:"headerHash,omitempty"`
 }
 
 // MiniBlocksDetails is a structure that hold information about mini-blocks execution details
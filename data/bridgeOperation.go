@@ -0,0 +1,19 @@
+package data
+
+import "time"
+
+// BridgeOperation is a structure that holds the information about an outgoing bridge operation committed by a sovereign block
+type BridgeOperation struct {
+	Hash                   string        `json:"-"`
+	Index                  int           `json:"index"`
+	OutGoingMiniBlockHash  string        `json:"outGoingMiniBlockHash"`
+	OutGoingOperationsHash string        `json:"outGoingOperationsHash"`
+	AggregatedSignature    string        `json:"aggregatedSignature,omitempty"`
+	LeaderSignature        string        `json:"leaderSignature,omitempty"`
+	BlockHash              string        `json:"blockHash"`
+	BlockNonce             uint64        `json:"blockNonce"`
+	Round                  uint64        `json:"round"`
+	Epoch                  uint32        `json:"epoch"`
+	ShardID                uint32        `json:"shardID"`
+	Timestamp              time.Duration `json:"timestamp"`
+}
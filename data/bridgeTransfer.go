@@ -0,0 +1,43 @@
+package data
+
+import "time"
+
+// BridgeTransfer is a structure that holds the information about a cross-chain transfer between a sovereign chain and the main chain
+type BridgeTransfer struct {
+	ID                string         `json:"-"`
+	Direction         string         `json:"direction"`
+	OriginTxHash      string         `json:"originTxHash"`
+	DestinationTxHash string         `json:"destinationTxHash,omitempty"`
+	Sender            string         `json:"sender,omitempty"`
+	Receiver          string         `json:"receiver"`
+	Tokens            []*BridgeToken `json:"tokens"`
+	Status            string         `json:"status"`
+	ShardID           uint32         `json:"shardID"`
+	Timestamp         time.Duration  `json:"timestamp"`
+	ExecutedTimestamp time.Duration  `json:"executedTimestamp,omitempty"`
+}
+
+// BridgeToken is a structure that holds a token moved by a cross-chain transfer
+type BridgeToken struct {
+	Identifier       string `json:"identifier"`
+	Token            string `json:"token"`
+	Nonce            uint64 `json:"nonce,omitempty"`
+	Amount           string `json:"amount"`
+	Prefix           string `json:"prefix,omitempty"`
+	IsSovereignToken bool   `json:"isSovereignToken"`
+}
+
+// BridgeTransferConfirmation holds the result of the execution of a cross-chain transfer on the destination chain
+type BridgeTransferConfirmation struct {
+	OriginTxHash      string        `json:"originTxHash"`
+	DestinationTxHash string        `json:"destinationTxHash"`
+	Status            string        `json:"status"`
+	ShardID           uint32        `json:"executedShardID"`
+	Timestamp         time.Duration `json:"executedTimestamp"`
+}
+
+// PreparedBridgeTransfers holds the cross-chain transfers and the confirmations extracted from a block
+type PreparedBridgeTransfers struct {
+	Transfers     []*BridgeTransfer
+	Confirmations []*BridgeTransferConfirmation
+}
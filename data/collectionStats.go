@@ -0,0 +1,43 @@
+package data
+
+import "time"
+
+// CollectionStats holds the statistics of an NFT collection. The document that is serialized for a block holds only the
+// changes done by that block, which are added to the statistics of the collection document from the tokens index.
+type CollectionStats struct {
+	Collection         string        `json:"-"`
+	Minted             string        `json:"minted"`
+	Burned             string        `json:"burned"`
+	Supply             string        `json:"supply"`
+	Holders            int64         `json:"holders"`
+	NFTsWithURIs       int64         `json:"nftsWithURIs"`
+	FirstMintTimestamp time.Duration `json:"firstMintTimestamp,omitempty"`
+	LastMintTimestamp  time.Duration `json:"lastMintTimestamp,omitempty"`
+	ShardID            uint32        `json:"-"`
+	Timestamp          time.Duration `json:"-"`
+}
+
+// CollectionAccountChange holds the change of the number of NFTs of a collection that are held by an address
+type CollectionAccountChange struct {
+	Address    string
+	Collection string
+	Change     int64
+}
+
+// ResponseCollectionsHeld is the structure for the response of the aggregation that counts the NFTs of the collections
+// that are held by the addresses
+type ResponseCollectionsHeld struct {
+	Aggregations struct {
+		Addresses struct {
+			Buckets []struct {
+				Key         string `json:"key"`
+				Collections struct {
+					Buckets []struct {
+						Key      string `json:"key"`
+						DocCount uint64 `json:"doc_count"`
+					} `json:"buckets"`
+				} `json:"collections"`
+			} `json:"buckets"`
+		} `json:"addresses"`
+	} `json:"aggregations"`
+}
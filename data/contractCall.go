@@ -0,0 +1,54 @@
+package data
+
+import "time"
+
+// ContractCall is the structure for a caller to callee edge of the smart contract call graph
+type ContractCall struct {
+	ID             string        `json:"-"`
+	TxHash         string        `json:"txHash"`
+	OriginalTxHash string        `json:"originalTxHash"`
+	PrevTxHash     string        `json:"prevTxHash,omitempty"`
+	Caller         string        `json:"caller"`
+	Callee         string        `json:"callee"`
+	Function       string        `json:"function"`
+	CallType       string        `json:"callType,omitempty"`
+	Value          string        `json:"value"`
+	ValueNum       float64       `json:"valueNum"`
+	Depth          uint32        `json:"depth"`
+	GasLimit       uint64        `json:"gasLimit"`
+	Success        bool          `json:"success"`
+	ShardID        uint32        `json:"shardID"`
+	Timestamp      time.Duration `json:"timestamp"`
+}
+
+// ContractCallStats holds the aggregated inbound calls of a contract. When the caller is set, the document holds only the
+// calls done by that caller, and it is used to count the unique callers of the contract.
+type ContractCallStats struct {
+	ID            string        `json:"-"`
+	Contract      string        `json:"contract"`
+	Caller        string        `json:"caller,omitempty"`
+	InboundCalls  uint64        `json:"inboundCalls"`
+	UniqueCallers uint64        `json:"uniqueCallers"`
+	Failures      uint64        `json:"failures"`
+	Timestamp     time.Duration `json:"timestamp"`
+}
+
+// ContractCallsStats is the DTO that holds the aggregated calls of a block
+type ContractCallsStats struct {
+	Contracts map[string]*ContractCallStats
+	Callers   map[string]*ContractCallStats
+	ShardID   uint32
+	Timestamp uint64
+}
+
+// ResponseContractCallStats is the structure for the contract call stats response
+type ResponseContractCallStats struct {
+	Docs []ResponseContractCallStatsDB `json:"docs"`
+}
+
+// ResponseContractCallStatsDB is the structure for the contract call stats document response
+type ResponseContractCallStatsDB struct {
+	Found  bool               `json:"found"`
+	ID     string             `json:"_id"`
+	Source *ContractCallStats `json:"_source,omitempty"`
+}
@@ -0,0 +1,13 @@
+package data
+
+// DecodedCall holds the function call decoded with the ABI of the called contract
+type DecodedCall struct {
+	Function string                 `json:"function"`
+	Args     map[string]interface{} `json:"args,omitempty"`
+}
+
+// DecodedEvent holds the event decoded with the ABI of the contract that emitted it
+type DecodedEvent struct {
+	Identifier string                 `json:"identifier"`
+	Fields     map[string]interface{} `json:"fields,omitempty"`
+}
@@ -0,0 +1,35 @@
+package data
+
+// IndexedBlockData holds the prepared documents of a block that was successfully written in the database
+type IndexedBlockData struct {
+	Pipeline     string
+	HeaderHash   string
+	Nonce        uint64
+	ShardID      uint32
+	Timestamp    uint64
+	Transactions []*Transaction
+	ScResults    []*ScResult
+	Events       []*LogEvent
+	Tokens       []*TokenInfo
+}
+
+// FeedFilter holds the criteria used to select the documents that are pushed to a live feed subscriber.
+// An empty criterion matches everything
+type FeedFilter struct {
+	Addresses   map[string]struct{}
+	Tokens      map[string]struct{}
+	Identifiers map[string]struct{}
+	ShardIDs    map[uint32]struct{}
+	Pipelines   map[string]struct{}
+}
+
+// NewFeedFilter will create a new instance of FeedFilter with all the criteria empty
+func NewFeedFilter() *FeedFilter {
+	return &FeedFilter{
+		Addresses:   make(map[string]struct{}),
+		Tokens:      make(map[string]struct{}),
+		Identifiers: make(map[string]struct{}),
+		ShardIDs:    make(map[uint32]struct{}),
+		Pipelines:   make(map[string]struct{}),
+	}
+}
@@ -0,0 +1,33 @@
+package data
+
+import "time"
+
+// AccountGuardian is the structure for a document of the guardians index. The document holds the guardian state of
+// an account: the active guardian, the pending guardian that replaces it once its activation epoch is reached and
+// whether the account is guarded.
+type AccountGuardian struct {
+	Address                string        `json:"address"`
+	IsGuarded              bool          `json:"isGuarded"`
+	ActiveGuardian         string        `json:"activeGuardian,omitempty"`
+	ActiveServiceUID       string        `json:"activeServiceUID,omitempty"`
+	PendingGuardian        string        `json:"pendingGuardian,omitempty"`
+	PendingServiceUID      string        `json:"pendingServiceUID,omitempty"`
+	PendingActivationEpoch uint32        `json:"pendingActivationEpoch,omitempty"`
+	TxHash                 string        `json:"txHash"`
+	ShardID                uint32        `json:"shardID"`
+	Timestamp              time.Duration `json:"timestamp"`
+}
+
+// GuardianChange is the structure for a guardian lifecycle event of an account
+type GuardianChange struct {
+	Address         string        `json:"address"`
+	Identifier      string        `json:"identifier"`
+	Guardian        string        `json:"guardian,omitempty"`
+	ServiceUID      string        `json:"serviceUID,omitempty"`
+	IsInstant       bool          `json:"isInstant"`
+	ActivationEpoch uint32        `json:"activationEpoch,omitempty"`
+	Epoch           uint32        `json:"epoch"`
+	TxHash          string        `json:"txHash"`
+	ShardID         uint32        `json:"shardID"`
+	Timestamp       time.Duration `json:"timestamp"`
+}
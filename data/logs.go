@@ -31,13 +31,24 @@ type Event struct {
 type PreparedLogsResults struct {
 	Tokens                  TokensHandler
 	TokensSupply            TokensHandler
+	CollectionsStats        map[string]*CollectionStats
 	ScDeploys               map[string]*ScDeployInfo
 	ChangeOwnerOperations   map[string]*OwnerData
 	Delegators              map[string]*Delegator
+	Providers               map[string]*StakingProvider
+	Nodes                   map[string]*StakingNode
 	TxHashStatusInfo        map[string]*outport.StatusInfo
 	TokensInfo              []*TokenInfo
 	NFTsDataUpdates         []*NFTDataUpdate
 	TokenRolesAndProperties *tokeninfo.TokenRolesAndProperties
 	DBLogs                  []*Logs
 	DBEvents                []*LogEvent
+	CustomEvents            []*CustomEvent
+}
+
+// CustomEvent is a document produced by a custom events processor, which is saved in the index chosen by the processor
+type CustomEvent struct {
+	Index  string
+	ID     string
+	Fields map[string]interface{}
 }
@@ -0,0 +1,24 @@
+package data
+
+import "time"
+
+// Payload is the structure that holds the full data field of a transaction or of a smart contract result, when it is
+// too large to be stored in the document of the transaction. The data is compressed with gzip
+type Payload struct {
+	Hash      string        `json:"-"`
+	Data      []byte        `json:"data"`
+	Length    int           `json:"length"`
+	Timestamp time.Duration `json:"timestamp"`
+}
+
+// ResponsePayloads is the structure for the payloads response
+type ResponsePayloads struct {
+	Docs []ResponsePayloadDB `json:"docs"`
+}
+
+// ResponsePayloadDB is the structure for the payload response
+type ResponsePayloadDB struct {
+	Found  bool    `json:"found"`
+	ID     string  `json:"_id"`
+	Source Payload `json:"_source"`
+}
@@ -0,0 +1,27 @@
+package data
+
+import "time"
+
+// StakingProvider is the structure for a document of the providers index. The fields that are not changed by an event
+// are empty, so the document keeps the values set by the previous events.
+type StakingProvider struct {
+	Address            string        `json:"address"`
+	Owner              string        `json:"owner,omitempty"`
+	ServiceFee         *uint64       `json:"serviceFee,omitempty"`
+	TotalDelegationCap string        `json:"totalDelegationCap,omitempty"`
+	Name               string        `json:"name,omitempty"`
+	Website            string        `json:"website,omitempty"`
+	Identity           string        `json:"identity,omitempty"`
+	Timestamp          time.Duration `json:"timestamp"`
+}
+
+// StakingNode is the structure for a document of the nodes index, which maps the BLS key of a node to its owner and
+// to the staking provider that manages it, if any
+type StakingNode struct {
+	BLSKey    string        `json:"blsKey"`
+	Owner     string        `json:"owner"`
+	Provider  string        `json:"provider,omitempty"`
+	Status    string        `json:"status"`
+	TxHash    string        `json:"txHash"`
+	Timestamp time.Duration `json:"timestamp"`
+}
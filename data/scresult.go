@@ -14,12 +14,16 @@ type ScResult struct {
 	ValueNum           float64       `json:"valueNum"`
 	Sender             string        `json:"sender"`
 	Receiver           string        `json:"receiver"`
+	SenderSecondary    string        `json:"senderSecondary,omitempty"`
+	ReceiverSecondary  string        `json:"receiverSecondary,omitempty"`
 	SenderShard        uint32        `json:"senderShard"`
 	ReceiverShard      uint32        `json:"receiverShard"`
 	RelayerAddr        string        `json:"relayerAddr,omitempty"`
 	RelayedValue       string        `json:"relayedValue,omitempty"`
 	Code               string        `json:"code,omitempty"`
 	Data               []byte        `json:"data,omitempty"`
+	DataHash           string        `json:"dataHash,omitempty"`
+	DataLength         int           `json:"dataLength,omitempty"`
 	PrevTxHash         string        `json:"prevTxHash"`
 	OriginalTxHash     string        `json:"originalTxHash"`
 	CallType           string        `json:"callType"`
@@ -36,6 +40,7 @@ type ScResult struct {
 	ReceiversShardIDs  []uint32      `json:"receiversShardIDs,omitempty"`
 	Operation          string        `json:"operation,omitempty"`
 	Function           string        `json:"function,omitempty"`
+	DecodedData        *DecodedCall  `json:"decodedData,omitempty"`
 	IsRelayed          bool          `json:"isRelayed,omitempty"`
 	CanBeIgnored       bool          `json:"canBeIgnored,omitempty"`
 	OriginalSender     string        `json:"originalSender,omitempty"`
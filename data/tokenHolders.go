@@ -0,0 +1,11 @@
+package data
+
+// TokenHolders holds the holders counters and the circulating supply of a token. The document that is serialized for a
+// block holds only the changes done by that block, which are added to the indexed values.
+type TokenHolders struct {
+	Identifier           string  `json:"-"`
+	HoldersCount         int64   `json:"holdersCount"`
+	AccountsCount        int64   `json:"accountsCount"`
+	CirculatingSupply    string  `json:"circulatingSupply"`
+	CirculatingSupplyNum float64 `json:"circulatingSupplyNum"`
+}
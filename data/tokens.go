@@ -74,6 +74,7 @@ type TokenInfo struct {
 	TransferOwnership bool             `json:"-"`
 	ChangeToDynamic   bool             `json:"-"`
 	Properties        *TokenProperties `json:"properties,omitempty"`
+	PendingResolution bool             `json:"pendingResolution,omitempty"`
 }
 
 // TokenProperties is a structure that is needed to store all properties of a token
@@ -16,6 +16,8 @@ type Transaction struct {
 	ValueNum             float64       `json:"valueNum"`
 	Receiver             string        `json:"receiver"`
 	Sender               string        `json:"sender"`
+	ReceiverSecondary    string        `json:"receiverSecondary,omitempty"`
+	SenderSecondary      string        `json:"senderSecondary,omitempty"`
 	ReceiverShard        uint32        `json:"receiverShard"`
 	SenderShard          uint32        `json:"senderShard"`
 	GasPrice             uint64        `json:"gasPrice"`
@@ -25,6 +27,8 @@ type Transaction struct {
 	FeeNum               float64       `json:"feeNum"`
 	InitialPaidFee       string        `json:"initialPaidFee,omitempty"`
 	Data                 []byte        `json:"data"`
+	DataHash             string        `json:"dataHash,omitempty"`
+	DataLength           int           `json:"dataLength,omitempty"`
 	Signature            string        `json:"signature"`
 	Timestamp            time.Duration `json:"timestamp"`
 	Status               string        `json:"status"`
@@ -43,6 +47,7 @@ type Transaction struct {
 	Type                 string        `json:"type,omitempty"`
 	Operation            string        `json:"operation,omitempty"`
 	Function             string        `json:"function,omitempty"`
+	DecodedData          *DecodedCall  `json:"decodedData,omitempty"`
 	IsRelayed            bool          `json:"isRelayed,omitempty"`
 	Version              uint32        `json:"version,omitempty"`
 	GuardianAddress      string        `json:"guardian,omitempty"`
@@ -0,0 +1,23 @@
+package data
+
+import "time"
+
+// Transfer is a structure containing all the fields that need to be saved for a single value movement
+type Transfer struct {
+	ID             string        `json:"-"`
+	TxHash         string        `json:"txHash"`
+	OriginalTxHash string        `json:"originalTxHash"`
+	Sender         string        `json:"sender"`
+	Receiver       string        `json:"receiver"`
+	SenderShard    uint32        `json:"senderShard"`
+	ReceiverShard  uint32        `json:"receiverShard"`
+	Token          string        `json:"token"`
+	Identifier     string        `json:"identifier"`
+	Nonce          uint64        `json:"nonce,omitempty"`
+	Amount         string        `json:"amount"`
+	AmountNum      float64       `json:"amountNum"`
+	Direction      string        `json:"direction"`
+	Source         string        `json:"source"`
+	ShardID        uint32        `json:"shardID"`
+	Timestamp      time.Duration `json:"timestamp"`
+}
@@ -0,0 +1,15 @@
+package data
+
+import "time"
+
+// TxStatusTransition is the structure for a status transition of a transaction or of a smart contract result. The
+// transitions are never removed, so the history explains the status changes caused by forks or by delayed results.
+type TxStatusTransition struct {
+	ID        string        `json:"-"`
+	TxHash    string        `json:"txHash"`
+	Type      string        `json:"type"`
+	Status    string        `json:"status,omitempty"`
+	Cause     string        `json:"cause"`
+	ShardID   uint32        `json:"shardID"`
+	Timestamp time.Duration `json:"timestamp"`
+}
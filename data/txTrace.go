@@ -0,0 +1,81 @@
+package data
+
+import "time"
+
+// TxTrace is the structure that holds, in a single document, all the pieces of the execution of an original transaction.
+// Every item of the lists has an ID, which is used to merge the pieces that arrive in different blocks.
+type TxTrace struct {
+	ID             string               `json:"-"`
+	Sender         string               `json:"sender,omitempty"`
+	Receiver       string               `json:"receiver,omitempty"`
+	Value          string               `json:"value,omitempty"`
+	Function       string               `json:"function,omitempty"`
+	Status         string               `json:"status,omitempty"`
+	Fee            string               `json:"fee,omitempty"`
+	FeeNum         float64              `json:"feeNum,omitempty"`
+	GasUsed        uint64               `json:"gasUsed,omitempty"`
+	InitialPaidFee string               `json:"initialPaidFee,omitempty"`
+	Timestamp      time.Duration        `json:"timestamp,omitempty"`
+	ScResults      []*TraceScResult     `json:"scResults"`
+	Events         []*TraceEvent        `json:"events"`
+	Receipts       []*TraceReceipt      `json:"receipts"`
+	Refunds        []*TraceRefund       `json:"refunds"`
+	StatusChanges  []*TraceStatusChange `json:"statusChanges"`
+}
+
+// TraceScResult is the structure for a smart contract result of a transaction trace
+type TraceScResult struct {
+	ID            string        `json:"id"`
+	PrevTxHash    string        `json:"prevTxHash"`
+	Sender        string        `json:"sender"`
+	Receiver      string        `json:"receiver"`
+	Value         string        `json:"value"`
+	Function      string        `json:"function,omitempty"`
+	CallType      string        `json:"callType,omitempty"`
+	ReturnMessage string        `json:"returnMessage,omitempty"`
+	Order         int           `json:"order"`
+	ShardID       uint32        `json:"shardID"`
+	Timestamp     time.Duration `json:"timestamp"`
+}
+
+// TraceEvent is the structure for a log event of a transaction trace
+type TraceEvent struct {
+	ID          string        `json:"id"`
+	TxHash      string        `json:"txHash"`
+	Address     string        `json:"address"`
+	Identifier  string        `json:"identifier"`
+	Topics      []string      `json:"topics,omitempty"`
+	Data        string        `json:"data,omitempty"`
+	DecodedData *DecodedEvent `json:"decodedData,omitempty"`
+	Order       int           `json:"order"`
+	ShardID     uint32        `json:"shardID"`
+	Timestamp   time.Duration `json:"timestamp"`
+}
+
+// TraceReceipt is the structure for a receipt of a transaction trace
+type TraceReceipt struct {
+	ID        string        `json:"id"`
+	Sender    string        `json:"sender"`
+	Value     string        `json:"value"`
+	Data      string        `json:"data,omitempty"`
+	ShardID   uint32        `json:"shardID"`
+	Timestamp time.Duration `json:"timestamp"`
+}
+
+// TraceRefund is the structure for a refund of a transaction trace
+type TraceRefund struct {
+	ID          string        `json:"id"`
+	Receiver    string        `json:"receiver"`
+	Value       string        `json:"value"`
+	GasRefunded uint64        `json:"gasRefunded"`
+	ShardID     uint32        `json:"shardID"`
+	Timestamp   time.Duration `json:"timestamp"`
+}
+
+// TraceStatusChange is the structure for a status change of a transaction trace
+type TraceStatusChange struct {
+	ID        string        `json:"id"`
+	Status    string        `json:"status"`
+	ShardID   uint32        `json:"shardID"`
+	Timestamp time.Duration `json:"timestamp"`
+}
@@ -0,0 +1,24 @@
+package data
+
+import "time"
+
+// Username is the structure for a document of the usernames registry. The document holds the current owner of the
+// username and the history of its registrations. A username is superseded when its owner registers another one.
+type Username struct {
+	UserName            string            `json:"userName"`
+	Address             string            `json:"address"`
+	TxHash              string            `json:"txHash"`
+	ShardID             uint32            `json:"shardID"`
+	Timestamp           time.Duration     `json:"timestamp"`
+	SupersededBy        string            `json:"supersededBy,omitempty"`
+	SupersededTimestamp time.Duration     `json:"supersededTimestamp,omitempty"`
+	History             []*UsernameChange `json:"history"`
+}
+
+// UsernameChange is the structure for a registration of a username
+type UsernameChange struct {
+	Address   string        `json:"address"`
+	TxHash    string        `json:"txHash"`
+	ShardID   uint32        `json:"shardID"`
+	Timestamp time.Duration `json:"timestamp"`
+}
@@ -0,0 +1,32 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/reconciliation"
+)
+
+// CreateCrossShardReconciler will create a new instance of core.CrossShardReconciler that reconciles the pending items
+// in the cluster and under the index namespace of the provided pipeline
+func CreateCrossShardReconciler(clusterCfg config.ClusterConfig, pipelineCfg config.PipelineConfig, statusMetrics elasticproc.GaugeMetricsHandler) (core.CrossShardReconciler, error) {
+	reconciliationCfg := clusterCfg.Config.CrossShardReconciliation
+	if reconciliationCfg.IntervalInSeconds == 0 {
+		return reconciliation.NewDisabledCrossShardReconciler(), nil
+	}
+
+	databaseClient, err := createPipelineDatabaseClient(pipelineCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliation.NewCrossShardReconciler(reconciliation.ArgsCrossShardReconciler{
+		DBClient:           databaseClient,
+		StatusMetrics:      statusMetrics,
+		Interval:           time.Duration(reconciliationCfg.IntervalInSeconds) * time.Second,
+		PendingTimeout:     time.Duration(reconciliationCfg.PendingTimeoutInSeconds) * time.Second,
+		BulkRequestMaxSize: pipelineCfg.ElasticCluster.BulkRequestMaxSizeInBytes,
+	})
+}
@@ -0,0 +1,23 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/livefeed"
+)
+
+// CreateLiveFeed will create a new instance of core.LiveFeedHandler
+func CreateLiveFeed(clusterCfg config.ClusterConfig) (core.LiveFeedHandler, error) {
+	liveFeedCfg := clusterCfg.Config.LiveFeed
+	if !liveFeedCfg.Enabled {
+		return livefeed.NewDisabledLiveFeed(), nil
+	}
+
+	return livefeed.NewLiveFeed(livefeed.ArgsLiveFeed{
+		MaxSubscribers: liveFeedCfg.MaxSubscribers,
+		SendBufferSize: liveFeedCfg.SendBufferSize,
+		WriteTimeout:   time.Duration(liveFeedCfg.WriteTimeoutInSec) * time.Second,
+	})
+}
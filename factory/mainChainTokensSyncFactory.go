@@ -0,0 +1,51 @@
+package factory
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/client"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/client/logging"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/factory/runType"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/tokens"
+)
+
+// CreateMainChainTokensSync will create a new instance of core.MainChainTokensSync that updates the tokens in the cluster
+// and under the index namespace of the provided pipeline. The sync is enabled only for the sovereign pipelines
+func CreateMainChainTokensSync(cfg config.Config, clusterCfg config.ClusterConfig, pipelineCfg config.PipelineConfig) (core.MainChainTokensSync, error) {
+	mainChainCfg := clusterCfg.Config.MainChainCluster
+	syncInterval := mainChainCfg.TokensSyncIntervalInSec
+	isSovereign := getRunType(cfg, pipelineCfg) == runType.SovereignChainRunType
+	if !isSovereign || !mainChainCfg.Enabled || syncInterval == 0 {
+		return tokens.NewDisabledMainChainTokensSync(), nil
+	}
+
+	databaseClient, err := createPipelineDatabaseClient(pipelineCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mainChainDatabaseClient, err := client.NewElasticClient(elasticsearch.Config{
+		Addresses:     []string{mainChainCfg.URL},
+		Username:      mainChainCfg.UserName,
+		Password:      mainChainCfg.Password,
+		Logger:        &logging.CustomLogger{},
+		RetryOnStatus: []int{http.StatusConflict},
+		RetryBackoff:  client.RetryBackOff,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens.NewMainChainTokensSync(tokens.ArgsMainChainTokensSync{
+		DBClient:           databaseClient,
+		MainChainDBClient:  mainChainDatabaseClient,
+		DCDTPrefix:         cfg.Config.DCDTPrefix,
+		Interval:           time.Duration(syncInterval) * time.Second,
+		BulkRequestMaxSize: pipelineCfg.ElasticCluster.BulkRequestMaxSizeInBytes,
+	})
+}
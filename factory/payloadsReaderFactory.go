@@ -0,0 +1,34 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/payloads"
+)
+
+// CreatePayloadsReader will create a new instance of core.PayloadsReaderHandler, which reads the offloaded payloads
+// from the payloads indexes of all the pipelines
+func CreatePayloadsReader(cfg config.Config, clusterCfg config.ClusterConfig) (core.PayloadsReaderHandler, error) {
+	if !cfg.Config.PayloadsOffloading.Enabled {
+		return payloads.NewDisabledPayloadsReader(), nil
+	}
+
+	pipelinesCfg, err := getPipelinesConfig(clusterCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseClients := make([]payloads.DatabaseClientHandler, 0, len(pipelinesCfg))
+	for _, pipelineCfg := range pipelinesCfg {
+		databaseClient, errCreate := createPipelineDatabaseClient(pipelineCfg)
+		if errCreate != nil {
+			return nil, fmt.Errorf("%w for pipeline %s", errCreate, pipelineCfg.Name)
+		}
+
+		databaseClients = append(databaseClients, databaseClient)
+	}
+
+	return payloads.NewPayloadsReader(databaseClients...)
+}
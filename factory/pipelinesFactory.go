@@ -0,0 +1,203 @@
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/client"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/client/logging"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/metrics"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/wsindexer"
+)
+
+// Pipeline holds the websocket host of an indexing pipeline and the background jobs that run on its indexes
+type Pipeline struct {
+	Name                     string
+	Host                     wsindexer.WSClient
+	TokenHoldersFullCountJob core.TokenHoldersFullCountJob
+	MainChainTokensSync      core.MainChainTokensSync
+	CrossShardReconciler     core.CrossShardReconciler
+	RetryDuration            time.Duration
+}
+
+// Close will close the websocket host and the background jobs of the pipeline
+func (p *Pipeline) Close() {
+	err := p.Host.Close()
+	if err != nil {
+		log.Error("cannot close ws indexer", "pipeline", p.Name, "error", err)
+	}
+
+	err = p.TokenHoldersFullCountJob.Close()
+	if err != nil {
+		log.Error("cannot close token holders full count job", "pipeline", p.Name, "error", err)
+	}
+
+	err = p.MainChainTokensSync.Close()
+	if err != nil {
+		log.Error("cannot close main chain tokens sync", "pipeline", p.Name, "error", err)
+	}
+
+	err = p.CrossShardReconciler.Close()
+	if err != nil {
+		log.Error("cannot close cross-shard reconciler", "pipeline", p.Name, "error", err)
+	}
+}
+
+// CreatePipelines will create a websocket indexer and the background jobs for every configured pipeline. The pipelines
+// share the status metrics, the live feed and the webhooks notifier, and the documents pushed to them are tagged with
+// the name of the pipeline. When no pipeline is configured, a single unnamed pipeline is created from the web socket and
+// elastic cluster configs
+func CreatePipelines(
+	cfg config.Config,
+	clusterCfg config.ClusterConfig,
+	statusMetrics metrics.PipelinesStatusMetricsHandler,
+	liveFeed core.LiveFeedHandler,
+	webhooksNotifier core.WebhooksNotifier,
+	version string,
+) ([]*Pipeline, error) {
+	pipelinesCfg, err := getPipelinesConfig(clusterCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelines := make([]*Pipeline, 0, len(pipelinesCfg))
+	for _, pipelineCfg := range pipelinesCfg {
+		pipeline, errCreate := createPipeline(cfg, clusterCfg, pipelineCfg, statusMetrics, liveFeed, webhooksNotifier, version)
+		if errCreate != nil {
+			ClosePipelines(pipelines)
+			return nil, fmt.Errorf("%w for pipeline %s", errCreate, pipelineCfg.Name)
+		}
+
+		pipelines = append(pipelines, pipeline)
+	}
+
+	return pipelines, nil
+}
+
+func createPipeline(
+	cfg config.Config,
+	clusterCfg config.ClusterConfig,
+	pipelineCfg config.PipelineConfig,
+	statusMetrics metrics.PipelinesStatusMetricsHandler,
+	liveFeed core.LiveFeedHandler,
+	webhooksNotifier core.WebhooksNotifier,
+	version string,
+) (*Pipeline, error) {
+	tokenHoldersFullCountJob, err := CreateTokenHoldersFullCountJob(cfg, clusterCfg, pipelineCfg)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the token holders full count job", err)
+	}
+
+	mainChainTokensSync, err := CreateMainChainTokensSync(cfg, clusterCfg, pipelineCfg)
+	if err != nil {
+		_ = tokenHoldersFullCountJob.Close()
+		return nil, fmt.Errorf("%w while creating the main chain tokens sync", err)
+	}
+
+	pipelineMetrics := metrics.NewPipelineStatusMetrics(statusMetrics, pipelineCfg.Name)
+	crossShardReconciler, err := CreateCrossShardReconciler(clusterCfg, pipelineCfg, pipelineMetrics)
+	if err != nil {
+		_ = tokenHoldersFullCountJob.Close()
+		_ = mainChainTokensSync.Close()
+		return nil, fmt.Errorf("%w while creating the cross-shard reconciler", err)
+	}
+
+	host, err := CreateWsIndexer(cfg, clusterCfg, pipelineCfg, pipelineMetrics, liveFeed, webhooksNotifier, version)
+	if err != nil {
+		_ = tokenHoldersFullCountJob.Close()
+		_ = mainChainTokensSync.Close()
+		_ = crossShardReconciler.Close()
+		return nil, err
+	}
+
+	return &Pipeline{
+		Name:                     pipelineCfg.Name,
+		Host:                     host,
+		TokenHoldersFullCountJob: tokenHoldersFullCountJob,
+		MainChainTokensSync:      mainChainTokensSync,
+		CrossShardReconciler:     crossShardReconciler,
+		RetryDuration:            time.Duration(pipelineCfg.WebSocket.RetryDurationInSec) * time.Second,
+	}, nil
+}
+
+// the background jobs of a pipeline use its cluster and the indexes of its namespace, as its indexer does
+func createPipelineDatabaseClient(pipelineCfg config.PipelineConfig) (elasticproc.DatabaseClientHandler, error) {
+	databaseClient, err := client.NewElasticClient(elasticsearch.Config{
+		Addresses:     []string{pipelineCfg.ElasticCluster.URL},
+		Username:      pipelineCfg.ElasticCluster.UserName,
+		Password:      pipelineCfg.ElasticCluster.Password,
+		Logger:        &logging.CustomLogger{},
+		RetryOnStatus: []int{http.StatusConflict},
+		RetryBackoff:  client.RetryBackOff,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if pipelineCfg.IndexNamespace == "" {
+		return databaseClient, nil
+	}
+
+	return client.NewNamespacedElasticClient(databaseClient, pipelineCfg.IndexNamespace)
+}
+
+func getPipelinesConfig(clusterCfg config.ClusterConfig) ([]config.PipelineConfig, error) {
+	if len(clusterCfg.Config.Pipelines) > 0 {
+		return clusterCfg.Config.Pipelines, checkPipelinesConfig(clusterCfg.Config.Pipelines)
+	}
+
+	return []config.PipelineConfig{
+		{
+			WebSocket:      clusterCfg.Config.WebSocket,
+			ElasticCluster: clusterCfg.Config.ElasticCluster,
+		},
+	}, nil
+}
+
+// the pipelines are identified by their name in the logs and in the metrics, so the names have to be unique, and two
+// pipelines can neither listen on the same websocket endpoint nor write in the same indexes
+func checkPipelinesConfig(pipelinesCfg []config.PipelineConfig) error {
+	names := make(map[string]struct{})
+	wsURLs := make(map[string]struct{})
+	namespaces := make(map[string]struct{})
+	for _, pipelineCfg := range pipelinesCfg {
+		if pipelineCfg.Name == "" || strings.Contains(pipelineCfg.Name, request.PipelineSeparator) {
+			return fmt.Errorf("%w: invalid name %q", dataindexer.ErrInvalidPipelineConfig, pipelineCfg.Name)
+		}
+		if _, found := names[pipelineCfg.Name]; found {
+			return fmt.Errorf("%w: duplicated name %s", dataindexer.ErrInvalidPipelineConfig, pipelineCfg.Name)
+		}
+		names[pipelineCfg.Name] = struct{}{}
+
+		if _, found := wsURLs[pipelineCfg.WebSocket.URL]; found {
+			return fmt.Errorf("%w: pipeline %s uses the web socket url %s of another pipeline",
+				dataindexer.ErrInvalidPipelineConfig, pipelineCfg.Name, pipelineCfg.WebSocket.URL)
+		}
+		wsURLs[pipelineCfg.WebSocket.URL] = struct{}{}
+
+		namespaceKey := pipelineCfg.ElasticCluster.URL + "/" + pipelineCfg.IndexNamespace
+		if _, found := namespaces[namespaceKey]; found {
+			return fmt.Errorf("%w: pipeline %s uses the index namespace %q of another pipeline on the cluster %s",
+				dataindexer.ErrInvalidPipelineConfig, pipelineCfg.Name, pipelineCfg.IndexNamespace, pipelineCfg.ElasticCluster.URL)
+		}
+		namespaces[namespaceKey] = struct{}{}
+	}
+
+	return nil
+}
+
+// ClosePipelines will close the provided pipelines
+func ClosePipelines(pipelines []*Pipeline) {
+	for _, pipeline := range pipelines {
+		pipeline.Close()
+	}
+}
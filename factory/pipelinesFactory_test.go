@@ -0,0 +1,107 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func createMockPipelinesConfig() []config.PipelineConfig {
+	mainChain := config.PipelineConfig{
+		Name:           "main-chain",
+		IndexNamespace: "main",
+	}
+	mainChain.WebSocket.URL = "localhost:22111"
+	mainChain.ElasticCluster.URL = "http://localhost:9200"
+
+	sovereign := config.PipelineConfig{
+		Name:           "sovereign",
+		RunType:        "sovereign",
+		IndexNamespace: "sovereign",
+	}
+	sovereign.WebSocket.URL = "localhost:22112"
+	sovereign.ElasticCluster.URL = "http://localhost:9200"
+
+	return []config.PipelineConfig{mainChain, sovereign}
+}
+
+func TestGetPipelinesConfig(t *testing.T) {
+	t.Parallel()
+
+	clusterCfg := config.ClusterConfig{}
+	clusterCfg.Config.WebSocket.URL = "localhost:22111"
+	clusterCfg.Config.ElasticCluster.URL = "http://localhost:9200"
+
+	pipelinesCfg, err := getPipelinesConfig(clusterCfg)
+	require.Nil(t, err)
+	require.Len(t, pipelinesCfg, 1)
+	require.Equal(t, "", pipelinesCfg[0].Name)
+	require.Equal(t, "", pipelinesCfg[0].IndexNamespace)
+	require.Equal(t, clusterCfg.Config.WebSocket, pipelinesCfg[0].WebSocket)
+	require.Equal(t, clusterCfg.Config.ElasticCluster, pipelinesCfg[0].ElasticCluster)
+
+	clusterCfg.Config.Pipelines = createMockPipelinesConfig()
+	pipelinesCfg, err = getPipelinesConfig(clusterCfg)
+	require.Nil(t, err)
+	require.Equal(t, clusterCfg.Config.Pipelines, pipelinesCfg)
+}
+
+func TestCheckPipelinesConfig(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, checkPipelinesConfig(createMockPipelinesConfig()))
+
+	pipelinesCfg := createMockPipelinesConfig()
+	pipelinesCfg[0].Name = ""
+	require.True(t, errors.Is(checkPipelinesConfig(pipelinesCfg), dataindexer.ErrInvalidPipelineConfig))
+
+	pipelinesCfg = createMockPipelinesConfig()
+	pipelinesCfg[0].Name = "main/chain"
+	require.True(t, errors.Is(checkPipelinesConfig(pipelinesCfg), dataindexer.ErrInvalidPipelineConfig))
+
+	pipelinesCfg = createMockPipelinesConfig()
+	pipelinesCfg[1].Name = pipelinesCfg[0].Name
+	require.ErrorContains(t, checkPipelinesConfig(pipelinesCfg), "duplicated name")
+
+	pipelinesCfg = createMockPipelinesConfig()
+	pipelinesCfg[1].WebSocket.URL = pipelinesCfg[0].WebSocket.URL
+	require.ErrorContains(t, checkPipelinesConfig(pipelinesCfg), "web socket url")
+
+	pipelinesCfg = createMockPipelinesConfig()
+	pipelinesCfg[1].IndexNamespace = pipelinesCfg[0].IndexNamespace
+	require.ErrorContains(t, checkPipelinesConfig(pipelinesCfg), "index namespace")
+
+	pipelinesCfg[1].ElasticCluster.URL = "http://localhost:9201"
+	require.Nil(t, checkPipelinesConfig(pipelinesCfg))
+}
+
+func TestGetRunType(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	require.Equal(t, "regular", getRunType(cfg, config.PipelineConfig{}))
+	require.Equal(t, "sovereign", getRunType(cfg, config.PipelineConfig{RunType: "sovereign"}))
+
+	cfg.Sovereign = true
+	require.Equal(t, "sovereign", getRunType(cfg, config.PipelineConfig{}))
+	require.Equal(t, "regular", getRunType(cfg, config.PipelineConfig{RunType: "regular"}))
+}
+
+func TestCreatePipelineDatabaseClient(t *testing.T) {
+	t.Parallel()
+
+	pipelinesCfg := createMockPipelinesConfig()
+	pipelinesCfg[0].IndexNamespace = ""
+	databaseClient, err := createPipelineDatabaseClient(pipelinesCfg[0])
+	require.Nil(t, err)
+	require.Equal(t, "*client.elasticClient", fmt.Sprintf("%T", databaseClient))
+
+	databaseClient, err = createPipelineDatabaseClient(pipelinesCfg[1])
+	require.Nil(t, err)
+	require.Equal(t, "*client.namespacedElasticClient", fmt.Sprintf("%T", databaseClient))
+}
@@ -0,0 +1,41 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/pubkeyConverter"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+const (
+	bech32PubkeyConverterType = "bech32"
+	hexPubkeyConverterType    = "hex"
+	base64PubkeyConverterType = "base64"
+)
+
+func createPubkeyConverter(converterType string, length int, prefix string) (core.PubkeyConverter, error) {
+	switch converterType {
+	case bech32PubkeyConverterType:
+		return pubkeyConverter.NewBech32PubkeyConverter(length, prefix)
+	case hexPubkeyConverterType:
+		return pubkeyConverter.NewHexPubkeyConverter(length)
+	case base64PubkeyConverterType:
+		return converters.NewBase64PubkeyConverter(length)
+	default:
+		return nil, fmt.Errorf("%w: %s", dataindexer.ErrUnknownPubkeyConverterType, converterType)
+	}
+}
+
+// the secondary encoding of the addresses is optional, a nil converter disables it
+func createSecondaryPubkeyConverter(cfg config.Config) (core.PubkeyConverter, error) {
+	addressConverterCfg := cfg.Config.AddressConverter
+	if addressConverterCfg.SecondaryType == "" {
+		return nil, nil
+	}
+
+	return createPubkeyConverter(addressConverterCfg.SecondaryType, addressConverterCfg.Length, addressConverterCfg.SecondaryPrefix)
+}
@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func TestCreatePubkeyConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := createPubkeyConverter("bech32", 32, "sov")
+	require.Nil(t, err)
+	require.Equal(t, "*pubkeyConverter.bech32PubkeyConverter", fmt.Sprintf("%T", converter))
+	encoded, _ := converter.Encode(make([]byte, 32))
+	require.Regexp(t, "^sov1", encoded)
+
+	converter, err = createPubkeyConverter("hex", 96, "")
+	require.Nil(t, err)
+	require.Equal(t, "*pubkeyConverter.hexPubkeyConverter", fmt.Sprintf("%T", converter))
+
+	converter, err = createPubkeyConverter("base64", 32, "")
+	require.Nil(t, err)
+	require.Equal(t, "*converters.base64PubkeyConverter", fmt.Sprintf("%T", converter))
+
+	converter, err = createPubkeyConverter("unknown", 32, "")
+	require.Nil(t, converter)
+	require.True(t, errors.Is(err, dataindexer.ErrUnknownPubkeyConverterType))
+}
+
+func TestCreateSecondaryPubkeyConverter(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	cfg.Config.AddressConverter.Length = 32
+
+	converter, err := createSecondaryPubkeyConverter(cfg)
+	require.Nil(t, err)
+	require.Nil(t, converter)
+
+	cfg.Config.AddressConverter.SecondaryType = "hex"
+	converter, err = createSecondaryPubkeyConverter(cfg)
+	require.Nil(t, err)
+	require.Equal(t, 32, converter.Len())
+}
@@ -0,0 +1,69 @@
+package runType
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/block"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+)
+
+// the indexes that hold data produced only by a sovereign chain
+var sovereignOnlyIndexes = map[string]struct{}{
+	dataindexer.BridgeOperationsIndex: {},
+	dataindexer.BridgeTransfersIndex:  {},
+}
+
+func getRegularChainIndexes() []string {
+	regularIndexes := make([]string, 0)
+	for _, index := range elasticproc.GetAllIndexes() {
+		_, isSovereignOnly := sovereignOnlyIndexes[index]
+		if !isSovereignOnly {
+			regularIndexes = append(regularIndexes, index)
+		}
+	}
+
+	return regularIndexes
+}
+
+func getSovereignChainIndexes() []string {
+	return elasticproc.GetAllIndexes()
+}
+
+func createRegularBlockCreatorsContainer() (dataindexer.BlockContainerHandler, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := addRegularBlockCreators(container)
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// the sovereign chain also keeps the regular header creators, as the shard headers can be received from the main chain
+func createSovereignBlockCreatorsContainer() (dataindexer.BlockContainerHandler, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := addRegularBlockCreators(container)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.SovereignChainHeader, block.NewEmptySovereignHeaderCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+func addRegularBlockCreators(container blockCreatorsContainer) error {
+	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	if err != nil {
+		return err
+	}
+	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	if err != nil {
+		return err
+	}
+
+	return container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+}
@@ -0,0 +1,45 @@
+package runType
+
+import (
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+)
+
+func TestGetChainIndexes(t *testing.T) {
+	t.Parallel()
+
+	regularIndexes := getRegularChainIndexes()
+	require.Len(t, regularIndexes, len(elasticproc.GetAllIndexes())-len(sovereignOnlyIndexes))
+	require.Contains(t, regularIndexes, dataindexer.TransactionsIndex)
+	require.NotContains(t, regularIndexes, dataindexer.BridgeOperationsIndex)
+	require.NotContains(t, regularIndexes, dataindexer.BridgeTransfersIndex)
+
+	sovereignIndexes := getSovereignChainIndexes()
+	require.Contains(t, sovereignIndexes, dataindexer.BridgeOperationsIndex)
+	require.Contains(t, sovereignIndexes, dataindexer.BridgeTransfersIndex)
+}
+
+func TestCreateBlockCreatorsContainers(t *testing.T) {
+	t.Parallel()
+
+	regularContainer, err := createRegularBlockCreatorsContainer()
+	require.Nil(t, err)
+	for _, headerType := range []core.HeaderType{core.ShardHeaderV1, core.ShardHeaderV2, core.MetaHeader} {
+		_, err = regularContainer.Get(headerType)
+		require.Nil(t, err)
+	}
+	_, err = regularContainer.Get(core.SovereignChainHeader)
+	require.NotNil(t, err)
+
+	sovereignContainer, err := createSovereignBlockCreatorsContainer()
+	require.Nil(t, err)
+	_, err = sovereignContainer.Get(core.SovereignChainHeader)
+	require.Nil(t, err)
+	_, err = sovereignContainer.Get(core.ShardHeaderV2)
+	require.Nil(t, err)
+}
@@ -5,3 +5,11 @@ import (
 )
 
 var errNilRunTypeComponents = errors.New("nil run type components")
+
+var errNilRunTypeComponentsFactoryCreator = errors.New("nil run type components factory creator")
+
+var errRunTypeAlreadyRegistered = errors.New("run type already registered")
+
+var errUnknownRunType = errors.New("unknown run type")
+
+var errNilBlockCreatorsContainer = errors.New("nil block creators container")
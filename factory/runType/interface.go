@@ -1,7 +1,12 @@
 package runType
 
 import (
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/block"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
 )
 
@@ -30,9 +35,19 @@ type RunTypeComponentsHolder interface {
 	TxHashExtractorCreator() transactions.TxHashExtractor
 	RewardTxDataCreator() transactions.RewardTxDataHandler
 	IndexTokensHandlerCreator() elasticproc.IndexTokensHandler
+	BridgeTransfersHandlerCreator() elasticproc.BridgeTransfersHandler
+	BlockProcessorCreator() elasticproc.BlockProcessorCreator
+	AccountsProcessorCreator() elasticproc.AccountsProcessorCreator
+	EventsProcessorsCreator() logsevents.EventsProcessorsCreator
+	SupportedIndexes() []string
+	BlockCreatorsContainer() dataindexer.BlockContainerHandler
 	Create() error
 	Close() error
 	CheckSubcomponents() error
 	String() string
 	IsInterfaceNil() bool
 }
+
+type blockCreatorsContainer interface {
+	Add(headerType core.HeaderType, creator block.EmptyBlockCreator) error
+}
@@ -0,0 +1,78 @@
+package runType
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/accounts"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/block"
+)
+
+type blockProcessorCreator struct{}
+
+// NewBlockProcessorCreator will create the creator of the block processor that handles the shard and meta headers
+func NewBlockProcessorCreator() *blockProcessorCreator {
+	return &blockProcessorCreator{}
+}
+
+// CreateBlockProcessor will create a new block processor
+func (bpc *blockProcessorCreator) CreateBlockProcessor(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (elasticproc.DBBlockHandler, error) {
+	blockProc, err := block.NewBlockProcessor(hasher, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockProc, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bpc *blockProcessorCreator) IsInterfaceNil() bool {
+	return bpc == nil
+}
+
+type sovereignBlockProcessorCreator struct{}
+
+// NewSovereignBlockProcessorCreator will create the creator of the block processor that handles the sovereign headers
+func NewSovereignBlockProcessorCreator() *sovereignBlockProcessorCreator {
+	return &sovereignBlockProcessorCreator{}
+}
+
+// CreateBlockProcessor will create a new sovereign block processor
+func (sbpc *sovereignBlockProcessorCreator) CreateBlockProcessor(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (elasticproc.DBBlockHandler, error) {
+	blockProc, err := block.NewSovereignBlockProcessor(hasher, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockProc, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbpc *sovereignBlockProcessorCreator) IsInterfaceNil() bool {
+	return sbpc == nil
+}
+
+type accountsProcessorCreator struct{}
+
+// NewAccountsProcessorCreator will create the creator of the default accounts processor
+func NewAccountsProcessorCreator() *accountsProcessorCreator {
+	return &accountsProcessorCreator{}
+}
+
+// CreateAccountsProcessor will create a new accounts processor
+func (apc *accountsProcessorCreator) CreateAccountsProcessor(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (elasticproc.DBAccountHandler, error) {
+	accountsProc, err := accounts.NewAccountsProcessor(pubKeyConverter, balanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsProc, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (apc *accountsProcessorCreator) IsInterfaceNil() bool {
+	return apc == nil
+}
@@ -0,0 +1,78 @@
+package runType
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/factory"
+)
+
+const (
+	// RegularChainRunType is the name of the run type components used by the regular chain
+	RegularChainRunType = "regular"
+	// SovereignChainRunType is the name of the run type components used by a sovereign chain
+	SovereignChainRunType = "sovereign"
+)
+
+// ArgsRunTypeComponentsFactory holds the dependencies that can be used by the run type components factories
+type ArgsRunTypeComponentsFactory struct {
+	MainChainElastic       factory.ElasticConfig
+	DCDTPrefix             string
+	BridgeContractAddress  string
+	AddressPubkeyConverter core.PubkeyConverter
+	StatusMetrics          elasticproc.GaugeMetricsHandler
+}
+
+// RunTypeComponentsFactoryCreator defines the function that creates the run type components factory of a chain flavour
+type RunTypeComponentsFactoryCreator func(args ArgsRunTypeComponentsFactory) (RunTypeComponentsCreator, error)
+
+var (
+	mutCreators sync.RWMutex
+	creators    = map[string]RunTypeComponentsFactoryCreator{
+		RegularChainRunType: func(_ ArgsRunTypeComponentsFactory) (RunTypeComponentsCreator, error) {
+			return NewRunTypeComponentsFactory(), nil
+		},
+		SovereignChainRunType: func(args ArgsRunTypeComponentsFactory) (RunTypeComponentsCreator, error) {
+			return NewSovereignRunTypeComponentsFactory(args.MainChainElastic, args.DCDTPrefix, args.BridgeContractAddress, args.AddressPubkeyConverter, args.StatusMetrics), nil
+		},
+	}
+)
+
+// RegisterRunTypeComponentsFactoryCreator will register the run type components factory of a new chain flavour under
+// the provided name. It should be called from the init function of the package that implements the factory
+func RegisterRunTypeComponentsFactoryCreator(name string, creator RunTypeComponentsFactoryCreator) error {
+	if creator == nil {
+		return errNilRunTypeComponentsFactoryCreator
+	}
+
+	mutCreators.Lock()
+	defer mutCreators.Unlock()
+
+	_, found := creators[name]
+	if found {
+		return fmt.Errorf("%w: %s", errRunTypeAlreadyRegistered, name)
+	}
+	creators[name] = creator
+
+	return nil
+}
+
+// CreateRunTypeComponentsFactory will create the run type components factory registered under the provided name. An
+// empty name selects the regular chain run type
+func CreateRunTypeComponentsFactory(name string, args ArgsRunTypeComponentsFactory) (RunTypeComponentsCreator, error) {
+	if name == "" {
+		name = RegularChainRunType
+	}
+
+	mutCreators.RLock()
+	creator, found := creators[name]
+	mutCreators.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownRunType, name)
+	}
+
+	return creator(args)
+}
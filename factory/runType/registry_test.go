@@ -0,0 +1,50 @@
+package runType
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+)
+
+func TestRegisterRunTypeComponentsFactoryCreator(t *testing.T) {
+	t.Parallel()
+
+	err := RegisterRunTypeComponentsFactoryCreator("nil-creator", nil)
+	require.Equal(t, errNilRunTypeComponentsFactoryCreator, err)
+
+	err = RegisterRunTypeComponentsFactoryCreator(SovereignChainRunType, func(_ ArgsRunTypeComponentsFactory) (RunTypeComponentsCreator, error) {
+		return NewRunTypeComponentsFactory(), nil
+	})
+	require.True(t, errors.Is(err, errRunTypeAlreadyRegistered))
+
+	err = RegisterRunTypeComponentsFactoryCreator("test-chain", func(_ ArgsRunTypeComponentsFactory) (RunTypeComponentsCreator, error) {
+		return NewRunTypeComponentsFactory(), nil
+	})
+	require.Nil(t, err)
+
+	rtcf, err := CreateRunTypeComponentsFactory("test-chain", ArgsRunTypeComponentsFactory{})
+	require.Nil(t, err)
+	require.False(t, rtcf.IsInterfaceNil())
+}
+
+func TestCreateRunTypeComponentsFactory(t *testing.T) {
+	t.Parallel()
+
+	rtcf, err := CreateRunTypeComponentsFactory("unknown", ArgsRunTypeComponentsFactory{})
+	require.Nil(t, rtcf)
+	require.True(t, errors.Is(err, errUnknownRunType))
+
+	rtcf, err = CreateRunTypeComponentsFactory("", ArgsRunTypeComponentsFactory{})
+	require.Nil(t, err)
+	require.IsType(t, &runTypeComponentsFactory{}, rtcf)
+
+	rtcf, err = CreateRunTypeComponentsFactory(SovereignChainRunType, ArgsRunTypeComponentsFactory{
+		DCDTPrefix:             "sov",
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+	})
+	require.Nil(t, err)
+	require.IsType(t, &sovereignRunTypeComponentsFactory{}, rtcf)
+}
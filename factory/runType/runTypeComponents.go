@@ -1,14 +1,22 @@
 package runType
 
 import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
 )
 
 type runTypeComponents struct {
-	txHashExtractor    transactions.TxHashExtractor
-	rewardTxData       transactions.RewardTxDataHandler
-	indexTokensHandler elasticproc.IndexTokensHandler
+	txHashExtractor          transactions.TxHashExtractor
+	rewardTxData             transactions.RewardTxDataHandler
+	indexTokensHandler       elasticproc.IndexTokensHandler
+	bridgeTransfersHandler   elasticproc.BridgeTransfersHandler
+	blockProcessorCreator    elasticproc.BlockProcessorCreator
+	accountsProcessorCreator elasticproc.AccountsProcessorCreator
+	eventsProcessorsCreator  logsevents.EventsProcessorsCreator
+	supportedIndexes         []string
+	blockCreatorsContainer   dataindexer.BlockContainerHandler
 }
 
 // Close does nothing
@@ -1,6 +1,8 @@
 package runType
 
 import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/bridge"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/tokens"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
 )
@@ -14,10 +16,21 @@ func NewRunTypeComponentsFactory() *runTypeComponentsFactory {
 
 // Create will create the run type components
 func (rtcf *runTypeComponentsFactory) Create() (*runTypeComponents, error) {
+	blockContainer, err := createRegularBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
 	return &runTypeComponents{
-		txHashExtractor:    transactions.NewTxHashExtractor(),
-		rewardTxData:       transactions.NewRewardTxData(),
-		indexTokensHandler: tokens.NewDisabledIndexTokensHandler(),
+		txHashExtractor:          transactions.NewTxHashExtractor(),
+		rewardTxData:             transactions.NewRewardTxData(),
+		indexTokensHandler:       tokens.NewDisabledIndexTokensHandler(),
+		bridgeTransfersHandler:   bridge.NewDisabledBridgeTransfersProcessor(),
+		blockProcessorCreator:    NewBlockProcessorCreator(),
+		accountsProcessorCreator: NewAccountsProcessorCreator(),
+		eventsProcessorsCreator:  logsevents.NewEventsProcessorsFactory(),
+		supportedIndexes:         getRegularChainIndexes(),
+		blockCreatorsContainer:   blockContainer,
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 
 	elasticIndexer "github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
 )
 
@@ -83,6 +84,24 @@ func (mrtc *managedRunTypeComponents) CheckSubcomponents() error {
 	if check.IfNil(mrtc.indexTokensHandler) {
 		return elasticIndexer.ErrNilIndexTokensHandler
 	}
+	if check.IfNil(mrtc.bridgeTransfersHandler) {
+		return elasticIndexer.ErrNilBridgeTransfersHandler
+	}
+	if check.IfNil(mrtc.blockProcessorCreator) {
+		return elasticIndexer.ErrNilBlockProcessorCreator
+	}
+	if check.IfNil(mrtc.accountsProcessorCreator) {
+		return elasticIndexer.ErrNilAccountsProcessorCreator
+	}
+	if check.IfNil(mrtc.eventsProcessorsCreator) {
+		return elasticIndexer.ErrNilEventsProcessorsCreator
+	}
+	if len(mrtc.supportedIndexes) == 0 {
+		return elasticIndexer.ErrEmptySupportedIndexes
+	}
+	if check.IfNilReflect(mrtc.blockCreatorsContainer) {
+		return errNilBlockCreatorsContainer
+	}
 	return nil
 }
 
@@ -122,6 +141,78 @@ func (mrtc *managedRunTypeComponents) IndexTokensHandlerCreator() elasticproc.In
 	return mrtc.runTypeComponents.indexTokensHandler
 }
 
+// BridgeTransfersHandlerCreator returns the bridge transfers handler
+func (mrtc *managedRunTypeComponents) BridgeTransfersHandlerCreator() elasticproc.BridgeTransfersHandler {
+	mrtc.mutRunTypeCoreComponents.Lock()
+	defer mrtc.mutRunTypeCoreComponents.Unlock()
+
+	if check.IfNil(mrtc.runTypeComponents) {
+		return nil
+	}
+
+	return mrtc.runTypeComponents.bridgeTransfersHandler
+}
+
+// BlockProcessorCreator returns the block processor creator
+func (mrtc *managedRunTypeComponents) BlockProcessorCreator() elasticproc.BlockProcessorCreator {
+	mrtc.mutRunTypeCoreComponents.Lock()
+	defer mrtc.mutRunTypeCoreComponents.Unlock()
+
+	if check.IfNil(mrtc.runTypeComponents) {
+		return nil
+	}
+
+	return mrtc.runTypeComponents.blockProcessorCreator
+}
+
+// AccountsProcessorCreator returns the accounts processor creator
+func (mrtc *managedRunTypeComponents) AccountsProcessorCreator() elasticproc.AccountsProcessorCreator {
+	mrtc.mutRunTypeCoreComponents.Lock()
+	defer mrtc.mutRunTypeCoreComponents.Unlock()
+
+	if check.IfNil(mrtc.runTypeComponents) {
+		return nil
+	}
+
+	return mrtc.runTypeComponents.accountsProcessorCreator
+}
+
+// EventsProcessorsCreator returns the creator of the events processors
+func (mrtc *managedRunTypeComponents) EventsProcessorsCreator() logsevents.EventsProcessorsCreator {
+	mrtc.mutRunTypeCoreComponents.Lock()
+	defer mrtc.mutRunTypeCoreComponents.Unlock()
+
+	if check.IfNil(mrtc.runTypeComponents) {
+		return nil
+	}
+
+	return mrtc.runTypeComponents.eventsProcessorsCreator
+}
+
+// SupportedIndexes returns the indexes supported by the chain flavour
+func (mrtc *managedRunTypeComponents) SupportedIndexes() []string {
+	mrtc.mutRunTypeCoreComponents.Lock()
+	defer mrtc.mutRunTypeCoreComponents.Unlock()
+
+	if check.IfNil(mrtc.runTypeComponents) {
+		return nil
+	}
+
+	return mrtc.runTypeComponents.supportedIndexes
+}
+
+// BlockCreatorsContainer returns the container of the empty block creators for the header types of the chain flavour
+func (mrtc *managedRunTypeComponents) BlockCreatorsContainer() elasticIndexer.BlockContainerHandler {
+	mrtc.mutRunTypeCoreComponents.Lock()
+	defer mrtc.mutRunTypeCoreComponents.Unlock()
+
+	if check.IfNil(mrtc.runTypeComponents) {
+		return nil
+	}
+
+	return mrtc.runTypeComponents.blockCreatorsContainer
+}
+
 // IsInterfaceNil returns true if the interface is nil
 func (mrtc *managedRunTypeComponents) IsInterfaceNil() bool {
 	return mrtc == nil
@@ -2,28 +2,44 @@ package runType
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/elastic/go-elasticsearch/v7"
 
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/client"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/client/disabled"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/client/logging"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/bridge"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/factory"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/tokens"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
 )
 
 type sovereignRunTypeComponentsFactory struct {
-	mainChainElastic factory.ElasticConfig
-	dcdtPrefix       string
+	mainChainElastic       factory.ElasticConfig
+	dcdtPrefix             string
+	bridgeContractAddress  string
+	addressPubkeyConverter core.PubkeyConverter
+	statusMetrics          elasticproc.GaugeMetricsHandler
 }
 
 // NewSovereignRunTypeComponentsFactory will return a new instance of sovereign run type components factory
-func NewSovereignRunTypeComponentsFactory(mainChainElastic factory.ElasticConfig, dcdtPrefix string) *sovereignRunTypeComponentsFactory {
+func NewSovereignRunTypeComponentsFactory(
+	mainChainElastic factory.ElasticConfig,
+	dcdtPrefix string,
+	bridgeContractAddress string,
+	addressPubkeyConverter core.PubkeyConverter,
+	statusMetrics elasticproc.GaugeMetricsHandler,
+) *sovereignRunTypeComponentsFactory {
 	return &sovereignRunTypeComponentsFactory{
-		mainChainElastic: mainChainElastic,
-		dcdtPrefix:       dcdtPrefix,
+		mainChainElastic:       mainChainElastic,
+		dcdtPrefix:             dcdtPrefix,
+		bridgeContractAddress:  bridgeContractAddress,
+		addressPubkeyConverter: addressPubkeyConverter,
+		statusMetrics:          statusMetrics,
 	}
 }
 
@@ -34,15 +50,38 @@ func (srtcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, er
 		return nil, err
 	}
 
-	sovIndexTokensHandler, err := tokens.NewSovereignIndexTokensHandler(mainChainElasticClient, srtcf.dcdtPrefix)
+	sovIndexTokensHandler, err := tokens.NewSovereignIndexTokensHandler(tokens.ArgsSovereignIndexTokensHandler{
+		MainChainElasticClient: mainChainElasticClient,
+		DCDTPrefix:             srtcf.dcdtPrefix,
+		CacheSize:              srtcf.mainChainElastic.TokensCacheSize,
+		NotFoundCacheTTL:       time.Duration(srtcf.mainChainElastic.TokensNotFoundCacheTTLInSec) * time.Second,
+		RetryInterval:          time.Duration(srtcf.mainChainElastic.RetryIntervalInSec) * time.Second,
+		StatusMetrics:          srtcf.statusMetrics,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeTransfersProcessor, err := bridge.NewBridgeTransfersProcessor(srtcf.addressPubkeyConverter, srtcf.dcdtPrefix, srtcf.bridgeContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	blockContainer, err := createSovereignBlockCreatorsContainer()
 	if err != nil {
 		return nil, err
 	}
 
 	return &runTypeComponents{
-		txHashExtractor:    transactions.NewSovereignTxHashExtractor(),
-		rewardTxData:       transactions.NewSovereignRewardTxData(),
-		indexTokensHandler: sovIndexTokensHandler,
+		txHashExtractor:          transactions.NewSovereignTxHashExtractor(),
+		rewardTxData:             transactions.NewSovereignRewardTxData(),
+		indexTokensHandler:       sovIndexTokensHandler,
+		bridgeTransfersHandler:   bridgeTransfersProcessor,
+		blockProcessorCreator:    NewSovereignBlockProcessorCreator(),
+		accountsProcessorCreator: NewAccountsProcessorCreator(),
+		eventsProcessorsCreator:  logsevents.NewEventsProcessorsFactory(),
+		supportedIndexes:         getSovereignChainIndexes(),
+		blockCreatorsContainer:   blockContainer,
 	}, nil
 }
 
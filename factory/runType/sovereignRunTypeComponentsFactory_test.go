@@ -1,22 +1,25 @@
 package runType
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/factory"
 )
 
 func TestSovereignRunTypeComponentsFactory_CreateAndClose(t *testing.T) {
 	t.Parallel()
 
-	srtcf := NewSovereignRunTypeComponentsFactory(factory.ElasticConfig{}, "sov")
+	srtcf := NewSovereignRunTypeComponentsFactory(factory.ElasticConfig{}, "sov", "bridge", mock.NewPubkeyConverterMock(32), nil)
 	require.False(t, srtcf.IsInterfaceNil())
 
 	srtc, err := srtcf.Create()
 	require.NotNil(t, srtc)
 	require.NoError(t, err)
+	require.Equal(t, "*runType.sovereignBlockProcessorCreator", fmt.Sprintf("%T", srtc.blockProcessorCreator))
 
 	require.NoError(t, srtc.Close())
 }
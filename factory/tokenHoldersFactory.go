@@ -0,0 +1,40 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/holders"
+)
+
+// CreateTokenHoldersFullCountJob will create a new instance of core.TokenHoldersFullCountJob that counts the holders in
+// the cluster and under the index namespace of the provided pipeline
+func CreateTokenHoldersFullCountJob(cfg config.Config, clusterCfg config.ClusterConfig, pipelineCfg config.PipelineConfig) (core.TokenHoldersFullCountJob, error) {
+	fullCountInterval := clusterCfg.Config.TokenHolders.FullCountIntervalInMinutes
+	if fullCountInterval == 0 {
+		return holders.NewDisabledFullCountJob(), nil
+	}
+
+	databaseClient, err := createPipelineDatabaseClient(pipelineCfg)
+	if err != nil {
+		return nil, err
+	}
+	addressPubkeyConverter, err := createPubkeyConverter(cfg.Config.AddressConverter.Type, cfg.Config.AddressConverter.Length, cfg.Config.AddressConverter.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	balanceConverter, err := converters.NewBalanceConverter(cfg.Config.Economics.Denomination)
+	if err != nil {
+		return nil, err
+	}
+
+	return holders.NewFullCountJob(holders.ArgsFullCountJob{
+		DBClient:           databaseClient,
+		PubKeyConverter:    addressPubkeyConverter,
+		BalanceConverter:   balanceConverter,
+		Interval:           time.Duration(fullCountInterval) * time.Minute,
+		BulkRequestMaxSize: pipelineCfg.ElasticCluster.BulkRequestMaxSizeInBytes,
+	})
+}
@@ -8,15 +8,17 @@ import (
 )
 
 // CreateWebServer will create a new instance of core.WebServerHandler
-func CreateWebServer(apiConfig config.ApiRoutesConfig, statusMetricsHandler core.StatusMetricsHandler) (core.WebServerHandler, error) {
+func CreateWebServer(apiConfig config.ApiRoutesConfig, statusMetricsHandler core.StatusMetricsHandler, liveFeed core.LiveFeedHandler, payloadsReader core.PayloadsReaderHandler) (core.WebServerHandler, error) {
 	metricsFacade, err := facade.NewMetricsFacade(statusMetricsHandler)
 	if err != nil {
 		return nil, err
 	}
 
 	args := gin.ArgsWebServer{
-		Facade:    metricsFacade,
-		ApiConfig: apiConfig,
+		Facade:         metricsFacade,
+		LiveFeed:       liveFeed,
+		PayloadsReader: payloadsReader,
+		ApiConfig:      apiConfig,
 	}
 	return gin.NewWebServer(args)
 }
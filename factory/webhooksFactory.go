@@ -0,0 +1,25 @@
+package factory
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/webhooks"
+)
+
+// CreateWebhooksNotifier will create a new instance of core.WebhooksNotifier
+func CreateWebhooksNotifier(clusterCfg config.ClusterConfig) (core.WebhooksNotifier, error) {
+	webhooksCfg := clusterCfg.Config.Webhooks
+	if !webhooksCfg.Enabled {
+		return webhooks.NewDisabledNotifier(), nil
+	}
+
+	return webhooks.NewWebhooksNotifier(webhooks.ArgsWebhooksNotifier{
+		Config: webhooksCfg,
+		HTTPClient: &http.Client{
+			Timeout: time.Duration(webhooksCfg.RequestTimeoutInSec) * time.Second,
+		},
+	})
+}
@@ -1,31 +1,45 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/TerraDharitri/drt-go-chain-communication/websocket/data"
 	factoryHost "github.com/TerraDharitri/drt-go-chain-communication/websocket/factory"
-	"github.com/TerraDharitri/drt-go-chain-core/core/pubkeyConverter"
 	factoryHasher "github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	factoryMarshaller "github.com/TerraDharitri/drt-go-chain-core/marshal/factory"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/client"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/factory/runType"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/abi"
 	esFactory "github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/factory"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/factory"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/wsindexer"
 )
 
 var log = logger.GetOrCreate("elasticindexer")
 
-// CreateWsIndexer will create a new instance of wsindexer.WSClient
-func CreateWsIndexer(cfg config.Config, clusterCfg config.ClusterConfig, statusMetrics core.StatusMetricsHandler, version string) (wsindexer.WSClient, error) {
-	wsMarshaller, err := factoryMarshaller.NewMarshalizer(clusterCfg.Config.WebSocket.DataMarshallerType)
+// CreateWsIndexer will create a new instance of wsindexer.WSClient for the provided pipeline
+func CreateWsIndexer(
+	cfg config.Config,
+	clusterCfg config.ClusterConfig,
+	pipelineCfg config.PipelineConfig,
+	statusMetrics core.StatusMetricsHandler,
+	liveFeed core.LiveFeedHandler,
+	webhooksNotifier core.WebhooksNotifier,
+	version string,
+) (wsindexer.WSClient, error) {
+	wsMarshaller, err := factoryMarshaller.NewMarshalizer(pipelineCfg.WebSocket.DataMarshallerType)
 	if err != nil {
 		return nil, err
 	}
 
-	dataIndexer, err := createDataIndexer(cfg, clusterCfg, wsMarshaller, statusMetrics, version)
+	dataIndexer, err := createDataIndexer(cfg, clusterCfg, pipelineCfg, wsMarshaller, statusMetrics, liveFeed, webhooksNotifier, version)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +54,7 @@ func CreateWsIndexer(cfg config.Config, clusterCfg config.ClusterConfig, statusM
 		return nil, err
 	}
 
-	host, err := createWsHost(clusterCfg, wsMarshaller)
+	host, err := createWsHost(pipelineCfg.WebSocket, wsMarshaller)
 	if err != nil {
 		return nil, err
 	}
@@ -56,8 +70,11 @@ func CreateWsIndexer(cfg config.Config, clusterCfg config.ClusterConfig, statusM
 func createDataIndexer(
 	cfg config.Config,
 	clusterCfg config.ClusterConfig,
+	pipelineCfg config.PipelineConfig,
 	wsMarshaller marshal.Marshalizer,
 	statusMetrics core.StatusMetricsHandler,
+	liveFeed core.LiveFeedHandler,
+	webhooksNotifier core.WebhooksNotifier,
 	version string,
 ) (wsindexer.DataIndexer, error) {
 	marshaller, err := factoryMarshaller.NewMarshalizer(cfg.Config.Marshaller.Type)
@@ -68,42 +85,145 @@ func createDataIndexer(
 	if err != nil {
 		return nil, err
 	}
-	addressPubkeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(cfg.Config.AddressConverter.Length, cfg.Config.AddressConverter.Prefix)
+	addressPubkeyConverter, err := createPubkeyConverter(cfg.Config.AddressConverter.Type, cfg.Config.AddressConverter.Length, cfg.Config.AddressConverter.Prefix)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w for the addresses", err)
+	}
+	validatorPubkeyConverter, err := createPubkeyConverter(cfg.Config.ValidatorKeysConverter.Type, cfg.Config.ValidatorKeysConverter.Length, cfg.Config.ValidatorKeysConverter.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the validator keys", err)
+	}
+	secondaryPubkeyConverter, err := createSecondaryPubkeyConverter(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the secondary encoding of the addresses", err)
 	}
-	validatorPubkeyConverter, err := pubkeyConverter.NewHexPubkeyConverter(cfg.Config.ValidatorKeysConverter.Length)
+	dataPublisher, err := elasticproc.NewDataPublishers(pipelineCfg.Name, liveFeed, webhooksNotifier)
 	if err != nil {
 		return nil, err
 	}
 
 	mainChainElastic := esFactory.ElasticConfig{
-		Enabled:  clusterCfg.Config.MainChainCluster.Enabled,
-		Url:      clusterCfg.Config.MainChainCluster.URL,
-		UserName: clusterCfg.Config.MainChainCluster.UserName,
-		Password: clusterCfg.Config.MainChainCluster.Password,
+		Enabled:                     clusterCfg.Config.MainChainCluster.Enabled,
+		Url:                         clusterCfg.Config.MainChainCluster.URL,
+		UserName:                    clusterCfg.Config.MainChainCluster.UserName,
+		Password:                    clusterCfg.Config.MainChainCluster.Password,
+		TokensCacheSize:             clusterCfg.Config.MainChainCluster.TokensCacheSize,
+		TokensNotFoundCacheTTLInSec: clusterCfg.Config.MainChainCluster.TokensNotFoundCacheTTLInSec,
+		RetryIntervalInSec:          clusterCfg.Config.MainChainCluster.RetryIntervalInSec,
 	}
 
 	return factory.NewIndexer(factory.ArgsIndexerFactory{
-		Sovereign:                cfg.Sovereign,
-		MainChainElastic:         mainChainElastic,
-		UseKibana:                clusterCfg.Config.ElasticCluster.UseKibana,
-		Denomination:             cfg.Config.Economics.Denomination,
-		BulkRequestMaxSize:       clusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes,
-		Url:                      clusterCfg.Config.ElasticCluster.URL,
-		UserName:                 clusterCfg.Config.ElasticCluster.UserName,
-		Password:                 clusterCfg.Config.ElasticCluster.Password,
-		EnabledIndexes:           prepareIndices(cfg.Config.AvailableIndices, clusterCfg.Config.DisabledIndices),
-		Marshalizer:              marshaller,
-		Hasher:                   hasher,
-		AddressPubkeyConverter:   addressPubkeyConverter,
-		ValidatorPubkeyConverter: validatorPubkeyConverter,
-		HeaderMarshaller:         wsMarshaller,
-		StatusMetrics:            statusMetrics,
-		Version:                  version,
+		RunType:                       getRunType(cfg, pipelineCfg),
+		IndexNamespace:                pipelineCfg.IndexNamespace,
+		MainChainElastic:              mainChainElastic,
+		BridgeContractAddress:         cfg.Config.Bridge.ContractAddress,
+		UseKibana:                     pipelineCfg.ElasticCluster.UseKibana,
+		Denomination:                  cfg.Config.Economics.Denomination,
+		GuardianActivationEpochsDelay: cfg.Config.Guardians.ActivationEpochsDelay,
+		BulkRequestMaxSize:            pipelineCfg.ElasticCluster.BulkRequestMaxSizeInBytes,
+		Url:                           pipelineCfg.ElasticCluster.URL,
+		UserName:                      pipelineCfg.ElasticCluster.UserName,
+		Password:                      pipelineCfg.ElasticCluster.Password,
+		EnabledIndexes:                prepareIndices(cfg.Config.AvailableIndices, clusterCfg.Config.DisabledIndices),
+		Marshalizer:                   marshaller,
+		Hasher:                        hasher,
+		AddressPubkeyConverter:        addressPubkeyConverter,
+		ValidatorPubkeyConverter:      validatorPubkeyConverter,
+		SecondaryPubkeyConverter:      secondaryPubkeyConverter,
+		HeaderMarshaller:              wsMarshaller,
+		StatusMetrics:                 statusMetrics,
+		DataPublisher:                 dataPublisher,
+		CustomEventsPlugins:           cfg.Config.CustomEvents.Plugins,
+		CustomEventRules:              createCustomEventRules(cfg.Config.CustomEvents.Rules),
+		ContractAbis:                  createContractAbis(cfg),
+		WatchedAddresses:              createWatchedAddressesConfig(cfg),
+		PayloadsOffloading: esFactory.PayloadsOffloadingConfig{
+			Enabled:             cfg.Config.PayloadsOffloading.Enabled,
+			ThresholdInBytes:    cfg.Config.PayloadsOffloading.ThresholdInBytes,
+			PrefixLengthInBytes: cfg.Config.PayloadsOffloading.PrefixLengthInBytes,
+		},
+		FieldsProjection: createFieldsProjectionRules(clusterCfg.Config.FieldsProjection),
+		Version:          version,
 	})
 }
 
+func createFieldsProjectionRules(fieldsProjectionConfig []config.FieldsProjectionConfig) []client.FieldsProjectionRule {
+	rules := make([]client.FieldsProjectionRule, 0, len(fieldsProjectionConfig))
+	for _, ruleCfg := range fieldsProjectionConfig {
+		rules = append(rules, client.FieldsProjectionRule{
+			Index: ruleCfg.Index,
+			Drop:  ruleCfg.Drop,
+			Hash:  ruleCfg.Hash,
+		})
+	}
+
+	return rules
+}
+
+func createWatchedAddressesConfig(cfg config.Config) esFactory.WatchedAddressesConfig {
+	return esFactory.WatchedAddressesConfig{
+		Enabled:             cfg.Config.WatchedAddresses.Enabled,
+		Source:              cfg.Config.WatchedAddresses.Source,
+		FilePath:            cfg.Config.WatchedAddresses.File,
+		Index:               cfg.Config.WatchedAddresses.Index,
+		ReloadIntervalInSec: cfg.Config.WatchedAddresses.ReloadIntervalInSec,
+	}
+}
+
+func createContractAbis(cfg config.Config) []abi.ContractAbi {
+	if !cfg.Config.AbiDecoder.Enabled {
+		return nil
+	}
+
+	contractAbis := make([]abi.ContractAbi, 0, len(cfg.Config.AbiDecoder.Contracts))
+	for _, contractCfg := range cfg.Config.AbiDecoder.Contracts {
+		contractAbis = append(contractAbis, abi.ContractAbi{
+			Address:  contractCfg.Address,
+			CodeHash: contractCfg.CodeHash,
+			AbiFile:  contractCfg.AbiFile,
+		})
+	}
+
+	return contractAbis
+}
+
+func createCustomEventRules(rulesConfig []config.CustomEventRuleConfig) []logsevents.CustomEventRule {
+	rules := make([]logsevents.CustomEventRule, 0, len(rulesConfig))
+	for _, ruleCfg := range rulesConfig {
+		topics := make([]logsevents.CustomEventTopic, 0, len(ruleCfg.Topics))
+		for _, topicCfg := range ruleCfg.Topics {
+			topics = append(topics, logsevents.CustomEventTopic{
+				Name:    topicCfg.Name,
+				Decoder: topicCfg.Decoder,
+			})
+		}
+
+		rules = append(rules, logsevents.CustomEventRule{
+			Name:       ruleCfg.Name,
+			Identifier: ruleCfg.Identifier,
+			Contract:   ruleCfg.Contract,
+			Index:      ruleCfg.Index,
+			IDTemplate: ruleCfg.IDTemplate,
+			Topics:     topics,
+		})
+	}
+
+	return rules
+}
+
+// the run type of the pipeline selects its run type components, which have to be registered in the run type components
+// registry. A pipeline without a run type uses the sovereign flag to choose between the regular and the sovereign chain
+func getRunType(cfg config.Config, pipelineCfg config.PipelineConfig) string {
+	if pipelineCfg.RunType != "" {
+		return pipelineCfg.RunType
+	}
+	if cfg.Sovereign {
+		return runType.SovereignChainRunType
+	}
+
+	return runType.RegularChainRunType
+}
+
 func prepareIndices(availableIndices, disabledIndices []string) []string {
 	indices := make([]string, 0)
 
@@ -123,15 +243,15 @@ func prepareIndices(availableIndices, disabledIndices []string) []string {
 	return indices
 }
 
-func createWsHost(clusterCfg config.ClusterConfig, wsMarshaller marshal.Marshalizer) (factoryHost.FullDuplexHost, error) {
+func createWsHost(wsCfg config.WebSocketConfig, wsMarshaller marshal.Marshalizer) (factoryHost.FullDuplexHost, error) {
 	return factoryHost.CreateWebSocketHost(factoryHost.ArgsWebSocketHost{
 		WebSocketConfig: data.WebSocketConfig{
-			URL:                     clusterCfg.Config.WebSocket.URL,
-			WithAcknowledge:         clusterCfg.Config.WebSocket.WithAcknowledge,
-			Mode:                    clusterCfg.Config.WebSocket.Mode,
-			RetryDurationInSec:      int(clusterCfg.Config.WebSocket.RetryDurationInSec),
-			AcknowledgeTimeoutInSec: int(clusterCfg.Config.WebSocket.AckTimeoutInSec),
-			BlockingAckOnError:      clusterCfg.Config.WebSocket.BlockingAckOnError,
+			URL:                     wsCfg.URL,
+			WithAcknowledge:         wsCfg.WithAcknowledge,
+			Mode:                    wsCfg.Mode,
+			RetryDurationInSec:      int(wsCfg.RetryDurationInSec),
+			AcknowledgeTimeoutInSec: int(wsCfg.AckTimeoutInSec),
+			BlockingAckOnError:      wsCfg.BlockingAckOnError,
 		},
 		Marshaller: wsMarshaller,
 		Log:        log,
@@ -15,10 +15,14 @@ import (
 
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/client"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/client/logging"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/factory/runType"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/livefeed"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/bridge"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/factory"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/tokens"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
 )
@@ -28,6 +32,7 @@ var (
 	log                = logger.GetOrCreate("integration-tests")
 	pubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, addressPrefix)
 	sovDcdtPrefix      = "sov"
+	sovBridgeAddress   = "drt1qqqqqqqqqqqqqpgq57szwud2quysucrlq2e97ntdysdl7v4ejz3qwdy3rt"
 )
 
 // nolint
@@ -70,10 +75,16 @@ func CreateElasticProcessor(
 		EnabledIndexes: []string{dataindexer.TransactionsIndex, dataindexer.LogsIndex, dataindexer.AccountsDCDTIndex, dataindexer.ScResultsIndex,
 			dataindexer.ReceiptsIndex, dataindexer.BlockIndex, dataindexer.AccountsIndex, dataindexer.TokensIndex, dataindexer.TagsIndex, dataindexer.EventsIndex,
 			dataindexer.OperationsIndex, dataindexer.DelegatorsIndex, dataindexer.DCDTsIndex, dataindexer.SCDeploysIndex, dataindexer.MiniblocksIndex, dataindexer.ValuesIndex},
-		Denomination:       18,
-		TxHashExtractor:    transactions.NewTxHashExtractor(),
-		RewardTxData:       transactions.NewRewardTxData(),
-		IndexTokensHandler: tokens.NewDisabledIndexTokensHandler(),
+		Denomination:             18,
+		TxHashExtractor:          transactions.NewTxHashExtractor(),
+		RewardTxData:             transactions.NewRewardTxData(),
+		IndexTokensHandler:       tokens.NewDisabledIndexTokensHandler(),
+		BridgeTransfersHandler:   bridge.NewDisabledBridgeTransfersProcessor(),
+		DataPublisher:            livefeed.NewDisabledLiveFeed(),
+		SupportedIndexes:         elasticproc.GetAllIndexes(),
+		BlockProcessorCreator:    runType.NewBlockProcessorCreator(),
+		AccountsProcessorCreator: runType.NewAccountsProcessorCreator(),
+		EventsProcessorsCreator:  logsevents.NewEventsProcessorsFactory(),
 	}
 
 	return factory.CreateElasticProcessor(args)
@@ -84,7 +95,11 @@ func CreateSovereignElasticProcessor(
 	esClient elasticproc.DatabaseClientHandler,
 	mainEsClient elasticproc.MainChainDatabaseClientHandler,
 ) (dataindexer.ElasticProcessor, error) {
-	sovIndexTokens, _ := tokens.NewSovereignIndexTokensHandler(mainEsClient, sovDcdtPrefix)
+	sovIndexTokens, _ := tokens.NewSovereignIndexTokensHandler(tokens.ArgsSovereignIndexTokensHandler{
+		MainChainElasticClient: mainEsClient,
+		DCDTPrefix:             sovDcdtPrefix,
+	})
+	bridgeTransfersProc, _ := bridge.NewBridgeTransfersProcessor(pubKeyConverter, sovDcdtPrefix, sovBridgeAddress)
 
 	args := factory.ArgElasticProcessorFactory{
 		Marshalizer:              &mock.MarshalizerMock{},
@@ -94,11 +109,18 @@ func CreateSovereignElasticProcessor(
 		DBClient:                 esClient,
 		EnabledIndexes: []string{dataindexer.TransactionsIndex, dataindexer.LogsIndex, dataindexer.AccountsDCDTIndex, dataindexer.ScResultsIndex,
 			dataindexer.ReceiptsIndex, dataindexer.BlockIndex, dataindexer.AccountsIndex, dataindexer.TokensIndex, dataindexer.TagsIndex, dataindexer.EventsIndex,
-			dataindexer.OperationsIndex, dataindexer.DelegatorsIndex, dataindexer.DCDTsIndex, dataindexer.SCDeploysIndex, dataindexer.MiniblocksIndex, dataindexer.ValuesIndex},
-		Denomination:       18,
-		TxHashExtractor:    transactions.NewSovereignTxHashExtractor(),
-		RewardTxData:       transactions.NewSovereignRewardTxData(),
-		IndexTokensHandler: sovIndexTokens,
+			dataindexer.OperationsIndex, dataindexer.DelegatorsIndex, dataindexer.DCDTsIndex, dataindexer.SCDeploysIndex, dataindexer.MiniblocksIndex, dataindexer.ValuesIndex,
+			dataindexer.BridgeTransfersIndex},
+		Denomination:             18,
+		TxHashExtractor:          transactions.NewSovereignTxHashExtractor(),
+		RewardTxData:             transactions.NewSovereignRewardTxData(),
+		IndexTokensHandler:       sovIndexTokens,
+		BridgeTransfersHandler:   bridgeTransfersProc,
+		DataPublisher:            livefeed.NewDisabledLiveFeed(),
+		SupportedIndexes:         elasticproc.GetAllIndexes(),
+		BlockProcessorCreator:    runType.NewSovereignBlockProcessorCreator(),
+		AccountsProcessorCreator: runType.NewAccountsProcessorCreator(),
+		EventsProcessorsCreator:  logsevents.NewEventsProcessorsFactory(),
 	}
 
 	return factory.CreateElasticProcessor(args)
@@ -32,6 +32,7 @@ func TestCheckVersionIsIndexer(t *testing.T) {
 		TxHashExtractor:          &mock.TxHashExtractorMock{},
 		RewardTxData:             &mock.RewardTxDataMock{},
 		IndexTokensHandler:       &elasticproc.IndexTokenHandlerMock{},
+		BridgeTransfersHandler:   &elasticproc.BridgeTransfersHandlerMock{},
 	}
 
 	_, err = factory.CreateElasticProcessor(args)
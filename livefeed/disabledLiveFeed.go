@@ -0,0 +1,32 @@
+package livefeed
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type disabledLiveFeed struct{}
+
+// NewDisabledLiveFeed will create a new instance of disabledLiveFeed
+func NewDisabledLiveFeed() *disabledLiveFeed {
+	return &disabledLiveFeed{}
+}
+
+// AddSubscriber returns ErrLiveFeedDisabled
+func (dlf *disabledLiveFeed) AddSubscriber(_ core.WSConnection, _ *data.FeedFilter) error {
+	return ErrLiveFeedDisabled
+}
+
+// Publish does nothing
+func (dlf *disabledLiveFeed) Publish(_ *data.IndexedBlockData) {
+}
+
+// Close returns nil
+func (dlf *disabledLiveFeed) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dlf *disabledLiveFeed) IsInterfaceNil() bool {
+	return dlf == nil
+}
@@ -0,0 +1,24 @@
+package livefeed
+
+import "errors"
+
+// ErrLiveFeedDisabled signals that the live feed is disabled
+var ErrLiveFeedDisabled = errors.New("live feed is disabled")
+
+// ErrLiveFeedClosed signals that the live feed was closed
+var ErrLiveFeedClosed = errors.New("live feed is closed")
+
+// ErrTooManySubscribers signals that the maximum number of live feed subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many live feed subscribers")
+
+// ErrNilWSConnection signals that a nil websocket connection has been provided
+var ErrNilWSConnection = errors.New("nil websocket connection")
+
+// ErrNilFeedFilter signals that a nil feed filter has been provided
+var ErrNilFeedFilter = errors.New("nil feed filter")
+
+// ErrInvalidSendBufferSize signals that an invalid send buffer size has been provided
+var ErrInvalidSendBufferSize = errors.New("invalid send buffer size")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
@@ -0,0 +1,162 @@
+package livefeed
+
+import (
+	"encoding/hex"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+// feedTransaction adds the hash, which is not part of the serialized document, to a transaction
+type feedTransaction struct {
+	Hash string `json:"hash"`
+	*data.Transaction
+}
+
+// feedScResult adds the hash, which is not part of the serialized document, to a smart contract result
+type feedScResult struct {
+	Hash string `json:"hash"`
+	*data.ScResult
+}
+
+// feedEvent adds the ID, which is not part of the serialized document, to an event
+type feedEvent struct {
+	ID string `json:"id"`
+	*data.LogEvent
+}
+
+// feedMessage is the structure that is pushed to a subscriber for every indexed block
+type feedMessage struct {
+	Pipeline     string             `json:"pipeline,omitempty"`
+	HeaderHash   string             `json:"headerHash"`
+	Nonce        uint64             `json:"nonce"`
+	ShardID      uint32             `json:"shardID"`
+	Timestamp    uint64             `json:"timestamp"`
+	Transactions []*feedTransaction `json:"transactions,omitempty"`
+	ScResults    []*feedScResult    `json:"scResults,omitempty"`
+	Events       []*feedEvent       `json:"events,omitempty"`
+	Tokens       []*data.TokenInfo  `json:"tokens,omitempty"`
+}
+
+func (fm *feedMessage) isEmpty() bool {
+	return len(fm.Transactions) == 0 && len(fm.ScResults) == 0 && len(fm.Events) == 0 && len(fm.Tokens) == 0
+}
+
+func createFeedMessage(indexedData *data.IndexedBlockData, filter *data.FeedFilter) *feedMessage {
+	message := &feedMessage{
+		Pipeline:   indexedData.Pipeline,
+		HeaderHash: indexedData.HeaderHash,
+		Nonce:      indexedData.Nonce,
+		ShardID:    indexedData.ShardID,
+		Timestamp:  indexedData.Timestamp,
+	}
+
+	if !matchesShard(filter, indexedData.ShardID) || !matchesPipeline(filter, indexedData.Pipeline) {
+		return message
+	}
+
+	for _, tx := range indexedData.Transactions {
+		if matchesTransaction(filter, tx) {
+			message.Transactions = append(message.Transactions, &feedTransaction{Hash: tx.Hash, Transaction: tx})
+		}
+	}
+	for _, scr := range indexedData.ScResults {
+		if matchesScResult(filter, scr) {
+			message.ScResults = append(message.ScResults, &feedScResult{Hash: scr.Hash, ScResult: scr})
+		}
+	}
+	for _, event := range indexedData.Events {
+		if matchesEvent(filter, event) {
+			message.Events = append(message.Events, &feedEvent{ID: event.ID, LogEvent: event})
+		}
+	}
+	for _, token := range indexedData.Tokens {
+		if matchesToken(filter, token) {
+			message.Tokens = append(message.Tokens, token)
+		}
+	}
+
+	return message
+}
+
+func matchesShard(filter *data.FeedFilter, shardID uint32) bool {
+	if len(filter.ShardIDs) == 0 {
+		return true
+	}
+
+	_, found := filter.ShardIDs[shardID]
+	return found
+}
+
+func matchesPipeline(filter *data.FeedFilter, pipeline string) bool {
+	if len(filter.Pipelines) == 0 {
+		return true
+	}
+
+	_, found := filter.Pipelines[pipeline]
+	return found
+}
+
+func matchesTransaction(filter *data.FeedFilter, tx *data.Transaction) bool {
+	if len(filter.Identifiers) != 0 {
+		return false
+	}
+
+	addresses := append([]string{tx.Sender, tx.Receiver}, tx.Receivers...)
+	return containsAny(filter.Addresses, addresses) && containsAny(filter.Tokens, tx.Tokens)
+}
+
+func matchesScResult(filter *data.FeedFilter, scr *data.ScResult) bool {
+	if len(filter.Identifiers) != 0 {
+		return false
+	}
+
+	addresses := append([]string{scr.Sender, scr.Receiver}, scr.Receivers...)
+	return containsAny(filter.Addresses, addresses) && containsAny(filter.Tokens, scr.Tokens)
+}
+
+func matchesEvent(filter *data.FeedFilter, event *data.LogEvent) bool {
+	if !containsAny(filter.Identifiers, []string{event.Identifier}) {
+		return false
+	}
+	if !containsAny(filter.Addresses, []string{event.Address, event.LogAddress}) {
+		return false
+	}
+	if len(filter.Tokens) == 0 {
+		return true
+	}
+
+	// events that move tokens hold the hex encoded token identifier as the first topic
+	if len(event.Topics) == 0 {
+		return false
+	}
+	tokenIdentifier, err := hex.DecodeString(event.Topics[0])
+	if err != nil {
+		return false
+	}
+
+	return containsAny(filter.Tokens, []string{string(tokenIdentifier)})
+}
+
+func matchesToken(filter *data.FeedFilter, token *data.TokenInfo) bool {
+	if len(filter.Identifiers) != 0 {
+		return false
+	}
+
+	return containsAny(filter.Addresses, []string{token.Issuer, token.CurrentOwner}) &&
+		containsAny(filter.Tokens, []string{token.Token, token.Identifier})
+}
+
+func containsAny(criterion map[string]struct{}, values []string) bool {
+	if len(criterion) == 0 {
+		return true
+	}
+
+	for _, value := range values {
+		_, found := criterion[value]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
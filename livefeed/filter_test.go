@@ -0,0 +1,108 @@
+package livefeed
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func createIndexedBlockData() *data.IndexedBlockData {
+	return &data.IndexedBlockData{
+		ShardID: 1,
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Sender: "alice", Receiver: "bob"},
+			{Hash: "tx2", Sender: "alice", Receiver: "alice", Receivers: []string{"carol"}, Tokens: []string{"TKN-abcd"}},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "scr1", Sender: "sc", Receiver: "carol", Tokens: []string{"TKN-abcd"}},
+		},
+		Events: []*data.LogEvent{
+			{ID: "ev1", Address: "alice", Identifier: "DCDTTransfer", Topics: []string{hex.EncodeToString([]byte("TKN-abcd"))}},
+			{ID: "ev2", Address: "sc", Identifier: "swap"},
+		},
+		Tokens: []*data.TokenInfo{
+			{Token: "NEW-1234", Issuer: "dave"},
+		},
+	}
+}
+
+func TestCreateFeedMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty filter should match everything", func(t *testing.T) {
+		message := createFeedMessage(createIndexedBlockData(), data.NewFeedFilter())
+		require.Len(t, message.Transactions, 2)
+		require.Len(t, message.ScResults, 1)
+		require.Len(t, message.Events, 2)
+		require.Len(t, message.Tokens, 1)
+	})
+	t.Run("shard filter", func(t *testing.T) {
+		filter := data.NewFeedFilter()
+		filter.ShardIDs[0] = struct{}{}
+
+		message := createFeedMessage(createIndexedBlockData(), filter)
+		require.True(t, message.isEmpty())
+		require.Equal(t, uint32(1), message.ShardID)
+	})
+	t.Run("pipeline filter", func(t *testing.T) {
+		filter := data.NewFeedFilter()
+		filter.Pipelines["sovereign"] = struct{}{}
+
+		indexedData := createIndexedBlockData()
+		indexedData.Pipeline = "main-chain"
+		message := createFeedMessage(indexedData, filter)
+		require.True(t, message.isEmpty())
+		require.Equal(t, "main-chain", message.Pipeline)
+
+		indexedData.Pipeline = "sovereign"
+		message = createFeedMessage(indexedData, filter)
+		require.Len(t, message.Transactions, 2)
+		require.Equal(t, "sovereign", message.Pipeline)
+	})
+	t.Run("address filter", func(t *testing.T) {
+		filter := data.NewFeedFilter()
+		filter.Addresses["carol"] = struct{}{}
+
+		message := createFeedMessage(createIndexedBlockData(), filter)
+		require.Len(t, message.Transactions, 1)
+		require.Equal(t, "tx2", message.Transactions[0].Hash)
+		require.Len(t, message.ScResults, 1)
+		require.Len(t, message.Events, 0)
+		require.Len(t, message.Tokens, 0)
+	})
+	t.Run("token filter", func(t *testing.T) {
+		filter := data.NewFeedFilter()
+		filter.Tokens["TKN-abcd"] = struct{}{}
+
+		message := createFeedMessage(createIndexedBlockData(), filter)
+		require.Len(t, message.Transactions, 1)
+		require.Len(t, message.ScResults, 1)
+		require.Len(t, message.Events, 1)
+		require.Equal(t, "ev1", message.Events[0].ID)
+		require.Len(t, message.Tokens, 0)
+	})
+	t.Run("identifier filter should match only events", func(t *testing.T) {
+		filter := data.NewFeedFilter()
+		filter.Identifiers["swap"] = struct{}{}
+
+		message := createFeedMessage(createIndexedBlockData(), filter)
+		require.Len(t, message.Transactions, 0)
+		require.Len(t, message.ScResults, 0)
+		require.Len(t, message.Events, 1)
+		require.Equal(t, "ev2", message.Events[0].ID)
+	})
+	t.Run("criteria should be combined", func(t *testing.T) {
+		filter := data.NewFeedFilter()
+		filter.Addresses["alice"] = struct{}{}
+		filter.Tokens["TKN-abcd"] = struct{}{}
+
+		message := createFeedMessage(createIndexedBlockData(), filter)
+		require.Len(t, message.Transactions, 1)
+		require.Equal(t, "tx2", message.Transactions[0].Hash)
+		require.Len(t, message.ScResults, 0)
+		require.Len(t, message.Events, 1)
+	})
+}
@@ -0,0 +1,139 @@
+package livefeed
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+var log = logger.GetOrCreate("livefeed")
+
+// ArgsLiveFeed holds all the arguments needed to create a new instance of liveFeed
+type ArgsLiveFeed struct {
+	MaxSubscribers int
+	SendBufferSize int
+	WriteTimeout   time.Duration
+}
+
+type liveFeed struct {
+	mutSubscribers   sync.RWMutex
+	subscribers      map[uint64]*subscriber
+	lastSubscriberID uint64
+	closed           bool
+	maxSubscribers   int
+	sendBufferSize   int
+	writeTimeout     time.Duration
+}
+
+// NewLiveFeed will create a new instance of liveFeed
+func NewLiveFeed(args ArgsLiveFeed) (*liveFeed, error) {
+	if args.MaxSubscribers < 1 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+	if args.SendBufferSize < 1 {
+		return nil, ErrInvalidSendBufferSize
+	}
+
+	return &liveFeed{
+		subscribers:    make(map[uint64]*subscriber),
+		maxSubscribers: args.MaxSubscribers,
+		sendBufferSize: args.SendBufferSize,
+		writeTimeout:   args.WriteTimeout,
+	}, nil
+}
+
+// AddSubscriber will register the provided connection and will start pushing the documents that match the filter
+func (lf *liveFeed) AddSubscriber(conn core.WSConnection, filter *data.FeedFilter) error {
+	if check.IfNilReflect(conn) {
+		return ErrNilWSConnection
+	}
+	if filter == nil {
+		return ErrNilFeedFilter
+	}
+
+	lf.mutSubscribers.Lock()
+	if lf.closed {
+		lf.mutSubscribers.Unlock()
+		return ErrLiveFeedClosed
+	}
+	if len(lf.subscribers) >= lf.maxSubscribers {
+		lf.mutSubscribers.Unlock()
+		return ErrTooManySubscribers
+	}
+
+	lf.lastSubscriberID++
+	sub := newSubscriber(lf.lastSubscriberID, conn, filter, lf.sendBufferSize, lf.writeTimeout, lf.removeSubscriber)
+	lf.subscribers[sub.id] = sub
+	lf.mutSubscribers.Unlock()
+
+	log.Debug("new live feed subscriber", "id", sub.id)
+	sub.start()
+
+	return nil
+}
+
+func (lf *liveFeed) removeSubscriber(id uint64) {
+	lf.mutSubscribers.Lock()
+	delete(lf.subscribers, id)
+	lf.mutSubscribers.Unlock()
+
+	log.Debug("live feed subscriber removed", "id", id)
+}
+
+// Publish will push the documents of an indexed block to all the subscribers whose filter matches them
+func (lf *liveFeed) Publish(indexedData *data.IndexedBlockData) {
+	if indexedData == nil {
+		return
+	}
+
+	for _, sub := range lf.getSubscribers() {
+		message := createFeedMessage(indexedData, sub.filter)
+		if message.isEmpty() {
+			continue
+		}
+
+		messageBytes, err := json.Marshal(message)
+		if err != nil {
+			log.Warn("cannot marshal live feed message", "error", err)
+			continue
+		}
+
+		sub.send(messageBytes)
+	}
+}
+
+func (lf *liveFeed) getSubscribers() []*subscriber {
+	lf.mutSubscribers.RLock()
+	defer lf.mutSubscribers.RUnlock()
+
+	subscribers := make([]*subscriber, 0, len(lf.subscribers))
+	for _, sub := range lf.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+
+	return subscribers
+}
+
+// Close will disconnect all the subscribers
+func (lf *liveFeed) Close() error {
+	lf.mutSubscribers.Lock()
+	lf.closed = true
+	lf.mutSubscribers.Unlock()
+
+	for _, sub := range lf.getSubscribers() {
+		sub.close()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lf *liveFeed) IsInterfaceNil() bool {
+	return lf == nil
+}
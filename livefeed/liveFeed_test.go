@@ -0,0 +1,208 @@
+package livefeed
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+)
+
+func createMockArgsLiveFeed() ArgsLiveFeed {
+	return ArgsLiveFeed{
+		MaxSubscribers: 2,
+		SendBufferSize: 10,
+		WriteTimeout:   time.Second,
+	}
+}
+
+func createConnectionStub(written chan []byte) (*mock.WSConnectionStub, chan struct{}) {
+	closed := make(chan struct{})
+	closeOnce := sync.Once{}
+
+	return &mock.WSConnectionStub{
+		ReadMessageCalled: func() (messageType int, p []byte, err error) {
+			<-closed
+			return 0, nil, errors.New("connection closed")
+		},
+		WriteMessageCalled: func(messageType int, data []byte) error {
+			if messageType != websocket.TextMessage {
+				return nil
+			}
+
+			select {
+			case written <- data:
+				return nil
+			case <-closed:
+				return errors.New("connection closed")
+			}
+		},
+		CloseCalled: func() error {
+			closeOnce.Do(func() {
+				close(closed)
+			})
+			return nil
+		},
+	}, closed
+}
+
+func TestNewLiveFeed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max subscribers", func(t *testing.T) {
+		args := createMockArgsLiveFeed()
+		args.MaxSubscribers = 0
+
+		lf, err := NewLiveFeed(args)
+		require.Nil(t, lf)
+		require.Equal(t, ErrInvalidMaxSubscribers, err)
+	})
+	t.Run("invalid send buffer size", func(t *testing.T) {
+		args := createMockArgsLiveFeed()
+		args.SendBufferSize = 0
+
+		lf, err := NewLiveFeed(args)
+		require.Nil(t, lf)
+		require.Equal(t, ErrInvalidSendBufferSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		lf, err := NewLiveFeed(createMockArgsLiveFeed())
+		require.Nil(t, err)
+		require.False(t, lf.IsInterfaceNil())
+	})
+}
+
+func TestLiveFeed_AddSubscriber(t *testing.T) {
+	t.Parallel()
+
+	lf, _ := NewLiveFeed(createMockArgsLiveFeed())
+
+	err := lf.AddSubscriber(nil, data.NewFeedFilter())
+	require.Equal(t, ErrNilWSConnection, err)
+
+	conn1, _ := createConnectionStub(make(chan []byte, 1))
+	err = lf.AddSubscriber(conn1, nil)
+	require.Equal(t, ErrNilFeedFilter, err)
+
+	err = lf.AddSubscriber(conn1, data.NewFeedFilter())
+	require.Nil(t, err)
+	conn2, _ := createConnectionStub(make(chan []byte, 1))
+	err = lf.AddSubscriber(conn2, data.NewFeedFilter())
+	require.Nil(t, err)
+
+	conn3, _ := createConnectionStub(make(chan []byte, 1))
+	err = lf.AddSubscriber(conn3, data.NewFeedFilter())
+	require.Equal(t, ErrTooManySubscribers, err)
+
+	require.Nil(t, lf.Close())
+	err = lf.AddSubscriber(conn3, data.NewFeedFilter())
+	require.Equal(t, ErrLiveFeedClosed, err)
+}
+
+func TestLiveFeed_PublishShouldSendOnlyMatchingDocuments(t *testing.T) {
+	t.Parallel()
+
+	lf, _ := NewLiveFeed(createMockArgsLiveFeed())
+
+	written := make(chan []byte, 1)
+	conn, _ := createConnectionStub(written)
+	filter := data.NewFeedFilter()
+	filter.Addresses["drt1alice"] = struct{}{}
+	err := lf.AddSubscriber(conn, filter)
+	require.Nil(t, err)
+
+	lf.Publish(&data.IndexedBlockData{
+		HeaderHash: "aabb",
+		Nonce:      10,
+		ShardID:    1,
+		Timestamp:  5040,
+		Transactions: []*data.Transaction{
+			{Hash: "h1", Sender: "drt1alice", Receiver: "drt1bob"},
+			{Hash: "h2", Sender: "drt1carol", Receiver: "drt1bob"},
+		},
+	})
+
+	select {
+	case messageBytes := <-written:
+		message := &feedMessage{}
+		err = json.Unmarshal(messageBytes, message)
+		require.Nil(t, err)
+		require.Equal(t, "aabb", message.HeaderHash)
+		require.Equal(t, uint64(10), message.Nonce)
+		require.Len(t, message.Transactions, 1)
+		require.Equal(t, "h1", message.Transactions[0].Hash)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout while waiting for the live feed message")
+	}
+
+	// a block without matching documents should not be pushed
+	lf.Publish(&data.IndexedBlockData{
+		Transactions: []*data.Transaction{{Hash: "h3", Sender: "drt1carol", Receiver: "drt1bob"}},
+	})
+	select {
+	case <-written:
+		require.Fail(t, "should have not received a message")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.Nil(t, lf.Close())
+}
+
+func TestLiveFeed_SlowSubscriberShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsLiveFeed()
+	args.SendBufferSize = 1
+	lf, _ := NewLiveFeed(args)
+
+	// nobody reads from the written channel, so the subscriber will be blocked while writing the first message
+	conn, closed := createConnectionStub(make(chan []byte))
+	err := lf.AddSubscriber(conn, data.NewFeedFilter())
+	require.Nil(t, err)
+
+	indexedData := &data.IndexedBlockData{
+		Transactions: []*data.Transaction{{Hash: "h1"}},
+	}
+	for i := 0; i < 3; i++ {
+		lf.Publish(indexedData)
+	}
+
+	require.Eventually(t, func() bool {
+		return len(lf.getSubscribers()) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	_ = conn.Close()
+	<-closed
+}
+
+func TestLiveFeed_SubscriberShouldBeRemovedWhenConnectionIsClosed(t *testing.T) {
+	t.Parallel()
+
+	lf, _ := NewLiveFeed(createMockArgsLiveFeed())
+
+	conn, _ := createConnectionStub(make(chan []byte, 1))
+	err := lf.AddSubscriber(conn, data.NewFeedFilter())
+	require.Nil(t, err)
+	require.Len(t, lf.getSubscribers(), 1)
+
+	_ = conn.Close()
+	require.Eventually(t, func() bool {
+		return len(lf.getSubscribers()) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDisabledLiveFeed(t *testing.T) {
+	t.Parallel()
+
+	dlf := NewDisabledLiveFeed()
+	require.False(t, dlf.IsInterfaceNil())
+	require.Equal(t, ErrLiveFeedDisabled, dlf.AddSubscriber(&mock.WSConnectionStub{}, data.NewFeedFilter()))
+	dlf.Publish(&data.IndexedBlockData{})
+	require.Nil(t, dlf.Close())
+}
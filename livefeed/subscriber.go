@@ -0,0 +1,99 @@
+package livefeed
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type subscriber struct {
+	id           uint64
+	conn         core.WSConnection
+	filter       *data.FeedFilter
+	writeTimeout time.Duration
+	messages     chan []byte
+	closeChan    chan struct{}
+	closeOnce    sync.Once
+	onClose      func(id uint64)
+}
+
+func newSubscriber(
+	id uint64,
+	conn core.WSConnection,
+	filter *data.FeedFilter,
+	sendBufferSize int,
+	writeTimeout time.Duration,
+	onClose func(id uint64),
+) *subscriber {
+	return &subscriber{
+		id:           id,
+		conn:         conn,
+		filter:       filter,
+		writeTimeout: writeTimeout,
+		messages:     make(chan []byte, sendBufferSize),
+		closeChan:    make(chan struct{}),
+		onClose:      onClose,
+	}
+}
+
+func (s *subscriber) start() {
+	go s.writeLoop()
+	go s.readLoop()
+}
+
+// send will queue the message without blocking. A subscriber that cannot keep up is disconnected, so it can
+// reconnect and fetch the missed documents from the database instead of silently losing them
+func (s *subscriber) send(message []byte) {
+	select {
+	case <-s.closeChan:
+	case s.messages <- message:
+	default:
+		log.Debug("live feed subscriber is too slow, disconnecting", "id", s.id)
+		s.close()
+	}
+}
+
+func (s *subscriber) writeLoop() {
+	defer func() {
+		_ = s.conn.Close()
+	}()
+
+	for {
+		select {
+		case message := <-s.messages:
+			_ = s.conn.SetWriteDeadline(time.Now().Add(s.writeTimeout))
+			err := s.conn.WriteMessage(websocket.TextMessage, message)
+			if err != nil {
+				log.Debug("cannot write live feed message", "id", s.id, "error", err)
+				s.close()
+				return
+			}
+		case <-s.closeChan:
+			_ = s.conn.SetWriteDeadline(time.Now().Add(s.writeTimeout))
+			_ = s.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		}
+	}
+}
+
+// readLoop only drains the incoming messages in order to process the control frames and to detect a closed connection
+func (s *subscriber) readLoop() {
+	for {
+		_, _, err := s.conn.ReadMessage()
+		if err != nil {
+			s.close()
+			return
+		}
+	}
+}
+
+func (s *subscriber) close() {
+	s.closeOnce.Do(func() {
+		close(s.closeChan)
+		s.onClose(s.id)
+	})
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+)
+
+// PipelinesStatusMetricsHandler defines the behavior of the status metrics that are shared by several pipelines
+type PipelinesStatusMetricsHandler interface {
+	AddPipelineIndexingData(pipeline string, args ArgsAddIndexingData)
+	SetPipelineGauge(pipeline string, name string, value uint64)
+	GetMetrics() map[string]*request.MetricsResponse
+	GetMetricsForPrometheus() string
+	IsInterfaceNil() bool
+}
+
+type pipelineStatusMetrics struct {
+	statusMetrics PipelinesStatusMetricsHandler
+	pipeline      string
+}
+
+// NewPipelineStatusMetrics will return a status metrics handler that records the metrics of the provided pipeline
+// in the shared status metrics
+func NewPipelineStatusMetrics(statusMetrics PipelinesStatusMetricsHandler, pipeline string) *pipelineStatusMetrics {
+	return &pipelineStatusMetrics{
+		statusMetrics: statusMetrics,
+		pipeline:      pipeline,
+	}
+}
+
+// AddIndexingData will add the indexing data for the given topic of the pipeline
+func (psm *pipelineStatusMetrics) AddIndexingData(args ArgsAddIndexingData) {
+	psm.statusMetrics.AddPipelineIndexingData(psm.pipeline, args)
+}
+
+// SetGauge will set the current value of the provided gauge of the pipeline
+func (psm *pipelineStatusMetrics) SetGauge(name string, value uint64) {
+	psm.statusMetrics.SetPipelineGauge(psm.pipeline, name, value)
+}
+
+// GetMetrics returns the metrics of all the pipelines
+func (psm *pipelineStatusMetrics) GetMetrics() map[string]*request.MetricsResponse {
+	return psm.statusMetrics.GetMetrics()
+}
+
+// GetMetricsForPrometheus returns the metrics of all the pipelines in a prometheus format
+func (psm *pipelineStatusMetrics) GetMetricsForPrometheus() string {
+	return psm.statusMetrics.GetMetricsForPrometheus()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psm *pipelineStatusMetrics) IsInterfaceNil() bool {
+	return psm == nil
+}
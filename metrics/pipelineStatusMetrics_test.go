@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineStatusMetrics(t *testing.T) {
+	t.Parallel()
+
+	statusMetricsHandler := NewStatusMetrics()
+	sovereignMetrics := NewPipelineStatusMetrics(statusMetricsHandler, "sovereign")
+	require.False(t, sovereignMetrics.IsInterfaceNil())
+
+	sovereignMetrics.AddIndexingData(ArgsAddIndexingData{
+		MessageLen: 100,
+		Topic:      "req_bulk_0",
+	})
+	statusMetricsHandler.AddIndexingData(ArgsAddIndexingData{
+		MessageLen: 50,
+		Topic:      "req_bulk_0",
+	})
+
+	metrics := sovereignMetrics.GetMetrics()
+	require.Len(t, metrics, 2)
+	require.Equal(t, uint64(100), metrics["sovereign/req_bulk_0"].TotalData)
+	require.Equal(t, uint64(50), metrics["req_bulk_0"].TotalData)
+
+	sovereignMetrics.SetGauge("pendingMainChainTokens", 3)
+	prometheusMetrics := statusMetricsHandler.GetMetricsForPrometheus()
+	require.Contains(t, prometheusMetrics, `req_bulk{pipeline="sovereign",operation="total_data",shardID="0"} 100`)
+	require.Contains(t, prometheusMetrics, `req_bulk{operation="total_data",shardID="0"} 50`)
+	require.Contains(t, prometheusMetrics, `pending_main_chain_tokens{pipeline="sovereign"} 3`)
+}
@@ -13,15 +13,16 @@ const (
 	operationName = "operation"
 	shardIDName   = "shardID"
 	errorCodeName = "errorCode"
+	pipelineName  = "pipeline"
 )
 
-func counterMetric(metricName, operation string, shardIDStr string, count uint64) string {
+func counterMetric(metricName, pipeline, operation string, shardIDStr string, count uint64) string {
 	metricFamily := &dto.MetricFamily{
 		Name: proto.String(metricName),
 		Type: dto.MetricType_COUNTER.Enum(),
 		Metric: []*dto.Metric{
 			{
-				Label: []*dto.LabelPair{
+				Label: withPipelineLabel(pipeline, []*dto.LabelPair{
 					{
 						Name:  proto.String(operationName),
 						Value: proto.String(operation),
@@ -30,7 +31,7 @@ func counterMetric(metricName, operation string, shardIDStr string, count uint64
 						Name:  proto.String(shardIDName),
 						Value: proto.String(shardIDStr),
 					},
-				},
+				}),
 				Counter: &dto.Counter{
 					Value: proto.Float64(float64(count)),
 				},
@@ -41,7 +42,7 @@ func counterMetric(metricName, operation string, shardIDStr string, count uint64
 	return promMetricAsString(metricFamily)
 }
 
-func errorsMetric(metricName, operation string, shardIDStr string, errorsCount map[int]uint64) string {
+func errorsMetric(metricName, pipeline, operation string, shardIDStr string, errorsCount map[int]uint64) string {
 	metricFamily := &dto.MetricFamily{
 		Name:   proto.String(metricName),
 		Type:   dto.MetricType_GAUGE.Enum(),
@@ -50,7 +51,7 @@ func errorsMetric(metricName, operation string, shardIDStr string, errorsCount m
 
 	for code, count := range errorsCount {
 		m := &dto.Metric{
-			Label: []*dto.LabelPair{
+			Label: withPipelineLabel(pipeline, []*dto.LabelPair{
 				{
 					Name:  proto.String(operationName),
 					Value: proto.String(operation),
@@ -63,7 +64,7 @@ func errorsMetric(metricName, operation string, shardIDStr string, errorsCount m
 					Name:  proto.String(errorCodeName),
 					Value: proto.String(strconv.Itoa(code)),
 				},
-			},
+			}),
 			Gauge: &dto.Gauge{
 				Value: proto.Float64(float64(count)),
 			},
@@ -75,6 +76,37 @@ func errorsMetric(metricName, operation string, shardIDStr string, errorsCount m
 	return promMetricAsString(metricFamily)
 }
 
+func gaugeMetric(metricName, pipeline string, value uint64) string {
+	metricFamily := &dto.MetricFamily{
+		Name: proto.String(metricName),
+		Type: dto.MetricType_GAUGE.Enum(),
+		Metric: []*dto.Metric{
+			{
+				Label: withPipelineLabel(pipeline, nil),
+				Gauge: &dto.Gauge{
+					Value: proto.Float64(float64(value)),
+				},
+			},
+		},
+	}
+
+	return promMetricAsString(metricFamily)
+}
+
+// the metrics of the unnamed pipeline have no pipeline label, so a single pipeline setup keeps the same metrics
+func withPipelineLabel(pipeline string, labels []*dto.LabelPair) []*dto.LabelPair {
+	if pipeline == "" {
+		return labels
+	}
+
+	pipelineLabel := &dto.LabelPair{
+		Name:  proto.String(pipelineName),
+		Value: proto.String(pipeline),
+	}
+
+	return append([]*dto.LabelPair{pipelineLabel}, labels...)
+}
+
 func promMetricAsString(metric *dto.MetricFamily) string {
 	out := bytes.NewBuffer(make([]byte, 0))
 	_, err := expfmt.MetricFamilyToText(out, metric)
@@ -20,6 +20,7 @@ const (
 
 type statusMetrics struct {
 	metrics map[string]*request.MetricsResponse
+	gauges  map[string]uint64
 	mut     sync.RWMutex
 }
 
@@ -27,15 +28,21 @@ type statusMetrics struct {
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
 		metrics: make(map[string]*request.MetricsResponse),
+		gauges:  make(map[string]uint64),
 	}
 }
 
 // AddIndexingData will add the indexing data for the give topic
 func (sm *statusMetrics) AddIndexingData(args ArgsAddIndexingData) {
+	sm.AddPipelineIndexingData("", args)
+}
+
+// AddPipelineIndexingData will add the indexing data for the given topic of the provided pipeline
+func (sm *statusMetrics) AddPipelineIndexingData(pipeline string, args ArgsAddIndexingData) {
 	sm.mut.Lock()
 	defer sm.mut.Unlock()
 
-	topic := camelToSnake(args.Topic)
+	topic := request.ExtendTopicWithPipeline(pipeline, camelToSnake(args.Topic))
 	_, found := sm.metrics[topic]
 	if !found {
 		sm.metrics[topic] = &request.MetricsResponse{
@@ -56,6 +63,18 @@ func (sm *statusMetrics) AddIndexingData(args ArgsAddIndexingData) {
 	}
 }
 
+// SetGauge will set the current value of the provided gauge
+func (sm *statusMetrics) SetGauge(name string, value uint64) {
+	sm.SetPipelineGauge("", name, value)
+}
+
+// SetPipelineGauge will set the current value of the provided gauge of the pipeline
+func (sm *statusMetrics) SetPipelineGauge(pipeline string, name string, value uint64) {
+	sm.mut.Lock()
+	sm.gauges[request.ExtendTopicWithPipeline(pipeline, camelToSnake(name))] = value
+	sm.mut.Unlock()
+}
+
 // GetMetrics returns the metrics map
 func (sm *statusMetrics) GetMetrics() map[string]*request.MetricsResponse {
 	sm.mut.RLock()
@@ -68,17 +87,27 @@ func (sm *statusMetrics) GetMetrics() map[string]*request.MetricsResponse {
 func (sm *statusMetrics) GetMetricsForPrometheus() string {
 	sm.mut.RLock()
 	metrics := sm.getAllUnprotected()
+	gauges := make(map[string]uint64, len(sm.gauges))
+	for name, value := range sm.gauges {
+		gauges[name] = value
+	}
 	sm.mut.RUnlock()
 
 	stringBuilder := strings.Builder{}
 
-	for topicWithShardID, metricsData := range metrics {
+	for key, metricsData := range metrics {
+		pipeline, topicWithShardID := request.SplitPipelineAndTopic(key)
 		topic, shardIDStr := request.SplitTopicAndShardID(topicWithShardID)
-		stringBuilder.WriteString(counterMetric(topic, totalData, shardIDStr, metricsData.TotalData))
-		stringBuilder.WriteString(counterMetric(topic, errorsCount, shardIDStr, metricsData.TotalErrorsCount))
-		stringBuilder.WriteString(counterMetric(topic, operationCount, shardIDStr, metricsData.OperationsCount))
-		stringBuilder.WriteString(counterMetric(topic, totalTime, shardIDStr, uint64(metricsData.TotalIndexingTime.Milliseconds())))
-		stringBuilder.WriteString(errorsMetric(topic, requestsErrors, shardIDStr, metricsData.ErrorsCount))
+		stringBuilder.WriteString(counterMetric(topic, pipeline, totalData, shardIDStr, metricsData.TotalData))
+		stringBuilder.WriteString(counterMetric(topic, pipeline, errorsCount, shardIDStr, metricsData.TotalErrorsCount))
+		stringBuilder.WriteString(counterMetric(topic, pipeline, operationCount, shardIDStr, metricsData.OperationsCount))
+		stringBuilder.WriteString(counterMetric(topic, pipeline, totalTime, shardIDStr, uint64(metricsData.TotalIndexingTime.Milliseconds())))
+		stringBuilder.WriteString(errorsMetric(topic, pipeline, requestsErrors, shardIDStr, metricsData.ErrorsCount))
+	}
+
+	for key, value := range gauges {
+		pipeline, name := request.SplitPipelineAndTopic(key)
+		stringBuilder.WriteString(gaugeMetric(name, pipeline, value))
 	}
 
 	promMetricsOutput := stringBuilder.String()
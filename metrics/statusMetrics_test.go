@@ -60,6 +60,20 @@ test1{operation="requests_errors",shardID="0",errorCode="400"} 1
 `, prometheusMetrics)
 }
 
+func TestStatusMetrics_SetGauge(t *testing.T) {
+	t.Parallel()
+
+	statusMetricsHandler := NewStatusMetrics()
+	statusMetricsHandler.SetGauge("pendingMainChainTokens", 5)
+	statusMetricsHandler.SetGauge("pendingMainChainTokens", 3)
+
+	require.Empty(t, statusMetricsHandler.GetMetrics())
+	require.Equal(t, `# TYPE pending_main_chain_tokens gauge
+pending_main_chain_tokens 3
+
+`, statusMetricsHandler.GetMetricsForPrometheus())
+}
+
 func TestCamelCaseToSnakeCase(t *testing.T) {
 	t.Parallel()
 
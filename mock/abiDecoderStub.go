@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+// AbiDecoderStub -
+type AbiDecoderStub struct {
+	DecodeDataCalled func(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults, alteredAccounts map[string]*alteredAccount.AlteredAccount)
+}
+
+// DecodeData -
+func (ads *AbiDecoderStub) DecodeData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults, alteredAccounts map[string]*alteredAccount.AlteredAccount) {
+	if ads.DecodeDataCalled != nil {
+		ads.DecodeDataCalled(preparedResults, logsData, alteredAccounts)
+	}
+}
+
+// IsInterfaceNil -
+func (ads *AbiDecoderStub) IsInterfaceNil() bool {
+	return ads == nil
+}
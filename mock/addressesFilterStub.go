@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+// AddressesFilterStub -
+type AddressesFilterStub struct {
+	FilterTransactionsDataCalled func(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults)
+	FilterAlteredAccountsCalled  func(alteredAccounts map[string]*alteredAccount.AlteredAccount) map[string]*alteredAccount.AlteredAccount
+}
+
+// FilterTransactionsData -
+func (afs *AddressesFilterStub) FilterTransactionsData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults) {
+	if afs.FilterTransactionsDataCalled != nil {
+		afs.FilterTransactionsDataCalled(preparedResults, logsData)
+	}
+}
+
+// FilterAlteredAccounts -
+func (afs *AddressesFilterStub) FilterAlteredAccounts(alteredAccounts map[string]*alteredAccount.AlteredAccount) map[string]*alteredAccount.AlteredAccount {
+	if afs.FilterAlteredAccountsCalled != nil {
+		return afs.FilterAlteredAccountsCalled(alteredAccounts)
+	}
+
+	return alteredAccounts
+}
+
+// IsInterfaceNil -
+func (afs *AddressesFilterStub) IsInterfaceNil() bool {
+	return afs == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+
+// DataPublisherStub -
+type DataPublisherStub struct {
+	PublishCalled func(indexedData *data.IndexedBlockData)
+}
+
+// Publish -
+func (dps *DataPublisherStub) Publish(indexedData *data.IndexedBlockData) {
+	if dps.PublishCalled != nil {
+		dps.PublishCalled(indexedData)
+	}
+}
+
+// IsInterfaceNil -
+func (dps *DataPublisherStub) IsInterfaceNil() bool {
+	return dps == nil
+}
@@ -7,20 +7,42 @@ import (
 
 // DatabaseWriterStub -
 type DatabaseWriterStub struct {
-	DoBulkRequestCalled       func(buff *bytes.Buffer, index string) error
-	DoQueryRemoveCalled       func(index string, body *bytes.Buffer) error
-	DoMultiGetCalled          func(ids []string, index string, withSource bool, response interface{}) error
-	CheckAndCreateIndexCalled func(index string) error
-	DoScrollRequestCalled     func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
+	DoBulkRequestCalled          func(buff *bytes.Buffer, index string) error
+	DoQueryRemoveCalled          func(index string, body *bytes.Buffer) error
+	DoMultiGetCalled             func(ids []string, index string, withSource bool, response interface{}) error
+	DoSearchRequestCalled        func(index string, body []byte, response interface{}) error
+	UpdateByQueryCalled          func(index string, buff *bytes.Buffer) error
+	CheckAndCreateIndexCalled    func(index string) error
+	CheckAndCreateAliasCalled    func(alias string, index string) error
+	CheckAndCreateTemplateCalled func(templateName string, template *bytes.Buffer) error
+	PutMappingsCalled            func(indexName string, mappings *bytes.Buffer) error
+	DoScrollRequestCalled        func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
+	IsEnabledCalled              func() bool
 }
 
 // PutMappings -
-func (dwm *DatabaseWriterStub) PutMappings(_ string, _ *bytes.Buffer) error {
+func (dwm *DatabaseWriterStub) PutMappings(indexName string, mappings *bytes.Buffer) error {
+	if dwm.PutMappingsCalled != nil {
+		return dwm.PutMappingsCalled(indexName, mappings)
+	}
 	return nil
 }
 
 // UpdateByQuery -
-func (dwm *DatabaseWriterStub) UpdateByQuery(_ context.Context, _ string, _ *bytes.Buffer) error {
+func (dwm *DatabaseWriterStub) UpdateByQuery(_ context.Context, index string, buff *bytes.Buffer) error {
+	if dwm.UpdateByQueryCalled != nil {
+		return dwm.UpdateByQueryCalled(index, buff)
+	}
+
+	return nil
+}
+
+// DoSearchRequest -
+func (dwm *DatabaseWriterStub) DoSearchRequest(_ context.Context, index string, body []byte, response interface{}) error {
+	if dwm.DoSearchRequestCalled != nil {
+		return dwm.DoSearchRequestCalled(index, body, response)
+	}
+
 	return nil
 }
 
@@ -72,12 +94,18 @@ func (dwm *DatabaseWriterStub) CheckAndCreateIndex(index string) error {
 }
 
 // CheckAndCreateAlias -
-func (dwm *DatabaseWriterStub) CheckAndCreateAlias(_ string, _ string) error {
+func (dwm *DatabaseWriterStub) CheckAndCreateAlias(alias string, index string) error {
+	if dwm.CheckAndCreateAliasCalled != nil {
+		return dwm.CheckAndCreateAliasCalled(alias, index)
+	}
 	return nil
 }
 
 // CheckAndCreateTemplate -
-func (dwm *DatabaseWriterStub) CheckAndCreateTemplate(_ string, _ *bytes.Buffer) error {
+func (dwm *DatabaseWriterStub) CheckAndCreateTemplate(templateName string, template *bytes.Buffer) error {
+	if dwm.CheckAndCreateTemplateCalled != nil {
+		return dwm.CheckAndCreateTemplateCalled(templateName, template)
+	}
 	return nil
 }
 
@@ -88,6 +116,9 @@ func (dwm *DatabaseWriterStub) CheckAndCreatePolicy(_ string, _ *bytes.Buffer) e
 
 // IsEnabled -
 func (dwm *DatabaseWriterStub) IsEnabled() bool {
+	if dwm.IsEnabledCalled != nil {
+		return dwm.IsEnabledCalled()
+	}
 	return false
 }
 
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+// PayloadsOffloaderStub -
+type PayloadsOffloaderStub struct {
+	OffloadPayloadsCalled   func(preparedResults *data.PreparedResults, timestamp uint64) map[string]*data.Payload
+	SerializePayloadsCalled func(payloads map[string]*data.Payload, buffSlice *data.BufferSlice, index string) error
+}
+
+// OffloadPayloads -
+func (pos *PayloadsOffloaderStub) OffloadPayloads(preparedResults *data.PreparedResults, timestamp uint64) map[string]*data.Payload {
+	if pos.OffloadPayloadsCalled != nil {
+		return pos.OffloadPayloadsCalled(preparedResults, timestamp)
+	}
+
+	return make(map[string]*data.Payload)
+}
+
+// SerializePayloads -
+func (pos *PayloadsOffloaderStub) SerializePayloads(payloads map[string]*data.Payload, buffSlice *data.BufferSlice, index string) error {
+	if pos.SerializePayloadsCalled != nil {
+		return pos.SerializePayloadsCalled(payloads, buffSlice, index)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (pos *PayloadsOffloaderStub) IsInterfaceNil() bool {
+	return pos == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+// SecondaryAddressEncoderStub -
+type SecondaryAddressEncoderStub struct {
+	EncodeTransactionsDataCalled func(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults)
+	EncodeAccountsCalled         func(accounts map[string]*data.AccountInfo)
+}
+
+// EncodeTransactionsData -
+func (saes *SecondaryAddressEncoderStub) EncodeTransactionsData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults) {
+	if saes.EncodeTransactionsDataCalled != nil {
+		saes.EncodeTransactionsDataCalled(preparedResults, logsData)
+	}
+}
+
+// EncodeAccounts -
+func (saes *SecondaryAddressEncoderStub) EncodeAccounts(accounts map[string]*data.AccountInfo) {
+	if saes.EncodeAccountsCalled != nil {
+		saes.EncodeAccountsCalled(accounts)
+	}
+}
+
+// IsInterfaceNil -
+func (saes *SecondaryAddressEncoderStub) IsInterfaceNil() bool {
+	return saes == nil
+}
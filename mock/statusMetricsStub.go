@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/metrics"
+)
+
+// StatusMetricsStub -
+type StatusMetricsStub struct {
+	AddIndexingDataCalled func(args metrics.ArgsAddIndexingData)
+	SetGaugeCalled        func(name string, value uint64)
+}
+
+// AddIndexingData -
+func (sms *StatusMetricsStub) AddIndexingData(args metrics.ArgsAddIndexingData) {
+	if sms.AddIndexingDataCalled != nil {
+		sms.AddIndexingDataCalled(args)
+	}
+}
+
+// SetGauge -
+func (sms *StatusMetricsStub) SetGauge(name string, value uint64) {
+	if sms.SetGaugeCalled != nil {
+		sms.SetGaugeCalled(name, value)
+	}
+}
+
+// GetMetrics -
+func (sms *StatusMetricsStub) GetMetrics() map[string]*request.MetricsResponse {
+	return make(map[string]*request.MetricsResponse)
+}
+
+// GetMetricsForPrometheus -
+func (sms *StatusMetricsStub) GetMetricsForPrometheus() string {
+	return ""
+}
+
+// IsInterfaceNil -
+func (sms *StatusMetricsStub) IsInterfaceNil() bool {
+	return sms == nil
+}
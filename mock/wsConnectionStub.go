@@ -0,0 +1,43 @@
+package mock
+
+import "time"
+
+// WSConnectionStub -
+type WSConnectionStub struct {
+	ReadMessageCalled      func() (messageType int, p []byte, err error)
+	WriteMessageCalled     func(messageType int, data []byte) error
+	SetWriteDeadlineCalled func(t time.Time) error
+	CloseCalled            func() error
+}
+
+// ReadMessage -
+func (wcs *WSConnectionStub) ReadMessage() (messageType int, p []byte, err error) {
+	if wcs.ReadMessageCalled != nil {
+		return wcs.ReadMessageCalled()
+	}
+	return 0, nil, nil
+}
+
+// WriteMessage -
+func (wcs *WSConnectionStub) WriteMessage(messageType int, data []byte) error {
+	if wcs.WriteMessageCalled != nil {
+		return wcs.WriteMessageCalled(messageType, data)
+	}
+	return nil
+}
+
+// SetWriteDeadline -
+func (wcs *WSConnectionStub) SetWriteDeadline(t time.Time) error {
+	if wcs.SetWriteDeadlineCalled != nil {
+		return wcs.SetWriteDeadlineCalled(t)
+	}
+	return nil
+}
+
+// Close -
+func (wcs *WSConnectionStub) Close() error {
+	if wcs.CloseCalled != nil {
+		return wcs.CloseCalled()
+	}
+	return nil
+}
@@ -49,6 +49,32 @@ const (
 	ValuesIndex = "values"
 	// EventsIndex is the Elasticsearch index for log events
 	EventsIndex = "events"
+	// TransfersIndex is the Elasticsearch index for the individual value transfers
+	TransfersIndex = "transfers"
+	// ContractCallsIndex is the Elasticsearch index for the smart contract call graph edges
+	ContractCallsIndex = "contractcalls"
+	// ContractCallStatsIndex is the Elasticsearch index for the aggregated smart contract calls
+	ContractCallStatsIndex = "contractcallstats"
+	// TxTracesIndex is the Elasticsearch index for the aggregated execution traces of the transactions
+	TxTracesIndex = "txtraces"
+	// AddressActivityIndex is the Elasticsearch index for the aggregated activity of the addresses
+	AddressActivityIndex = "addressactivity"
+	// BridgeOperationsIndex is the Elasticsearch index for the outgoing bridge operations of a sovereign chain
+	BridgeOperationsIndex = "bridgeoperations"
+	// BridgeTransfersIndex is the Elasticsearch index for the cross-chain transfers of a sovereign chain
+	BridgeTransfersIndex = "bridgetransfers"
+	// PayloadsIndex is the Elasticsearch index for the compressed data fields that were offloaded from the transactions
+	PayloadsIndex = "payloads"
+	// TxStatusHistoryIndex is the Elasticsearch index for the status transitions of the transactions and smart contract results
+	TxStatusHistoryIndex = "txstatushistory"
+	// UsernamesIndex is the Elasticsearch index for the usernames registry
+	UsernamesIndex = "usernames"
+	// GuardiansIndex is the Elasticsearch index for the guardian state of the accounts
+	GuardiansIndex = "guardians"
+	// ProvidersIndex is the Elasticsearch index for the staking providers
+	ProvidersIndex = "providers"
+	// NodesIndex is the Elasticsearch index for the owners and staking providers of the nodes
+	NodesIndex = "nodes"
 
 	// TransactionsPolicy is the Elasticsearch policy for the transactions
 	TransactionsPolicy = "transactions_policy"
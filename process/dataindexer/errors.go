@@ -8,6 +8,9 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 // ErrNilPubkeyConverter signals that an operation has been attempted to or with a nil public key converter implementation
 var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
 
+// ErrNilValidatorPubkeyConverter signals that a nil validator public key converter has been provided
+var ErrNilValidatorPubkeyConverter = errors.New("nil validator pubkey converter")
+
 // ErrNegativeDenominationValue signals that a negative denomination value has been provided
 var ErrNegativeDenominationValue = errors.New("negative denomination value")
 
@@ -86,8 +89,98 @@ var ErrNilBalanceConverter = errors.New("nil balance converter")
 // ErrNilOperationsHandler signals that a nil operations handler has been provided
 var ErrNilOperationsHandler = errors.New("nil operations handler")
 
+// ErrNilTransfersHandler signals that a nil transfers handler has been provided
+var ErrNilTransfersHandler = errors.New("nil transfers handler")
+
+// ErrNilContractCallsHandler signals that a nil contract calls handler has been provided
+var ErrNilContractCallsHandler = errors.New("nil contract calls handler")
+
+// ErrNilTxTracesHandler signals that a nil transaction traces handler has been provided
+var ErrNilTxTracesHandler = errors.New("nil transaction traces handler")
+
+// ErrNilTxStatusHistoryHandler signals that a nil transaction status history handler has been provided
+var ErrNilTxStatusHistoryHandler = errors.New("nil transaction status history handler")
+
+// ErrNilUsernamesHandler signals that a nil usernames handler has been provided
+var ErrNilUsernamesHandler = errors.New("nil usernames handler")
+
+// ErrNilGuardiansHandler signals that a nil guardians handler has been provided
+var ErrNilGuardiansHandler = errors.New("nil guardians handler")
+
+// ErrNilAddressActivityHandler signals that a nil address activity handler has been provided
+var ErrNilAddressActivityHandler = errors.New("nil address activity handler")
+
+// ErrNilTokenHoldersHandler signals that a nil token holders handler has been provided
+var ErrNilTokenHoldersHandler = errors.New("nil token holders handler")
+
 // ErrNilBlockContainerHandler signals that a nil block container handler has been provided
 var ErrNilBlockContainerHandler = errors.New("nil bock container handler")
 
 // ErrNilIndexTokensHandler signals that a nil index tokens handler has been provided
 var ErrNilIndexTokensHandler = errors.New("nil index tokens handler")
+
+// ErrNilDataPublisher signals that a nil data publisher has been provided
+var ErrNilDataPublisher = errors.New("nil data publisher")
+
+// ErrNilCustomEventsRegistry signals that a nil custom events registry has been provided
+var ErrNilCustomEventsRegistry = errors.New("nil custom events registry")
+
+// ErrNilCustomEventsProcessor signals that a nil custom events processor has been provided
+var ErrNilCustomEventsProcessor = errors.New("nil custom events processor")
+
+// ErrCustomEventsProcessorAlreadyRegistered signals that a custom events processor with the same name is already registered
+var ErrCustomEventsProcessorAlreadyRegistered = errors.New("custom events processor already registered")
+
+// ErrUnknownCustomEventsProcessor signals that the enabled custom events processor is not registered
+var ErrUnknownCustomEventsProcessor = errors.New("unknown custom events processor")
+
+// ErrInvalidCustomEventRule signals that a custom event rule is not properly configured
+var ErrInvalidCustomEventRule = errors.New("invalid custom event rule")
+
+// ErrInvalidContractAbi signals that a contract ABI is not properly configured
+var ErrInvalidContractAbi = errors.New("invalid contract abi")
+
+// ErrNilAbiDecoder signals that a nil ABI decoder has been provided
+var ErrNilAbiDecoder = errors.New("nil abi decoder")
+
+// ErrNilBridgeTransfersHandler signals that a nil bridge transfers handler has been provided
+var ErrNilBridgeTransfersHandler = errors.New("nil bridge transfers handler")
+
+// ErrNilBlockProcessorCreator signals that a nil block processor creator has been provided
+var ErrNilBlockProcessorCreator = errors.New("nil block processor creator")
+
+// ErrNilAccountsProcessorCreator signals that a nil accounts processor creator has been provided
+var ErrNilAccountsProcessorCreator = errors.New("nil accounts processor creator")
+
+// ErrNilEventsProcessorsCreator signals that a nil events processors creator has been provided
+var ErrNilEventsProcessorsCreator = errors.New("nil events processors creator")
+
+// ErrEmptySupportedIndexes signals that an empty slice of supported indexes has been provided
+var ErrEmptySupportedIndexes = errors.New("empty supported indexes slice")
+
+// ErrEmptyIndexNamespace signals that an empty index namespace has been provided
+var ErrEmptyIndexNamespace = errors.New("empty index namespace")
+
+// ErrInvalidPipelineConfig signals that a pipeline is not properly configured
+var ErrInvalidPipelineConfig = errors.New("invalid pipeline config")
+
+// ErrUnknownPubkeyConverterType signals that an unknown public keys converter type has been provided
+var ErrUnknownPubkeyConverterType = errors.New("unknown public keys converter type")
+
+// ErrInvalidPubkeyLength signals that an invalid public key length has been provided
+var ErrInvalidPubkeyLength = errors.New("invalid public key length")
+
+// ErrNilSecondaryAddressEncoder signals that a nil secondary address encoder has been provided
+var ErrNilSecondaryAddressEncoder = errors.New("nil secondary address encoder")
+
+// ErrNilAddressesFilter signals that a nil addresses filter has been provided
+var ErrNilAddressesFilter = errors.New("nil addresses filter")
+
+// ErrInvalidFieldsProjectionRule signals that a fields projection rule is not properly configured
+var ErrInvalidFieldsProjectionRule = errors.New("invalid fields projection rule")
+
+// ErrNilPayloadsOffloader signals that a nil payloads offloader has been provided
+var ErrNilPayloadsOffloader = errors.New("nil payloads offloader")
+
+// ErrEmptyBridgeContractAddress signals that an empty bridge contract address has been provided
+var ErrEmptyBridgeContractAddress = errors.New("empty bridge contract address")
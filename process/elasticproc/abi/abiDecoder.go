@@ -0,0 +1,325 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+// maxDecodedArgs bounds the number of decoded arguments or fields, so the decoded objects remain small
+const maxDecodedArgs = 32
+
+var log = logger.GetOrCreate("indexer/process/abi")
+
+// ContractAbi links an ABI file to a contract address or to all the contracts that have the code hash
+type ContractAbi struct {
+	Address  string
+	CodeHash string
+	AbiFile  string
+}
+
+// ArgsAbiDecoder holds all dependencies required to create a new instance of abiDecoder
+type ArgsAbiDecoder struct {
+	PubKeyConverter core.PubkeyConverter
+	Contracts       []ContractAbi
+}
+
+type abiDecoder struct {
+	typesDecoder   *typesDecoder
+	abisByAddress  map[string]*contractAbi
+	abisByCodeHash map[string]*contractAbi
+	// contractsCodeHash holds the hex encoded code hash of the contracts that have a configured code hash
+	contractsCodeHash map[string]string
+}
+
+// NewAbiDecoder will create a new instance of abiDecoder, which loads all the configured ABI files
+func NewAbiDecoder(args ArgsAbiDecoder) (*abiDecoder, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+
+	ad := &abiDecoder{
+		typesDecoder:      &typesDecoder{pubKeyConverter: args.PubKeyConverter},
+		abisByAddress:     make(map[string]*contractAbi),
+		abisByCodeHash:    make(map[string]*contractAbi),
+		contractsCodeHash: make(map[string]string),
+	}
+
+	for _, contract := range args.Contracts {
+		if (contract.Address == "") == (contract.CodeHash == "") {
+			return nil, fmt.Errorf("%w: exactly one of address or code hash should be set for %s", dataindexer.ErrInvalidContractAbi, contract.AbiFile)
+		}
+
+		ca, err := loadContractAbi(contract.AbiFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s while loading %s", dataindexer.ErrInvalidContractAbi, err.Error(), contract.AbiFile)
+		}
+
+		if contract.Address != "" {
+			ad.abisByAddress[contract.Address] = ca
+			continue
+		}
+		ad.abisByCodeHash[strings.ToLower(contract.CodeHash)] = ca
+	}
+
+	return ad, nil
+}
+
+// DecodeData will decode the data field of the transactions and smart contract results and the events of the
+// contracts that have an ABI
+func (ad *abiDecoder) DecodeData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults, alteredAccounts map[string]*alteredAccount.AlteredAccount) {
+	ad.updateContractsCodeHash(logsData, alteredAccounts)
+
+	if preparedResults != nil {
+		for _, tx := range preparedResults.Transactions {
+			tx.DecodedData = ad.decodeCall(tx.Receiver, tx.Receivers, tx.Function, tx.Data)
+		}
+		for _, scr := range preparedResults.ScResults {
+			scr.DecodedData = ad.decodeCall(scr.Receiver, scr.Receivers, scr.Function, scr.Data)
+		}
+	}
+
+	if logsData != nil {
+		for _, event := range logsData.DBEvents {
+			event.DecodedData = ad.decodeEvent(event)
+		}
+	}
+}
+
+func (ad *abiDecoder) updateContractsCodeHash(logsData *data.PreparedLogsResults, alteredAccounts map[string]*alteredAccount.AlteredAccount) {
+	if len(ad.abisByCodeHash) == 0 {
+		return
+	}
+
+	for address, account := range alteredAccounts {
+		if account == nil || account.AdditionalData == nil {
+			continue
+		}
+		ad.setContractCodeHash(address, account.AdditionalData.CodeHash)
+	}
+
+	if logsData == nil {
+		return
+	}
+	for address, deployInfo := range logsData.ScDeploys {
+		ad.setContractCodeHash(address, deployInfo.CodeHash)
+	}
+}
+
+func (ad *abiDecoder) setContractCodeHash(address string, codeHash []byte) {
+	if len(codeHash) == 0 {
+		return
+	}
+
+	codeHashHex := hex.EncodeToString(codeHash)
+	_, hasAbi := ad.abisByCodeHash[codeHashHex]
+	if hasAbi {
+		ad.contractsCodeHash[address] = codeHashHex
+		return
+	}
+
+	// the contract was upgraded to a code without ABI
+	delete(ad.contractsCodeHash, address)
+}
+
+func (ad *abiDecoder) getContractAbi(address string) (*contractAbi, bool) {
+	ca, found := ad.abisByAddress[address]
+	if found {
+		return ca, true
+	}
+
+	codeHash, found := ad.contractsCodeHash[address]
+	if !found {
+		return nil, false
+	}
+
+	ca, found = ad.abisByCodeHash[codeHash]
+	return ca, found
+}
+
+func (ad *abiDecoder) decodeCall(receiver string, receivers []string, function string, dataField []byte) *data.DecodedCall {
+	if function == "" || len(dataField) == 0 {
+		return nil
+	}
+
+	ca, found := ad.getContractAbi(receiver)
+	if !found && len(receivers) == 1 {
+		// the transfers of NFTs have the real receiver in the data field
+		ca, found = ad.getContractAbi(receivers[0])
+	}
+	if !found {
+		return nil
+	}
+
+	endpoint, found := ca.endpoints[function]
+	if !found {
+		return nil
+	}
+
+	rawArgs, found := extractCallArguments(function, dataField)
+	if !found {
+		return nil
+	}
+
+	return &data.DecodedCall{
+		Function: function,
+		Args:     ad.decodeArguments(endpoint.Inputs, rawArgs),
+	}
+}
+
+// extractCallArguments returns the arguments that follow the function, which can be the first part of the data field
+// or a hex encoded argument of a built-in function, such as a DCDT transfer
+func extractCallArguments(function string, dataField []byte) ([][]byte, bool) {
+	parts := strings.Split(string(dataField), "@")
+	functionIdx := -1
+	if parts[0] == function {
+		functionIdx = 0
+	} else {
+		hexFunction := hex.EncodeToString([]byte(function))
+		for idx := 1; idx < len(parts); idx++ {
+			if parts[idx] == hexFunction {
+				functionIdx = idx
+				break
+			}
+		}
+	}
+	if functionIdx < 0 {
+		return nil, false
+	}
+
+	rawArgs := make([][]byte, 0, len(parts)-functionIdx-1)
+	for _, part := range parts[functionIdx+1:] {
+		arg, err := hex.DecodeString(part)
+		if err != nil {
+			return nil, false
+		}
+		rawArgs = append(rawArgs, arg)
+	}
+
+	return rawArgs, true
+}
+
+func (ad *abiDecoder) decodeArguments(inputs []*paramDef, rawArgs [][]byte) map[string]interface{} {
+	args := make(map[string]interface{})
+	for idx, input := range inputs {
+		if idx >= maxDecodedArgs || idx >= len(rawArgs) {
+			break
+		}
+
+		outerType, innerType := splitGenericType(input.Type)
+		if outerType == variadicType || outerType == multiType {
+			values := make([]interface{}, 0)
+			for _, rawArg := range rawArgs[idx:] {
+				if len(values) == maxListItems {
+					break
+				}
+				values = append(values, ad.typesDecoder.decodeTopLevel(innerType, rawArg))
+			}
+			args[paramName(input, idx)] = values
+			break
+		}
+
+		args[paramName(input, idx)] = ad.typesDecoder.decodeTopLevel(input.Type, rawArgs[idx])
+	}
+
+	return args
+}
+
+func (ad *abiDecoder) decodeEvent(event *data.LogEvent) *data.DecodedEvent {
+	if len(event.Topics) == 0 {
+		return nil
+	}
+
+	ca, found := ad.getContractAbi(event.Address)
+	if !found {
+		return nil
+	}
+
+	identifier, err := hex.DecodeString(event.Topics[0])
+	if err != nil {
+		return nil
+	}
+	eventDefinition, found := ca.events[string(identifier)]
+	if !found {
+		return nil
+	}
+
+	dataBytes, err := hex.DecodeString(event.Data)
+	if err != nil {
+		return nil
+	}
+
+	fields := make(map[string]interface{})
+	dataInputs := make([]*paramDef, 0)
+	topicIdx := 1
+	for idx, input := range eventDefinition.Inputs {
+		if idx >= maxDecodedArgs {
+			break
+		}
+		if !input.Indexed {
+			dataInputs = append(dataInputs, &paramDef{Name: paramName(input, idx), Type: input.Type})
+			continue
+		}
+		if topicIdx >= len(event.Topics) {
+			continue
+		}
+
+		topic, errDecode := hex.DecodeString(event.Topics[topicIdx])
+		topicIdx++
+		if errDecode != nil {
+			continue
+		}
+		fields[paramName(input, idx)] = ad.typesDecoder.decodeTopLevel(input.Type, topic)
+	}
+
+	ad.decodeEventData(fields, dataInputs, dataBytes)
+
+	return &data.DecodedEvent{
+		Identifier: eventDefinition.Identifier,
+		Fields:     fields,
+	}
+}
+
+// decodeEventData decodes the data of an event, which holds the not indexed inputs: a single input takes the whole data,
+// while more inputs are nested encoded one after another
+func (ad *abiDecoder) decodeEventData(fields map[string]interface{}, dataInputs []*paramDef, dataBytes []byte) {
+	if len(dataInputs) == 0 || len(dataBytes) == 0 {
+		return
+	}
+	if len(dataInputs) == 1 {
+		fields[dataInputs[0].Name] = ad.typesDecoder.decodeTopLevel(dataInputs[0].Type, dataBytes)
+		return
+	}
+
+	offset := 0
+	for _, input := range dataInputs {
+		value, consumed, err := ad.typesDecoder.decodeNested(input.Type, dataBytes[offset:])
+		if err != nil {
+			log.Trace("abiDecoder.decodeEventData: cannot decode event data", "input", input.Name, "error", err)
+			return
+		}
+		fields[input.Name] = value
+		offset += consumed
+	}
+}
+
+func paramName(param *paramDef, idx int) string {
+	if param.Name != "" {
+		return param.Name
+	}
+
+	return fmt.Sprintf("arg%d", idx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *abiDecoder) IsInterfaceNil() bool {
+	return ad == nil
+}
@@ -0,0 +1,171 @@
+package abi
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+const pairAbiFile = "testdata/pair.abi.json"
+
+func TestNewAbiDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter", func(t *testing.T) {
+		ad, err := NewAbiDecoder(ArgsAbiDecoder{})
+		require.Nil(t, ad)
+		require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+	})
+	t.Run("both address and code hash", func(t *testing.T) {
+		ad, err := NewAbiDecoder(ArgsAbiDecoder{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			Contracts:       []ContractAbi{{Address: "pair", CodeHash: "aa", AbiFile: pairAbiFile}},
+		})
+		require.Nil(t, ad)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidContractAbi))
+	})
+	t.Run("missing file", func(t *testing.T) {
+		ad, err := NewAbiDecoder(ArgsAbiDecoder{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			Contracts:       []ContractAbi{{Address: "pair", AbiFile: "testdata/missing.abi.json"}},
+		})
+		require.Nil(t, ad)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidContractAbi))
+	})
+	t.Run("should work", func(t *testing.T) {
+		ad, err := NewAbiDecoder(ArgsAbiDecoder{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			Contracts:       []ContractAbi{{Address: "pair", AbiFile: pairAbiFile}},
+		})
+		require.Nil(t, err)
+		require.False(t, ad.IsInterfaceNil())
+	})
+}
+
+func TestAbiDecoder_DecodeTransactions(t *testing.T) {
+	t.Parallel()
+
+	ad, _ := NewAbiDecoder(ArgsAbiDecoder{
+		PubKeyConverter: &mock.PubkeyConverterMock{},
+		Contracts:       []ContractAbi{{Address: "pair", AbiFile: pairAbiFile}},
+	})
+
+	tokenHex := hex.EncodeToString([]byte("WREWA-abcd"))
+	transferTx := &data.Transaction{
+		Receiver: "pair",
+		Function: "swapTokensFixedInput",
+		Data:     []byte("DCDTTransfer@" + tokenHex + "@0a@" + hex.EncodeToString([]byte("swapTokensFixedInput")) + "@" + tokenHex + "@03e8"),
+	}
+	variadicTx := &data.Transaction{
+		Receiver: "pair",
+		Function: "setFees",
+		Data:     []byte("setFees@01@1e@aa@bb"),
+	}
+	unknownEndpointTx := &data.Transaction{
+		Receiver: "pair",
+		Function: "pause",
+		Data:     []byte("pause"),
+	}
+	otherContractTx := &data.Transaction{
+		Receiver: "other",
+		Function: "setFees",
+		Data:     []byte("setFees@01"),
+	}
+	scr := &data.ScResult{
+		Receiver: "pair",
+		Function: "setFees",
+		Data:     []byte("setFees@zz"),
+	}
+
+	ad.DecodeData(&data.PreparedResults{
+		Transactions: []*data.Transaction{transferTx, variadicTx, unknownEndpointTx, otherContractTx},
+		ScResults:    []*data.ScResult{scr},
+	}, nil, nil)
+
+	require.Equal(t, &data.DecodedCall{
+		Function: "swapTokensFixedInput",
+		Args: map[string]interface{}{
+			"token_out":      "WREWA-abcd",
+			"amount_out_min": "1000",
+		},
+	}, transferTx.DecodedData)
+	require.Equal(t, &data.DecodedCall{
+		Function: "setFees",
+		Args: map[string]interface{}{
+			"enabled":       true,
+			"fee_percent":   "30",
+			"fee_receivers": []interface{}{"aa", "bb"},
+		},
+	}, variadicTx.DecodedData)
+	require.Nil(t, unknownEndpointTx.DecodedData)
+	require.Nil(t, otherContractTx.DecodedData)
+	require.Nil(t, scr.DecodedData)
+}
+
+func TestAbiDecoder_DecodeEventsByCodeHash(t *testing.T) {
+	t.Parallel()
+
+	codeHash := []byte("pair-code-hash")
+	ad, _ := NewAbiDecoder(ArgsAbiDecoder{
+		PubKeyConverter: &mock.PubkeyConverterMock{},
+		Contracts:       []ContractAbi{{CodeHash: hex.EncodeToString(codeHash), AbiFile: pairAbiFile}},
+	})
+
+	callerHex := hex.EncodeToString(make([]byte, addressLength))
+	eventData := append([]byte{0, 0, 0, 2, 0x03, 0xe8}, []byte{0, 0, 0, 0, 0, 0, 0, 5}...)
+	swapEvent := &data.LogEvent{
+		Address: "pair",
+		Topics:  []string{hex.EncodeToString([]byte("swap")), callerHex, hex.EncodeToString([]byte("WREWA-abcd"))},
+		Data:    hex.EncodeToString(eventData),
+	}
+	feesEvent := &data.LogEvent{
+		Address: "new-pair",
+		Topics:  []string{hex.EncodeToString([]byte("fees_updated"))},
+		Data:    hex.EncodeToString(big.NewInt(30).Bytes()),
+	}
+	unknownEvent := &data.LogEvent{
+		Address: "pair",
+		Topics:  []string{hex.EncodeToString([]byte("unknown"))},
+	}
+
+	ad.DecodeData(nil, &data.PreparedLogsResults{
+		DBEvents:  []*data.LogEvent{swapEvent, feesEvent, unknownEvent},
+		ScDeploys: map[string]*data.ScDeployInfo{"new-pair": {CodeHash: codeHash}},
+	}, map[string]*alteredAccount.AlteredAccount{
+		"pair": {AdditionalData: &alteredAccount.AdditionalAccountData{CodeHash: codeHash}},
+	})
+
+	require.Equal(t, &data.DecodedEvent{
+		Identifier: "swap",
+		Fields: map[string]interface{}{
+			"caller":    callerHex,
+			"token_in":  "WREWA-abcd",
+			"amount_in": "1000",
+			"epoch":     "5",
+		},
+	}, swapEvent.DecodedData)
+	require.Equal(t, &data.DecodedEvent{
+		Identifier: "fees_updated",
+		Fields:     map[string]interface{}{"fee_percent": "30"},
+	}, feesEvent.DecodedData)
+	require.Nil(t, unknownEvent.DecodedData)
+}
+
+func TestDisabledAbiDecoder(t *testing.T) {
+	t.Parallel()
+
+	dad := NewDisabledAbiDecoder()
+	require.False(t, dad.IsInterfaceNil())
+
+	tx := &data.Transaction{Function: "setFees", Data: []byte("setFees@01")}
+	dad.DecodeData(&data.PreparedResults{Transactions: []*data.Transaction{tx}}, nil, nil)
+	require.Nil(t, tx.DecodedData)
+}
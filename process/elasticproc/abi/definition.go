@@ -0,0 +1,69 @@
+package abi
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// definition holds the parts of a smart contract ABI JSON file that are needed for decoding
+type definition struct {
+	Name      string         `json:"name"`
+	Endpoints []*endpointDef `json:"endpoints"`
+	Events    []*eventDef    `json:"events"`
+}
+
+type endpointDef struct {
+	Name   string      `json:"name"`
+	Inputs []*paramDef `json:"inputs"`
+}
+
+type eventDef struct {
+	Identifier string      `json:"identifier"`
+	Inputs     []*paramDef `json:"inputs"`
+}
+
+type paramDef struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed,omitempty"`
+}
+
+// contractAbi holds the endpoints and the events of a contract indexed by name
+type contractAbi struct {
+	endpoints map[string]*endpointDef
+	events    map[string]*eventDef
+}
+
+func loadContractAbi(path string) (*contractAbi, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	def := &definition{}
+	err = json.Unmarshal(content, def)
+	if err != nil {
+		return nil, err
+	}
+
+	return newContractAbi(def), nil
+}
+
+func newContractAbi(def *definition) *contractAbi {
+	ca := &contractAbi{
+		endpoints: make(map[string]*endpointDef, len(def.Endpoints)),
+		events:    make(map[string]*eventDef, len(def.Events)),
+	}
+	for _, endpoint := range def.Endpoints {
+		if endpoint != nil {
+			ca.endpoints[endpoint.Name] = endpoint
+		}
+	}
+	for _, event := range def.Events {
+		if event != nil {
+			ca.events[event.Identifier] = event
+		}
+	}
+
+	return ca
+}
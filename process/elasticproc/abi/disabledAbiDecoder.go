@@ -0,0 +1,23 @@
+package abi
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type disabledAbiDecoder struct{}
+
+// NewDisabledAbiDecoder will create a new instance of disabledAbiDecoder
+func NewDisabledAbiDecoder() *disabledAbiDecoder {
+	return &disabledAbiDecoder{}
+}
+
+// DecodeData does nothing
+func (dad *disabledAbiDecoder) DecodeData(_ *data.PreparedResults, _ *data.PreparedLogsResults, _ map[string]*alteredAccount.AlteredAccount) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dad *disabledAbiDecoder) IsInterfaceNil() bool {
+	return dad == nil
+}
@@ -0,0 +1,200 @@
+package abi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+)
+
+const (
+	// maxListItems bounds the number of decoded items of a list or of a variadic argument
+	maxListItems  = 32
+	addressLength = 32
+	hashLength    = 32
+	lengthPrefix  = 4
+
+	optionType   = "Option"
+	listType     = "List"
+	vecType      = "vec"
+	optionalType = "optional"
+	variadicType = "variadic"
+	multiType    = "multi"
+)
+
+var errNotEnoughBytes = errors.New("not enough bytes for the nested value")
+var errUnsupportedNestedType = errors.New("unsupported nested type")
+
+type typesDecoder struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+// fixedSizeIntegers maps the fixed size integer types to their nested encoding size
+var fixedSizeIntegers = map[string]int{
+	"u8": 1, "u16": 2, "u32": 4, "u64": 8, "usize": 4,
+	"i8": 1, "i16": 2, "i32": 4, "i64": 8, "isize": 4,
+}
+
+// decodeTopLevel decodes a value that takes all the provided bytes, such as an argument or a topic
+func (td *typesDecoder) decodeTopLevel(abiType string, value []byte) interface{} {
+	outerType, innerType := splitGenericType(abiType)
+	switch outerType {
+	case optionalType:
+		return td.decodeTopLevel(innerType, value)
+	case optionType:
+		if len(value) == 0 {
+			return nil
+		}
+		decoded, _, err := td.decodeNested(innerType, value[1:])
+		if err != nil {
+			return hex.EncodeToString(value)
+		}
+		return decoded
+	case listType, vecType:
+		return td.decodeNestedList(innerType, value)
+	}
+
+	switch {
+	case isUnsignedType(abiType):
+		return big.NewInt(0).SetBytes(value).String()
+	case isSignedType(abiType):
+		return decodeSigned(value).String()
+	}
+
+	switch abiType {
+	case "bool":
+		return big.NewInt(0).SetBytes(value).Sign() != 0
+	case "Address":
+		if len(value) != addressLength {
+			return hex.EncodeToString(value)
+		}
+		return td.pubKeyConverter.SilentEncode(value, log)
+	case "TokenIdentifier", "RewaOrDcdtTokenIdentifier", "EgldOrEsdtTokenIdentifier", "utf-8 string", "String", "&str":
+		return string(value)
+	default:
+		return hex.EncodeToString(value)
+	}
+}
+
+// decodeNested decodes a value that is part of a bigger structure and returns the number of consumed bytes
+func (td *typesDecoder) decodeNested(abiType string, value []byte) (interface{}, int, error) {
+	outerType, innerType := splitGenericType(abiType)
+	switch outerType {
+	case optionType:
+		if len(value) < 1 {
+			return nil, 0, errNotEnoughBytes
+		}
+		if value[0] == 0 {
+			return nil, 1, nil
+		}
+		decoded, consumed, err := td.decodeNested(innerType, value[1:])
+		return decoded, consumed + 1, err
+	case listType, vecType:
+		length, err := readLength(value)
+		if err != nil {
+			return nil, 0, err
+		}
+		items := make([]interface{}, 0)
+		offset := lengthPrefix
+		for i := 0; i < length; i++ {
+			item, consumed, errItem := td.decodeNested(innerType, value[offset:])
+			if errItem != nil {
+				return nil, 0, errItem
+			}
+			offset += consumed
+			if i < maxListItems {
+				items = append(items, item)
+			}
+		}
+		return items, offset, nil
+	}
+
+	size, isFixedSizeInteger := fixedSizeIntegers[abiType]
+	if isFixedSizeInteger {
+		if len(value) < size {
+			return nil, 0, errNotEnoughBytes
+		}
+		return td.decodeTopLevel(abiType, value[:size]), size, nil
+	}
+
+	switch abiType {
+	case "bool":
+		if len(value) < 1 {
+			return nil, 0, errNotEnoughBytes
+		}
+		return value[0] != 0, 1, nil
+	case "Address", "H256":
+		if len(value) < hashLength {
+			return nil, 0, errNotEnoughBytes
+		}
+		return td.decodeTopLevel(abiType, value[:hashLength]), hashLength, nil
+	case "BigUint", "BigInt", "TokenIdentifier", "RewaOrDcdtTokenIdentifier", "EgldOrEsdtTokenIdentifier",
+		"utf-8 string", "String", "&str", "bytes", "ManagedBuffer":
+		length, err := readLength(value)
+		if err != nil {
+			return nil, 0, err
+		}
+		end := lengthPrefix + length
+		return td.decodeTopLevel(abiType, value[lengthPrefix:end]), end, nil
+	default:
+		return nil, 0, errUnsupportedNestedType
+	}
+}
+
+// decodeNestedList decodes the items of a list that takes all the provided bytes
+func (td *typesDecoder) decodeNestedList(itemType string, value []byte) interface{} {
+	items := make([]interface{}, 0)
+	for offset := 0; offset < len(value) && len(items) < maxListItems; {
+		item, consumed, err := td.decodeNested(itemType, value[offset:])
+		if err != nil {
+			return hex.EncodeToString(value)
+		}
+		items = append(items, item)
+		offset += consumed
+	}
+
+	return items
+}
+
+func readLength(value []byte) (int, error) {
+	if len(value) < lengthPrefix {
+		return 0, errNotEnoughBytes
+	}
+
+	length := int(binary.BigEndian.Uint32(value[:lengthPrefix]))
+	if length > len(value)-lengthPrefix {
+		return 0, errNotEnoughBytes
+	}
+
+	return length, nil
+}
+
+func decodeSigned(value []byte) *big.Int {
+	result := big.NewInt(0).SetBytes(value)
+	if len(value) > 0 && value[0]&0x80 != 0 {
+		result.Sub(result, big.NewInt(0).Lsh(big.NewInt(1), uint(len(value)*8)))
+	}
+
+	return result
+}
+
+func isUnsignedType(abiType string) bool {
+	return abiType == "BigUint" || strings.HasPrefix(abiType, "u") && fixedSizeIntegers[abiType] > 0
+}
+
+func isSignedType(abiType string) bool {
+	return abiType == "BigInt" || strings.HasPrefix(abiType, "i") && fixedSizeIntegers[abiType] > 0
+}
+
+// splitGenericType returns the outer and the inner type of a generic type like Option<BigUint>
+func splitGenericType(abiType string) (string, string) {
+	start := strings.Index(abiType, "<")
+	if start < 0 || !strings.HasSuffix(abiType, ">") {
+		return abiType, ""
+	}
+
+	return abiType[:start], abiType[start+1 : len(abiType)-1]
+}
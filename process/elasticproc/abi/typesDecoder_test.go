@@ -0,0 +1,71 @@
+package abi
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+)
+
+func TestTypesDecoder_DecodeTopLevel(t *testing.T) {
+	t.Parallel()
+
+	td := &typesDecoder{pubKeyConverter: &mock.PubkeyConverterMock{}}
+	address := make([]byte, addressLength)
+	address[31] = 1
+
+	require.Equal(t, "1000", td.decodeTopLevel("BigUint", big.NewInt(1000).Bytes()))
+	require.Equal(t, "0", td.decodeTopLevel("u64", nil))
+	require.Equal(t, "-1", td.decodeTopLevel("i8", []byte{0xff}))
+	require.Equal(t, "-129", td.decodeTopLevel("BigInt", []byte{0xff, 0x7f}))
+	require.Equal(t, true, td.decodeTopLevel("bool", []byte{1}))
+	require.Equal(t, false, td.decodeTopLevel("bool", nil))
+	require.Equal(t, hex.EncodeToString(address), td.decodeTopLevel("Address", address))
+	require.Equal(t, "0102", td.decodeTopLevel("Address", []byte{1, 2}))
+	require.Equal(t, "WREWA-abcd", td.decodeTopLevel("TokenIdentifier", []byte("WREWA-abcd")))
+	require.Equal(t, "0a0b", td.decodeTopLevel("bytes", []byte{10, 11}))
+	require.Equal(t, "0a0b", td.decodeTopLevel("MyStruct", []byte{10, 11}))
+	require.Equal(t, "7", td.decodeTopLevel("optional<u32>", []byte{7}))
+	require.Nil(t, td.decodeTopLevel("Option<BigUint>", nil))
+	require.Equal(t, "5", td.decodeTopLevel("Option<BigUint>", []byte{1, 0, 0, 0, 1, 5}))
+	require.Equal(t, []interface{}{"1", "2"}, td.decodeTopLevel("List<u16>", []byte{0, 1, 0, 2}))
+	require.Equal(t, "000102", td.decodeTopLevel("List<u16>", []byte{0, 1, 2}))
+}
+
+func TestTypesDecoder_DecodeNested(t *testing.T) {
+	t.Parallel()
+
+	td := &typesDecoder{pubKeyConverter: &mock.PubkeyConverterMock{}}
+
+	value, consumed, err := td.decodeNested("BigUint", []byte{0, 0, 0, 2, 1, 0, 9})
+	require.Nil(t, err)
+	require.Equal(t, "256", value)
+	require.Equal(t, 6, consumed)
+
+	value, consumed, err = td.decodeNested("u32", []byte{0, 0, 1, 0, 9})
+	require.Nil(t, err)
+	require.Equal(t, "256", value)
+	require.Equal(t, 4, consumed)
+
+	value, consumed, err = td.decodeNested("Option<bool>", []byte{0})
+	require.Nil(t, err)
+	require.Nil(t, value)
+	require.Equal(t, 1, consumed)
+
+	value, consumed, err = td.decodeNested("List<TokenIdentifier>", []byte{0, 0, 0, 2, 0, 0, 0, 1, 'A', 0, 0, 0, 1, 'B'})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"A", "B"}, value)
+	require.Equal(t, 14, consumed)
+
+	_, _, err = td.decodeNested("u64", []byte{1, 2})
+	require.Equal(t, errNotEnoughBytes, err)
+
+	_, _, err = td.decodeNested("bytes", []byte{0, 0, 0, 9, 1})
+	require.Equal(t, errNotEnoughBytes, err)
+
+	_, _, err = td.decodeNested("MyStruct", []byte{1})
+	require.Equal(t, errUnsupportedNestedType, err)
+}
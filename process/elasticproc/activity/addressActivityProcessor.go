@@ -0,0 +1,173 @@
+package activity
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+const (
+	// maxTokensPerAddress bounds the list with the tokens ever held by an address
+	maxTokensPerAddress = 1000
+	// maxContractsPerAddress bounds the list with the contracts an address has interacted with
+	maxContractsPerAddress = 100
+
+	rewardOperation = "reward"
+)
+
+type addressActivityProcessor struct {
+}
+
+// NewAddressActivityProcessor will create a new instance of addressActivityProcessor
+func NewAddressActivityProcessor() (*addressActivityProcessor, error) {
+	return &addressActivityProcessor{}, nil
+}
+
+type activityBuilder struct {
+	activity  *data.AddressActivity
+	fees      *big.Int
+	tokens    map[string]struct{}
+	contracts map[string]struct{}
+}
+
+// PrepareAddressesActivity will compute the activity of the block for the addresses of the current shard. Every address
+// is updated only by the shard it belongs to, so the cross-shard transactions are counted once for each side.
+func (aap *addressActivityProcessor) PrepareAddressesActivity(
+	txs []*data.Transaction,
+	scrs []*data.ScResult,
+	coreAlteredAccounts map[string]*alteredAccount.AlteredAccount,
+	timestamp uint64,
+	selfShardID uint32,
+) map[string]*data.AddressActivity {
+	builders := make(map[string]*activityBuilder)
+	getBuilder := func(address string) *activityBuilder {
+		builder, found := builders[address]
+		if found {
+			return builder
+		}
+
+		builder = &activityBuilder{
+			activity: &data.AddressActivity{
+				Address:   address,
+				ShardID:   selfShardID,
+				FirstSeen: time.Duration(timestamp),
+				LastSeen:  time.Duration(timestamp),
+				Timestamp: time.Duration(timestamp),
+			},
+			fees:      big.NewInt(0),
+			tokens:    make(map[string]struct{}),
+			contracts: make(map[string]struct{}),
+		}
+		builders[address] = builder
+
+		return builder
+	}
+
+	for _, tx := range txs {
+		isSenderInShard := tx.SenderShard == selfShardID && tx.Operation != rewardOperation && tx.Sender != ""
+		if isSenderInShard {
+			sender := getBuilder(tx.Sender)
+			sender.activity.SentTxs++
+			sender.activity.FeesNum += tx.FeeNum
+			fee, ok := big.NewInt(0).SetString(tx.Fee, 10)
+			if ok {
+				sender.fees.Add(sender.fees, fee)
+			}
+			if tx.IsScCall {
+				addBounded(sender.contracts, getContract(tx.Receiver, tx.Receivers), maxContractsPerAddress)
+			}
+		}
+
+		for _, receiver := range getReceiversInShard(tx.Receiver, tx.ReceiverShard, tx.Receivers, tx.ReceiversShardIDs, selfShardID) {
+			getBuilder(receiver).activity.ReceivedTxs++
+		}
+	}
+
+	for _, scr := range scrs {
+		if scr.SenderShard == selfShardID && scr.Sender != "" {
+			getBuilder(scr.Sender).activity.ScResults++
+		}
+		isReceiverInShard := scr.ReceiverShard == selfShardID && scr.Receiver != "" && scr.Receiver != scr.Sender
+		if isReceiverInShard {
+			getBuilder(scr.Receiver).activity.ScResults++
+		}
+	}
+
+	for _, account := range coreAlteredAccounts {
+		if account == nil || len(account.Tokens) == 0 {
+			continue
+		}
+
+		builder := getBuilder(account.Address)
+		for _, token := range account.Tokens {
+			if token != nil && token.Identifier != "" {
+				addBounded(builder.tokens, token.Identifier, maxTokensPerAddress)
+			}
+		}
+	}
+
+	activities := make(map[string]*data.AddressActivity, len(builders))
+	for address, builder := range builders {
+		builder.activity.Fees = builder.fees.String()
+		builder.activity.Tokens = mapKeys(builder.tokens)
+		builder.activity.Contracts = mapKeys(builder.contracts)
+		activities[address] = builder.activity
+	}
+
+	return activities
+}
+
+func getReceiversInShard(receiver string, receiverShard uint32, receivers []string, receiversShardIDs []uint32, selfShardID uint32) []string {
+	if len(receivers) == 0 {
+		if receiverShard != selfShardID || receiver == "" {
+			return nil
+		}
+		return []string{receiver}
+	}
+
+	unique := make(map[string]struct{})
+	result := make([]string, 0, len(receivers))
+	for idx, rcv := range receivers {
+		if idx >= len(receiversShardIDs) || receiversShardIDs[idx] != selfShardID {
+			continue
+		}
+		if _, found := unique[rcv]; found {
+			continue
+		}
+		unique[rcv] = struct{}{}
+		result = append(result, rcv)
+	}
+
+	return result
+}
+
+// getContract returns the real receiver for the NFT and multi transfers, where the receiver is the sender itself
+func getContract(receiver string, receivers []string) string {
+	if len(receivers) > 0 {
+		return receivers[0]
+	}
+
+	return receiver
+}
+
+func addBounded(set map[string]struct{}, value string, maxSize int) {
+	if len(set) >= maxSize {
+		return
+	}
+
+	set[value] = struct{}{}
+}
+
+func mapKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
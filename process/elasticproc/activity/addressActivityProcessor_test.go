@@ -0,0 +1,81 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestNewAddressActivityProcessor(t *testing.T) {
+	t.Parallel()
+
+	aap, err := NewAddressActivityProcessor()
+	require.Nil(t, err)
+	require.NotNil(t, aap)
+}
+
+func TestAddressActivityProcessor_PrepareAddressesActivity(t *testing.T) {
+	t.Parallel()
+
+	aap, _ := NewAddressActivityProcessor()
+
+	txs := []*data.Transaction{
+		{Sender: "alice", Receiver: "contract", IsScCall: true, Fee: "100", FeeNum: 1},
+		{Sender: "alice", Receiver: "bob", ReceiverShard: 1, Fee: "50", FeeNum: 0.5},
+		{Sender: "carol", Receiver: "alice", SenderShard: 1},
+		{Sender: "alice", Receiver: "alice", Receivers: []string{"dave", "dave", "erin"}, ReceiversShardIDs: []uint32{0, 0, 1}, Fee: "10"},
+		{Sender: "metachain", Receiver: "alice", Operation: rewardOperation, SenderShard: 4294967295},
+	}
+	scrs := []*data.ScResult{
+		{Sender: "contract", Receiver: "alice"},
+		{Sender: "contract", Receiver: "frank", ReceiverShard: 1},
+	}
+	alteredAccounts := map[string]*alteredAccount.AlteredAccount{
+		"alice": {
+			Address: "alice",
+			Tokens: []*alteredAccount.AccountTokenData{
+				{Identifier: "TKN-abcd"},
+				{Identifier: "NFT-abcd", Nonce: 1},
+			},
+		},
+	}
+
+	activities := aap.PrepareAddressesActivity(txs, scrs, alteredAccounts, 5040, 0)
+	require.Len(t, activities, 3)
+
+	require.Equal(t, &data.AddressActivity{
+		Address:     "alice",
+		FirstSeen:   time.Duration(5040),
+		LastSeen:    time.Duration(5040),
+		SentTxs:     3,
+		ReceivedTxs: 2,
+		ScResults:   1,
+		Fees:        "160",
+		FeesNum:     1.5,
+		Tokens:      []string{"NFT-abcd", "TKN-abcd"},
+		Contracts:   []string{"contract"},
+		Timestamp:   time.Duration(5040),
+	}, activities["alice"])
+
+	require.Equal(t, uint64(1), activities["contract"].ReceivedTxs)
+	require.Equal(t, uint64(2), activities["contract"].ScResults)
+	require.Equal(t, "0", activities["contract"].Fees)
+	require.Equal(t, uint64(1), activities["dave"].ReceivedTxs)
+	require.Nil(t, activities["bob"])
+	require.Nil(t, activities["frank"])
+	require.Nil(t, activities["metachain"])
+}
+
+func TestAddBounded(t *testing.T) {
+	t.Parallel()
+
+	set := make(map[string]struct{})
+	addBounded(set, "a", 2)
+	addBounded(set, "b", 2)
+	addBounded(set, "c", 2)
+	require.Equal(t, []string{"a", "b"}, mapKeys(set))
+}
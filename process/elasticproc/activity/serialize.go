@@ -0,0 +1,109 @@
+package activity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeAddressesActivity will serialize the activity of the block. The counters are added to the indexed ones and the
+// applied changes are kept in the "previous" field, so the last block can be reverted.
+func (aap *addressActivityProcessor) SerializeAddressesActivity(activities map[string]*data.AddressActivity, buffSlice *data.BufferSlice, index string) error {
+	for address, activity := range activities {
+		meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(address), "\n"))
+		marshaledActivity, err := json.Marshal(activity)
+		if err != nil {
+			return err
+		}
+
+		codeToExecute := `
+			if (ctx._source.timestamp >= params.delta.timestamp) {
+				ctx.op = 'noop';
+				return;
+			}
+			List newTokens = new ArrayList();
+			for (token in params.delta.tokens) {
+				if (ctx._source.tokens.size() >= params.maxTokens) {
+					break;
+				}
+				if (!ctx._source.tokens.contains(token)) {
+					ctx._source.tokens.add(token);
+					newTokens.add(token);
+				}
+			}
+			List newContracts = new ArrayList();
+			for (contract in params.delta.contracts) {
+				if (ctx._source.contracts.size() >= params.maxContracts) {
+					break;
+				}
+				if (!ctx._source.contracts.contains(contract)) {
+					ctx._source.contracts.add(contract);
+					newContracts.add(contract);
+				}
+			}
+			Map previous = new HashMap();
+			previous.put('timestamp', ctx._source.timestamp);
+			previous.put('lastSeen', ctx._source.lastSeen);
+			previous.put('sentTxs', params.delta.sentTxs);
+			previous.put('receivedTxs', params.delta.receivedTxs);
+			previous.put('scResults', params.delta.scResults);
+			previous.put('fees', params.delta.fees);
+			previous.put('feesNum', params.delta.feesNum);
+			previous.put('tokens', newTokens);
+			previous.put('contracts', newContracts);
+			ctx._source.previous = previous;
+			ctx._source.sentTxs += params.delta.sentTxs;
+			ctx._source.receivedTxs += params.delta.receivedTxs;
+			ctx._source.scResults += params.delta.scResults;
+			ctx._source.fees = new BigInteger(ctx._source.fees).add(new BigInteger(params.delta.fees)).toString();
+			ctx._source.feesNum += params.delta.feesNum;
+			ctx._source.lastSeen = params.delta.lastSeen;
+			ctx._source.timestamp = params.delta.timestamp;
+`
+		serializedData := []byte(fmt.Sprintf(`{"script": {"source": "%s","lang": "painless","params": {"delta": %s, "maxTokens": %d, "maxContracts": %d}},"upsert": %s}`,
+			converters.FormatPainlessSource(codeToExecute), string(marshaledActivity), maxTokensPerAddress, maxContractsPerAddress, string(marshaledActivity)),
+		)
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareAddressesActivityQueryInCaseOfRevert will prepare the query that reverts the changes done by the block with the
+// provided timestamp. The addresses first seen in that block are removed.
+func (aap *addressActivityProcessor) PrepareAddressesActivityQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := `
+		if (ctx._source.firstSeen >= params.timestamp) {
+			ctx.op = 'delete';
+			return;
+		}
+		if (!ctx._source.containsKey('previous') || ctx._source.previous == null) {
+			ctx.op = 'noop';
+			return;
+		}
+		def previous = ctx._source.previous;
+		ctx._source.sentTxs -= previous.sentTxs;
+		ctx._source.receivedTxs -= previous.receivedTxs;
+		ctx._source.scResults -= previous.scResults;
+		ctx._source.fees = new BigInteger(ctx._source.fees).subtract(new BigInteger(previous.fees)).toString();
+		ctx._source.feesNum -= previous.feesNum;
+		ctx._source.tokens.removeAll(previous.tokens);
+		ctx._source.contracts.removeAll(previous.contracts);
+		ctx._source.lastSeen = previous.lastSeen;
+		ctx._source.timestamp = previous.timestamp;
+		ctx._source.previous = null;
+`
+
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"shardID": {"query": %d,"operator": "AND"}}},{"match": {"timestamp": {"query": "%d","operator": "AND"}}}]}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"timestamp": %d}}}`,
+		shardID, timestamp, converters.FormatPainlessSource(codeToExecute), timestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
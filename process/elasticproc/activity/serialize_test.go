@@ -0,0 +1,50 @@
+package activity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestAddressActivityProcessor_SerializeAddressesActivity(t *testing.T) {
+	t.Parallel()
+
+	aap, _ := NewAddressActivityProcessor()
+
+	activities := map[string]*data.AddressActivity{
+		"alice": {
+			Address:   "alice",
+			FirstSeen: 5040,
+			LastSeen:  5040,
+			SentTxs:   1,
+			Fees:      "100",
+			Tokens:    []string{},
+			Contracts: []string{"contract"},
+			Timestamp: 5040,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := aap.SerializeAddressesActivity(activities, buffSlice, "addressactivity")
+	require.Nil(t, err)
+
+	marshaledActivity := `{"address":"alice","shardID":0,"firstSeen":5040,"lastSeen":5040,"sentTxs":1,"receivedTxs":0,"scResults":0,"fees":"100","feesNum":0,"tokens":[],"contracts":["contract"],"timestamp":5040}`
+	lines := strings.Split(buffSlice.Buffers()[0].String(), "\n")
+	require.Equal(t, `{ "update" : {"_index":"addressactivity", "_id" : "alice" } }`, lines[0])
+	require.True(t, strings.HasPrefix(lines[1], `{"script": {"source": "if (ctx._source.timestamp >= params.delta.timestamp) {`))
+	require.True(t, strings.HasSuffix(lines[1], `"params": {"delta": `+marshaledActivity+`, "maxTokens": 1000, "maxContracts": 100}},"upsert": `+marshaledActivity+`}`))
+}
+
+func TestAddressActivityProcessor_PrepareAddressesActivityQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	aap, _ := NewAddressActivityProcessor()
+
+	query := aap.PrepareAddressesActivityQueryInCaseOfRevert(5040, 1).String()
+	require.True(t, strings.HasPrefix(query, `{"query": {"bool": {"must": [{"match": {"shardID": {"query": 1,"operator": "AND"}}},{"match": {"timestamp": {"query": "5040","operator": "AND"}}}]}},`))
+	require.True(t, strings.HasSuffix(query, `"params": {"timestamp": 5040}}}`))
+	require.Contains(t, query, `ctx.op = 'delete';`)
+}
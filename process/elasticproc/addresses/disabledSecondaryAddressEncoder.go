@@ -0,0 +1,25 @@
+package addresses
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type disabledSecondaryAddressEncoder struct{}
+
+// NewDisabledSecondaryAddressEncoder will create a new instance of disabledSecondaryAddressEncoder
+func NewDisabledSecondaryAddressEncoder() *disabledSecondaryAddressEncoder {
+	return &disabledSecondaryAddressEncoder{}
+}
+
+// EncodeTransactionsData does nothing
+func (dsae *disabledSecondaryAddressEncoder) EncodeTransactionsData(_ *data.PreparedResults, _ *data.PreparedLogsResults) {
+}
+
+// EncodeAccounts does nothing
+func (dsae *disabledSecondaryAddressEncoder) EncodeAccounts(_ map[string]*data.AccountInfo) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsae *disabledSecondaryAddressEncoder) IsInterfaceNil() bool {
+	return dsae == nil
+}
@@ -0,0 +1,86 @@
+package addresses
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+var log = logger.GetOrCreate("indexer/process/addresses")
+
+// ArgsSecondaryAddressEncoder holds all the components needed to create a new secondary address encoder
+type ArgsSecondaryAddressEncoder struct {
+	AddressPubkeyConverter   core.PubkeyConverter
+	SecondaryPubkeyConverter core.PubkeyConverter
+}
+
+type secondaryAddressEncoder struct {
+	addressPubkeyConverter   core.PubkeyConverter
+	secondaryPubkeyConverter core.PubkeyConverter
+}
+
+// NewSecondaryAddressEncoder will create a new instance of secondaryAddressEncoder
+func NewSecondaryAddressEncoder(args ArgsSecondaryAddressEncoder) (*secondaryAddressEncoder, error) {
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.SecondaryPubkeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+
+	return &secondaryAddressEncoder{
+		addressPubkeyConverter:   args.AddressPubkeyConverter,
+		secondaryPubkeyConverter: args.SecondaryPubkeyConverter,
+	}, nil
+}
+
+// EncodeTransactionsData will set the secondary encoding of the senders and receivers of the transactions and smart
+// contract results and of the addresses of the events
+func (sae *secondaryAddressEncoder) EncodeTransactionsData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults) {
+	if preparedResults != nil {
+		for _, tx := range preparedResults.Transactions {
+			tx.SenderSecondary = sae.encode(tx.Sender)
+			tx.ReceiverSecondary = sae.encode(tx.Receiver)
+		}
+		for _, scr := range preparedResults.ScResults {
+			scr.SenderSecondary = sae.encode(scr.Sender)
+			scr.ReceiverSecondary = sae.encode(scr.Receiver)
+		}
+	}
+
+	if logsData != nil {
+		for _, event := range logsData.DBEvents {
+			event.AddressSecondary = sae.encode(event.Address)
+		}
+	}
+}
+
+// EncodeAccounts will set the secondary encoding of the addresses of the provided accounts
+func (sae *secondaryAddressEncoder) EncodeAccounts(accounts map[string]*data.AccountInfo) {
+	for _, account := range accounts {
+		account.AddressSecondary = sae.encode(account.Address)
+	}
+}
+
+// the addresses that cannot be decoded, like the metachain address of the rewards, have no secondary encoding
+func (sae *secondaryAddressEncoder) encode(address string) string {
+	if address == "" {
+		return ""
+	}
+
+	pubKey, err := sae.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		log.Trace("secondaryAddressEncoder.encode: cannot decode address", "address", address, "error", err)
+		return ""
+	}
+
+	return sae.secondaryPubkeyConverter.SilentEncode(pubKey, log)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sae *secondaryAddressEncoder) IsInterfaceNil() bool {
+	return sae == nil
+}
@@ -0,0 +1,94 @@
+package addresses
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/pubkeyConverter"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func createMockArgsSecondaryAddressEncoder() ArgsSecondaryAddressEncoder {
+	addressPubkeyConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "drt")
+	hexPubkeyConverter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+
+	return ArgsSecondaryAddressEncoder{
+		AddressPubkeyConverter:   addressPubkeyConverter,
+		SecondaryPubkeyConverter: hexPubkeyConverter,
+	}
+}
+
+func TestNewSecondaryAddressEncoder(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSecondaryAddressEncoder()
+	args.AddressPubkeyConverter = nil
+	sae, err := NewSecondaryAddressEncoder(args)
+	require.Nil(t, sae)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	args = createMockArgsSecondaryAddressEncoder()
+	args.SecondaryPubkeyConverter = nil
+	sae, err = NewSecondaryAddressEncoder(args)
+	require.Nil(t, sae)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	sae, err = NewSecondaryAddressEncoder(createMockArgsSecondaryAddressEncoder())
+	require.Nil(t, err)
+	require.False(t, sae.IsInterfaceNil())
+}
+
+func TestSecondaryAddressEncoder_EncodeTransactionsData(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSecondaryAddressEncoder()
+	pubKey := bytes.Repeat([]byte{1}, 32)
+	bech32Address, _ := args.AddressPubkeyConverter.Encode(pubKey)
+	hexAddress := hex.EncodeToString(pubKey)
+
+	sae, _ := NewSecondaryAddressEncoder(args)
+
+	tx := &data.Transaction{Sender: bech32Address, Receiver: "metachain"}
+	scr := &data.ScResult{Sender: bech32Address, Receiver: bech32Address}
+	event := &data.LogEvent{Address: bech32Address}
+	sae.EncodeTransactionsData(&data.PreparedResults{
+		Transactions: []*data.Transaction{tx},
+		ScResults:    []*data.ScResult{scr},
+	}, &data.PreparedLogsResults{
+		DBEvents: []*data.LogEvent{event},
+	})
+
+	require.Equal(t, hexAddress, tx.SenderSecondary)
+	require.Equal(t, "", tx.ReceiverSecondary)
+	require.Equal(t, hexAddress, scr.SenderSecondary)
+	require.Equal(t, hexAddress, scr.ReceiverSecondary)
+	require.Equal(t, hexAddress, event.AddressSecondary)
+
+	require.NotPanics(t, func() {
+		sae.EncodeTransactionsData(nil, nil)
+	})
+}
+
+func TestSecondaryAddressEncoder_EncodeAccounts(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSecondaryAddressEncoder()
+	pubKey := bytes.Repeat([]byte{1}, 32)
+	bech32Address, _ := args.AddressPubkeyConverter.Encode(pubKey)
+	hexAddress := hex.EncodeToString(pubKey)
+
+	sae, _ := NewSecondaryAddressEncoder(args)
+
+	accounts := map[string]*data.AccountInfo{
+		bech32Address: {Address: bech32Address},
+		"":            {},
+	}
+	sae.EncodeAccounts(accounts)
+
+	require.Equal(t, hexAddress, accounts[bech32Address].AddressSecondary)
+	require.Equal(t, "", accounts[""].AddressSecondary)
+}
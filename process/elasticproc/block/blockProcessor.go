@@ -230,6 +230,34 @@ func (bp *blockProcessor) addEpochStartShardDataForMeta(epochStartShardData node
 	block.EpochStartShardsData = append(block.EpochStartShardsData, shardData)
 }
 
+// PrepareBridgeOperations will prepare the outgoing bridge operations committed by a sovereign block
+func (bp *blockProcessor) PrepareBridgeOperations(elasticBlock *data.Block) []*data.BridgeOperation {
+	if elasticBlock == nil || elasticBlock.OutGoingMiniBlockHeader == nil {
+		return nil
+	}
+
+	outGoingMbHeader := elasticBlock.OutGoingMiniBlockHeader
+	bridgeOperations := make([]*data.BridgeOperation, 0, len(outGoingMbHeader.OperationsHashes))
+	for idx, operationHash := range outGoingMbHeader.OperationsHashes {
+		bridgeOperations = append(bridgeOperations, &data.BridgeOperation{
+			Hash:                   operationHash,
+			Index:                  idx,
+			OutGoingMiniBlockHash:  outGoingMbHeader.Hash,
+			OutGoingOperationsHash: outGoingMbHeader.OutGoingOperationsHash,
+			AggregatedSignature:    outGoingMbHeader.AggregatedSignatureOutGoingOperations,
+			LeaderSignature:        outGoingMbHeader.LeaderSignatureOutGoingOperations,
+			BlockHash:              elasticBlock.Hash,
+			BlockNonce:             elasticBlock.Nonce,
+			Round:                  elasticBlock.Round,
+			Epoch:                  elasticBlock.Epoch,
+			ShardID:                elasticBlock.ShardID,
+			Timestamp:              elasticBlock.Timestamp,
+		})
+	}
+
+	return bridgeOperations
+}
+
 func (bp *blockProcessor) getEncodedMBSHashes(body *block.Body, intraShardMbs []*nodeBlock.MiniBlock) []string {
 	miniblocksHashes := make([]string, 0)
 	mbs := append(body.MiniBlocks, intraShardMbs...)
@@ -528,3 +528,12 @@ func TestBlockProcessor_PrepareBlockForDBMiniBlocksDetails(t *testing.T) {
 		},
 	}, dbBlock)
 }
+
+func TestBlockProcessor_PrepareBridgeOperationsNotSovereign(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+
+	require.Nil(t, bp.PrepareBridgeOperations(nil))
+	require.Nil(t, bp.PrepareBridgeOperations(&data.Block{Nonce: 1}))
+}
@@ -27,6 +27,24 @@ func (bp *blockProcessor) SerializeBlock(elasticBlock *data.Block, buffSlice *da
 	return buffSlice.PutData(meta, serializedData)
 }
 
+// SerializeBridgeOperations will serialize the provided outgoing bridge operations for database
+func (bp *blockProcessor) SerializeBridgeOperations(bridgeOperations []*data.BridgeOperation, buffSlice *data.BufferSlice, index string) error {
+	for _, bridgeOperation := range bridgeOperations {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(bridgeOperation.Hash), "\n"))
+		serializedData, errMarshal := json.Marshal(bridgeOperation)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		err := buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SerializeEpochInfoData will serialize information about current epoch
 func (bp *blockProcessor) SerializeEpochInfoData(header coreData.HeaderHandler, buffSlice *data.BufferSlice, index string) error {
 	if check.IfNil(header) {
@@ -106,3 +106,29 @@ func TestBlockProcessor_SerializeBlockEpochStartMeta(t *testing.T) {
 {"uuid":"","nonce":1,"round":2,"epoch":3,"miniBlocksHashes":["mb1Hash","mbHash2"],"notarizedBlocksHashes":["notarized1"],"proposer":5,"validators":[0,1,2,3,4,5],"pubKeyBitmap":"00000110","size":345,"sizeTxs":0,"timestamp":123456,"stateRootHash":"stateHash","prevHash":"prevHash","shardId":4294967295,"txCount":100,"notarizedTxsCount":120,"accumulatedFees":"1000","developerFees":"50","epochStartBlock":true,"searchOrder":1010,"epochStartInfo":{"totalSupply":"100","totalToDistribute":"55","totalNewlyMinted":"20","rewardsPerBlock":"15","rewardsForProtocolSustainability":"2","nodePrice":"10","prevEpochStartRound":222,"prevEpochStartHash":"7072657645706f6368"},"gasProvided":0,"gasRefunded":0,"gasPenalized":0,"maxGasLimit":0}
 `, buffSlice.Buffers()[0].String())
 }
+
+func TestBlockProcessor_SerializeBridgeOperations(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+
+	bridgeOperations := []*data.BridgeOperation{
+		{
+			Hash:                   "op1",
+			OutGoingMiniBlockHash:  "mb",
+			OutGoingOperationsHash: "ops",
+			BlockHash:              "block",
+			BlockNonce:             10,
+			Round:                  11,
+			ShardID:                core.SovereignChainShardId,
+			Timestamp:              5000,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := bp.SerializeBridgeOperations(bridgeOperations, buffSlice, "bridgeoperations")
+	require.Nil(t, err)
+	require.Equal(t, `{ "index" : { "_index":"bridgeoperations", "_id" : "op1" } }
+{"index":0,"outGoingMiniBlockHash":"mb","outGoingOperationsHash":"ops","blockHash":"block","blockNonce":10,"round":11,"epoch":0,"shardID":0,"timestamp":5000}
+`, buffSlice.Buffers()[0].String())
+}
@@ -0,0 +1,113 @@
+package block
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	coreData "github.com/TerraDharitri/drt-go-chain-core/data"
+	"github.com/TerraDharitri/drt-go-chain-core/data/block"
+	"github.com/TerraDharitri/drt-go-chain-core/data/outport"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+type sovereignBlockProcessor struct {
+	*blockProcessor
+}
+
+// NewSovereignBlockProcessor will create a new instance of the block processor used by the sovereign chains
+func NewSovereignBlockProcessor(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (*sovereignBlockProcessor, error) {
+	blockProc, err := NewBlockProcessor(hasher, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sovereignBlockProcessor{
+		blockProcessor: blockProc,
+	}, nil
+}
+
+// PrepareBlockForDB will prepare a database block and add the data of the sovereign chain header
+func (sbp *sovereignBlockProcessor) PrepareBlockForDB(obh *outport.OutportBlockWithHeader) (*data.Block, error) {
+	elasticBlock, err := sbp.blockProcessor.PrepareBlockForDB(obh)
+	if err != nil {
+		return nil, err
+	}
+
+	sbp.addSovereignHeaderData(obh.Header, obh.BlockData.Body, elasticBlock)
+
+	return elasticBlock, nil
+}
+
+func (sbp *sovereignBlockProcessor) addSovereignHeaderData(header coreData.HeaderHandler, body *block.Body, elasticBlock *data.Block) {
+	sovereignHeader, ok := header.(*block.SovereignChainHeader)
+	if !ok {
+		return
+	}
+
+	elasticBlock.ValidatorStatsRootHash = hex.EncodeToString(sovereignHeader.ValidatorStatsRootHash)
+	elasticBlock.ExtendedShardHeaderHashes = hexEncodeSlice(sovereignHeader.ExtendedShardHeaderHashes)
+	elasticBlock.AccumulatedFeesInEpoch = converters.BigIntToString(sovereignHeader.AccumulatedFeesInEpoch)
+	elasticBlock.DeveloperFeesInEpoch = converters.BigIntToString(sovereignHeader.DevFeesInEpoch)
+
+	outGoingMbHeader := sovereignHeader.OutGoingMiniBlockHeader
+	if outGoingMbHeader != nil {
+		elasticBlock.OutGoingMiniBlockHeader = &data.OutGoingMiniBlockHeader{
+			Hash:                                  hex.EncodeToString(outGoingMbHeader.Hash),
+			OutGoingOperationsHash:                hex.EncodeToString(outGoingMbHeader.OutGoingOperationsHash),
+			AggregatedSignatureOutGoingOperations: hex.EncodeToString(outGoingMbHeader.AggregatedSignatureOutGoingOperations),
+			LeaderSignatureOutGoingOperations:     hex.EncodeToString(outGoingMbHeader.LeaderSignatureOutGoingOperations),
+			OperationsHashes:                      sbp.getOutGoingOperationsHashes(outGoingMbHeader.Hash, body),
+		}
+	}
+
+	if !sovereignHeader.IsStartOfEpochBlock() {
+		return
+	}
+
+	economics := sovereignHeader.EpochStart.Economics
+	elasticBlock.EpochStartInfo = &data.EpochStartInfo{
+		TotalSupply:                      converters.BigIntToString(economics.TotalSupply),
+		TotalToDistribute:                converters.BigIntToString(economics.TotalToDistribute),
+		TotalNewlyMinted:                 converters.BigIntToString(economics.TotalNewlyMinted),
+		RewardsPerBlock:                  converters.BigIntToString(economics.RewardsPerBlock),
+		RewardsForProtocolSustainability: converters.BigIntToString(economics.RewardsForProtocolSustainability),
+		NodePrice:                        converters.BigIntToString(economics.NodePrice),
+		PrevEpochStartRound:              economics.PrevEpochStartRound,
+		PrevEpochStartHash:               hex.EncodeToString(economics.PrevEpochStartHash),
+	}
+
+	crossChainHeader := sovereignHeader.EpochStart.LastFinalizedCrossChainHeader
+	elasticBlock.LastFinalizedCrossChainHeader = &data.EpochStartCrossChainData{
+		ShardID:    crossChainHeader.ShardID,
+		Epoch:      crossChainHeader.Epoch,
+		Round:      crossChainHeader.Round,
+		Nonce:      crossChainHeader.Nonce,
+		HeaderHash: hex.EncodeToString(crossChainHeader.HeaderHash),
+	}
+}
+
+// the outgoing miniblock is part of the block body and holds the hashes of the outgoing operations
+func (sbp *sovereignBlockProcessor) getOutGoingOperationsHashes(outGoingMbHash []byte, body *block.Body) []string {
+	if len(outGoingMbHash) == 0 || body == nil {
+		return nil
+	}
+
+	for _, miniblock := range body.MiniBlocks {
+		mbHash, err := core.CalculateHash(sbp.marshalizer, sbp.hasher, miniblock)
+		if err != nil {
+			log.Warn("sovereignBlockProcessor.getOutGoingOperationsHashes: cannot compute miniblock hash", "error", err)
+			continue
+		}
+
+		if bytes.Equal(mbHash, outGoingMbHash) {
+			return hexEncodeSlice(miniblock.TxHashes)
+		}
+	}
+
+	return nil
+}
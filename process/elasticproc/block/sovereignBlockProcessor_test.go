@@ -0,0 +1,124 @@
+package block
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	dataBlock "github.com/TerraDharitri/drt-go-chain-core/data/block"
+	"github.com/TerraDharitri/drt-go-chain-core/data/outport"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	indexer "github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSovereignBlockProcessor(t *testing.T) {
+	t.Parallel()
+
+	sbp, err := NewSovereignBlockProcessor(nil, &mock.MarshalizerMock{})
+	require.Nil(t, sbp)
+	require.Equal(t, indexer.ErrNilHasher, err)
+
+	sbp, err = NewSovereignBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+	require.Nil(t, err)
+	require.NotNil(t, sbp)
+}
+
+func TestSovereignBlockProcessor_PrepareBlockForDB(t *testing.T) {
+	t.Parallel()
+
+	sbp, _ := NewSovereignBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+
+	outGoingMb := &dataBlock.MiniBlock{
+		TxHashes: [][]byte{[]byte("op1"), []byte("op2")},
+	}
+	outGoingMbHash, _ := core.CalculateHash(sbp.marshalizer, sbp.hasher, outGoingMb)
+
+	header := &dataBlock.SovereignChainHeader{
+		Header: &dataBlock.Header{
+			Nonce:     10,
+			Round:     11,
+			TimeStamp: 5000,
+		},
+		ValidatorStatsRootHash:    []byte("vsrh"),
+		ExtendedShardHeaderHashes: [][]byte{[]byte("ext1")},
+		OutGoingMiniBlockHeader: &dataBlock.OutGoingMiniBlockHeader{
+			Hash:                                  outGoingMbHash,
+			OutGoingOperationsHash:                []byte("opsHash"),
+			AggregatedSignatureOutGoingOperations: []byte("aggSig"),
+			LeaderSignatureOutGoingOperations:     []byte("leaderSig"),
+		},
+		IsStartOfEpoch:         true,
+		AccumulatedFeesInEpoch: big.NewInt(100),
+		DevFeesInEpoch:         big.NewInt(10),
+		EpochStart: dataBlock.EpochStartSovereign{
+			Economics: dataBlock.Economics{
+				TotalSupply:        big.NewInt(1000),
+				PrevEpochStartHash: []byte("prev"),
+			},
+			LastFinalizedCrossChainHeader: dataBlock.EpochStartCrossChainData{
+				ShardID:    core.MainChainShardId,
+				Nonce:      20,
+				HeaderHash: []byte("mainHash"),
+			},
+		},
+	}
+
+	outportBlockWithHeader := &outport.OutportBlockWithHeader{
+		Header: header,
+		OutportBlock: &outport.OutportBlock{
+			BlockData: &outport.BlockData{
+				HeaderHash: []byte("hash"),
+				Body: &dataBlock.Body{
+					MiniBlocks: []*dataBlock.MiniBlock{{}, outGoingMb},
+				},
+			},
+			TransactionPool:      &outport.TransactionPool{},
+			HeaderGasConsumption: &outport.HeaderGasConsumption{},
+		},
+	}
+
+	bp, _ := NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+	dbBlock, err := bp.PrepareBlockForDB(outportBlockWithHeader)
+	require.Nil(t, err)
+	require.Empty(t, dbBlock.ValidatorStatsRootHash)
+	require.Nil(t, dbBlock.OutGoingMiniBlockHeader)
+
+	dbBlock, err = sbp.PrepareBlockForDB(outportBlockWithHeader)
+	require.Nil(t, err)
+	require.Equal(t, "76737268", dbBlock.ValidatorStatsRootHash)
+	require.Equal(t, []string{"65787431"}, dbBlock.ExtendedShardHeaderHashes)
+	require.Equal(t, "100", dbBlock.AccumulatedFeesInEpoch)
+	require.Equal(t, "10", dbBlock.DeveloperFeesInEpoch)
+	require.Equal(t, &data.OutGoingMiniBlockHeader{
+		Hash:                                  hex.EncodeToString(outGoingMbHash),
+		OutGoingOperationsHash:                "6f707348617368",
+		AggregatedSignatureOutGoingOperations: "616767536967",
+		LeaderSignatureOutGoingOperations:     "6c6561646572536967",
+		OperationsHashes:                      []string{"6f7031", "6f7032"},
+	}, dbBlock.OutGoingMiniBlockHeader)
+	require.Equal(t, "1000", dbBlock.EpochStartInfo.TotalSupply)
+	require.Equal(t, &data.EpochStartCrossChainData{
+		ShardID:    core.MainChainShardId,
+		Nonce:      20,
+		HeaderHash: "6d61696e48617368",
+	}, dbBlock.LastFinalizedCrossChainHeader)
+
+	bridgeOperations := sbp.PrepareBridgeOperations(dbBlock)
+	require.Len(t, bridgeOperations, 2)
+	require.Equal(t, &data.BridgeOperation{
+		Hash:                   "6f7032",
+		Index:                  1,
+		OutGoingMiniBlockHash:  hex.EncodeToString(outGoingMbHash),
+		OutGoingOperationsHash: "6f707348617368",
+		AggregatedSignature:    "616767536967",
+		LeaderSignature:        "6c6561646572536967",
+		BlockHash:              "68617368",
+		BlockNonce:             10,
+		Round:                  11,
+		ShardID:                dbBlock.ShardID,
+		Timestamp:              5000,
+	}, bridgeOperations[1])
+}
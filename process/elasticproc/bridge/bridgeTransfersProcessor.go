@@ -0,0 +1,319 @@
+package bridge
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+const (
+	// DirectionIncoming marks the transfers from the main chain to the sovereign chain
+	DirectionIncoming = "incoming"
+	// DirectionOutgoing marks the transfers from the sovereign chain to the main chain
+	DirectionOutgoing = "outgoing"
+
+	// StatusPending marks a transfer that was not executed yet on the destination chain
+	StatusPending = "pending"
+	// StatusExecuted marks a transfer that was executed on the destination chain
+	StatusExecuted = "executed"
+	// StatusFailed marks a transfer whose execution failed on the destination chain
+	StatusFailed = "failed"
+
+	depositTopic               = "deposit"
+	depositIdentifier          = "deposit"
+	executedBridgeOpTopic      = "executedBridgeOp"
+	executedBridgeOpIdentifier = "execute"
+
+	numTopicsPerDepositToken   = 3
+	minNumTopicsDeposit        = 2 + numTopicsPerDepositToken
+	minNumTopicsExecutedOp     = 3
+	tokenDataAmountLenOffset   = 1
+	tokenDataAmountOffset      = tokenDataAmountLenOffset + 4
+	eventDataSenderOffset      = 8
+	executedOpStatusSuccessful = 1
+)
+
+var log = logger.GetOrCreate("indexer/process/bridge")
+
+type bridgeTransfersProcessor struct {
+	pubKeyConverter       core.PubkeyConverter
+	dcdtPrefix            string
+	bridgeContractAddress string
+}
+
+// NewBridgeTransfersProcessor will create a new instance of bridgeTransfersProcessor. Only the events emitted by the
+// provided bridge contract address are processed
+func NewBridgeTransfersProcessor(pubKeyConverter core.PubkeyConverter, dcdtPrefix string, bridgeContractAddress string) (*bridgeTransfersProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+	if bridgeContractAddress == "" {
+		return nil, dataindexer.ErrEmptyBridgeContractAddress
+	}
+
+	return &bridgeTransfersProcessor{
+		pubKeyConverter:       pubKeyConverter,
+		dcdtPrefix:            dcdtPrefix,
+		bridgeContractAddress: bridgeContractAddress,
+	}, nil
+}
+
+// PrepareBridgeTransfers will extract the cross-chain transfers and their confirmations from the provided block data.
+// The incoming transfers are the smart contract results sent by the main chain, which are already executed. The outgoing
+// transfers are extracted from the deposit events of the bridge contract and stay pending until the confirmation event
+// of their execution on the main chain arrives. A transaction can hold more deposits, so the outgoing transfers are
+// identified by the hash of the transaction which emitted the event and the index of the event in its log.
+func (btp *bridgeTransfersProcessor) PrepareBridgeTransfers(
+	scrs []*data.ScResult,
+	events []*data.LogEvent,
+	timestamp uint64,
+	shardID uint32,
+) *data.PreparedBridgeTransfers {
+	prepared := &data.PreparedBridgeTransfers{
+		Transfers:     make([]*data.BridgeTransfer, 0),
+		Confirmations: make([]*data.BridgeTransferConfirmation, 0),
+	}
+
+	for _, scr := range scrs {
+		transfer := btp.prepareIncomingTransfer(scr, timestamp, shardID)
+		if transfer != nil {
+			prepared.Transfers = append(prepared.Transfers, transfer)
+		}
+	}
+
+	for _, event := range events {
+		if event.Address != btp.bridgeContractAddress {
+			continue
+		}
+
+		topics := decodeTopics(event.Topics)
+		if len(topics) == 0 {
+			continue
+		}
+
+		switch {
+		case event.Identifier == depositIdentifier && string(topics[0]) == depositTopic:
+			transfer := btp.prepareOutgoingTransfer(event, topics, timestamp, shardID)
+			if transfer != nil {
+				prepared.Transfers = append(prepared.Transfers, transfer)
+			}
+		case event.Identifier == executedBridgeOpIdentifier && string(topics[0]) == executedBridgeOpTopic:
+			confirmation := prepareConfirmation(event, topics, timestamp, shardID)
+			if confirmation != nil {
+				prepared.Confirmations = append(prepared.Confirmations, confirmation)
+			}
+		}
+	}
+
+	return prepared
+}
+
+func (btp *bridgeTransfersProcessor) prepareIncomingTransfer(scr *data.ScResult, timestamp uint64, shardID uint32) *data.BridgeTransfer {
+	if scr.SenderShard != core.MainChainShardId || len(scr.Tokens) == 0 {
+		return nil
+	}
+
+	originTxHash := scr.OriginalTxHash
+	if originTxHash == "" {
+		originTxHash = scr.Hash
+	}
+
+	tokens := make([]*data.BridgeToken, 0, len(scr.Tokens))
+	for idx, identifier := range scr.Tokens {
+		amount := "0"
+		if idx < len(scr.DCDTValues) {
+			amount = scr.DCDTValues[idx]
+		}
+		tokens = append(tokens, btp.newBridgeToken(identifier, amount))
+	}
+
+	status := StatusExecuted
+	if scr.Status == transaction.TxStatusFail.String() {
+		status = StatusFailed
+	}
+
+	return &data.BridgeTransfer{
+		ID:                originTxHash,
+		Direction:         DirectionIncoming,
+		OriginTxHash:      originTxHash,
+		DestinationTxHash: scr.Hash,
+		Sender:            scr.Sender,
+		Receiver:          scr.Receiver,
+		Tokens:            tokens,
+		Status:            status,
+		ShardID:           shardID,
+		Timestamp:         time.Duration(timestamp),
+		ExecutedTimestamp: time.Duration(timestamp),
+	}
+}
+
+// deposit event:
+// topics[0] -- "deposit"
+// topics[1] -- receiver address on the main chain
+// topics[2+3*i] -- token identifier
+// topics[3+3*i] -- token nonce
+// topics[4+3*i] -- token data, which holds the amount
+// data -- the nonce of the operation and the sender address
+func (btp *bridgeTransfersProcessor) prepareOutgoingTransfer(event *data.LogEvent, topics [][]byte, timestamp uint64, shardID uint32) *data.BridgeTransfer {
+	if len(topics) < minNumTopicsDeposit {
+		return nil
+	}
+
+	receiver, err := btp.pubKeyConverter.Encode(topics[1])
+	if err != nil {
+		log.Debug("bridgeTransfersProcessor.prepareOutgoingTransfer cannot encode receiver", "txHash", event.TxHash, "error", err)
+		return nil
+	}
+
+	tokens := make([]*data.BridgeToken, 0)
+	for idx := 2; idx+numTopicsPerDepositToken <= len(topics); idx += numTopicsPerDepositToken {
+		identifier := string(topics[idx])
+		nonce := big.NewInt(0).SetBytes(topics[idx+1]).Uint64()
+		if nonce > 0 {
+			identifier = identifier + "-" + hex.EncodeToString(topics[idx+1])
+		}
+
+		tokens = append(tokens, btp.newBridgeToken(identifier, extractAmountFromTokenData(topics[idx+2])))
+	}
+
+	originTxHash := event.OriginalTxHash
+	if originTxHash == "" {
+		originTxHash = event.TxHash
+	}
+
+	return &data.BridgeTransfer{
+		ID:           fmt.Sprintf("%s-%d", event.TxHash, event.Order),
+		Direction:    DirectionOutgoing,
+		OriginTxHash: originTxHash,
+		Sender:       btp.extractSenderFromEventData(event.Data),
+		Receiver:     receiver,
+		Tokens:       tokens,
+		Status:       StatusPending,
+		ShardID:      shardID,
+		Timestamp:    time.Duration(timestamp),
+	}
+}
+
+// executedBridgeOp event:
+// topics[0] -- "executedBridgeOp"
+// topics[1] -- hash of the deposit transaction on the origin chain
+// topics[2] -- status of the execution, 1 for success
+func prepareConfirmation(event *data.LogEvent, topics [][]byte, timestamp uint64, shardID uint32) *data.BridgeTransferConfirmation {
+	if len(topics) < minNumTopicsExecutedOp || len(topics[1]) == 0 {
+		return nil
+	}
+
+	status := StatusFailed
+	if big.NewInt(0).SetBytes(topics[2]).Uint64() == executedOpStatusSuccessful {
+		status = StatusExecuted
+	}
+
+	destinationTxHash := event.OriginalTxHash
+	if destinationTxHash == "" {
+		destinationTxHash = event.TxHash
+	}
+
+	return &data.BridgeTransferConfirmation{
+		OriginTxHash:      hex.EncodeToString(topics[1]),
+		DestinationTxHash: destinationTxHash,
+		Status:            status,
+		ShardID:           shardID,
+		Timestamp:         time.Duration(timestamp),
+	}
+}
+
+func (btp *bridgeTransfersProcessor) newBridgeToken(identifier string, amount string) *data.BridgeToken {
+	prefix, hasPrefix := dcdt.IsValidPrefixedToken(identifier)
+	token, nonce := splitTokenIdentifier(identifier, hasPrefix)
+	if !hasPrefix {
+		prefix = ""
+	}
+
+	return &data.BridgeToken{
+		Identifier:       identifier,
+		Token:            token,
+		Nonce:            nonce,
+		Amount:           amount,
+		Prefix:           prefix,
+		IsSovereignToken: hasPrefix && prefix == btp.dcdtPrefix,
+	}
+}
+
+func (btp *bridgeTransfersProcessor) extractSenderFromEventData(eventData string) string {
+	dataBytes, err := hex.DecodeString(eventData)
+	senderEnd := eventDataSenderOffset + btp.pubKeyConverter.Len()
+	if err != nil || len(dataBytes) < senderEnd {
+		return ""
+	}
+
+	sender, err := btp.pubKeyConverter.Encode(dataBytes[eventDataSenderOffset:senderEnd])
+	if err != nil {
+		return ""
+	}
+
+	return sender
+}
+
+// the token data starts with the token type on one byte, followed by the length of the amount on four bytes and the amount
+func extractAmountFromTokenData(tokenData []byte) string {
+	if len(tokenData) < tokenDataAmountOffset {
+		return "0"
+	}
+
+	amountLen := int(binary.BigEndian.Uint32(tokenData[tokenDataAmountLenOffset:tokenDataAmountOffset]))
+	if len(tokenData) < tokenDataAmountOffset+amountLen {
+		return "0"
+	}
+
+	return big.NewInt(0).SetBytes(tokenData[tokenDataAmountOffset : tokenDataAmountOffset+amountLen]).String()
+}
+
+func splitTokenIdentifier(identifier string, hasPrefix bool) (string, uint64) {
+	numNFTParts := 3
+	if hasPrefix {
+		numNFTParts++
+	}
+
+	parts := strings.Split(identifier, "-")
+	if len(parts) != numNFTParts {
+		return identifier, 0
+	}
+
+	nonce, err := strconv.ParseUint(parts[len(parts)-1], 16, 64)
+	if err != nil {
+		return identifier, 0
+	}
+
+	return strings.Join(parts[:len(parts)-1], "-"), nonce
+}
+
+func decodeTopics(hexTopics []string) [][]byte {
+	topics := make([][]byte, 0, len(hexTopics))
+	for _, hexTopic := range hexTopics {
+		topic, err := hex.DecodeString(hexTopic)
+		if err != nil {
+			return nil
+		}
+		topics = append(topics, topic)
+	}
+
+	return topics
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (btp *bridgeTransfersProcessor) IsInterfaceNil() bool {
+	return btp == nil
+}
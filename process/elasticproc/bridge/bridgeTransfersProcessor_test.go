@@ -0,0 +1,204 @@
+package bridge
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/stretchr/testify/require"
+)
+
+const bridgeAddress = "bridge"
+
+func TestNewBridgeTransfersProcessor(t *testing.T) {
+	t.Parallel()
+
+	btp, err := NewBridgeTransfersProcessor(nil, "sov", bridgeAddress)
+	require.Nil(t, btp)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	btp, err = NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", "")
+	require.Nil(t, btp)
+	require.Equal(t, dataindexer.ErrEmptyBridgeContractAddress, err)
+
+	btp, err = NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", bridgeAddress)
+	require.Nil(t, err)
+	require.False(t, btp.IsInterfaceNil())
+}
+
+func TestBridgeTransfersProcessor_PrepareBridgeTransfersIncoming(t *testing.T) {
+	t.Parallel()
+
+	btp, _ := NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", bridgeAddress)
+
+	scrs := []*data.ScResult{
+		{
+			Hash:           "scr1",
+			OriginalTxHash: "depositTx",
+			Sender:         "sender",
+			Receiver:       "receiver",
+			SenderShard:    core.MainChainShardId,
+			Tokens:         []string{"TKN-123456", "sov-NFT-abcdef-0a"},
+			DCDTValues:     []string{"100", "1"},
+		},
+		{
+			Hash:        "scr2",
+			SenderShard: 0,
+			Tokens:      []string{"TKN-123456"},
+			DCDTValues:  []string{"5"},
+		},
+		{
+			Hash:        "scr3",
+			SenderShard: core.MainChainShardId,
+		},
+	}
+
+	prepared := btp.PrepareBridgeTransfers(scrs, nil, 5000, 0)
+	require.Len(t, prepared.Transfers, 1)
+	require.Empty(t, prepared.Confirmations)
+	require.Equal(t, &data.BridgeTransfer{
+		ID:                "depositTx",
+		Direction:         DirectionIncoming,
+		OriginTxHash:      "depositTx",
+		DestinationTxHash: "scr1",
+		Sender:            "sender",
+		Receiver:          "receiver",
+		Tokens: []*data.BridgeToken{
+			{
+				Identifier: "TKN-123456",
+				Token:      "TKN-123456",
+				Amount:     "100",
+			},
+			{
+				Identifier:       "sov-NFT-abcdef-0a",
+				Token:            "sov-NFT-abcdef",
+				Nonce:            10,
+				Amount:           "1",
+				Prefix:           "sov",
+				IsSovereignToken: true,
+			},
+		},
+		Status:            StatusExecuted,
+		ShardID:           0,
+		Timestamp:         time.Duration(5000),
+		ExecutedTimestamp: time.Duration(5000),
+	}, prepared.Transfers[0])
+}
+
+func TestBridgeTransfersProcessor_PrepareBridgeTransfersOutgoing(t *testing.T) {
+	t.Parallel()
+
+	btp, _ := NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", bridgeAddress)
+
+	receiver := []byte("receiver-address-on-main-chain..")
+	sender := []byte("sender-address-on-sovereign.....")
+	eventData := append([]byte{0, 0, 0, 0, 0, 0, 0, 1}, sender...)
+	tokenData := []byte{1, 0, 0, 0, 2, 3, 232}
+
+	events := []*data.LogEvent{
+		{
+			TxHash:     "depositTx",
+			Address:    bridgeAddress,
+			Identifier: depositIdentifier,
+			Order:      1,
+			Topics: []string{
+				hex.EncodeToString([]byte(depositTopic)),
+				hex.EncodeToString(receiver),
+				hex.EncodeToString([]byte("TKN-123456")),
+				"",
+				hex.EncodeToString(tokenData),
+			},
+			Data: hex.EncodeToString(eventData),
+		},
+		{
+			TxHash:     "otherTx",
+			Address:    bridgeAddress,
+			Identifier: "transfer",
+			Topics:     []string{hex.EncodeToString([]byte("transfer"))},
+		},
+		{
+			TxHash:     "otherContractTx",
+			Address:    "other",
+			Identifier: depositIdentifier,
+			Topics: []string{
+				hex.EncodeToString([]byte(depositTopic)),
+				hex.EncodeToString(receiver),
+				hex.EncodeToString([]byte("TKN-123456")),
+				"",
+				hex.EncodeToString(tokenData),
+			},
+			Data: hex.EncodeToString(eventData),
+		},
+	}
+
+	prepared := btp.PrepareBridgeTransfers(nil, events, 5000, 0)
+	require.Len(t, prepared.Transfers, 1)
+	require.Equal(t, &data.BridgeTransfer{
+		ID:           "depositTx-1",
+		Direction:    DirectionOutgoing,
+		OriginTxHash: "depositTx",
+		Sender:       hex.EncodeToString(sender),
+		Receiver:     hex.EncodeToString(receiver),
+		Tokens: []*data.BridgeToken{
+			{
+				Identifier: "TKN-123456",
+				Token:      "TKN-123456",
+				Amount:     "1000",
+			},
+		},
+		Status:    StatusPending,
+		ShardID:   0,
+		Timestamp: time.Duration(5000),
+	}, prepared.Transfers[0])
+}
+
+func TestBridgeTransfersProcessor_PrepareBridgeTransfersConfirmations(t *testing.T) {
+	t.Parallel()
+
+	btp, _ := NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", bridgeAddress)
+
+	events := []*data.LogEvent{
+		{
+			TxHash:         "executeTx",
+			OriginalTxHash: "originalExecuteTx",
+			Address:        bridgeAddress,
+			Identifier:     executedBridgeOpIdentifier,
+			Topics: []string{
+				hex.EncodeToString([]byte(executedBridgeOpTopic)),
+				"aabb",
+				"01",
+			},
+		},
+		{
+			TxHash:     "executeTx2",
+			Address:    bridgeAddress,
+			Identifier: executedBridgeOpIdentifier,
+			Topics: []string{
+				hex.EncodeToString([]byte(executedBridgeOpTopic)),
+				"ccdd",
+				"02",
+			},
+		},
+	}
+
+	prepared := btp.PrepareBridgeTransfers(nil, events, 6000, 0)
+	require.Empty(t, prepared.Transfers)
+	require.Equal(t, []*data.BridgeTransferConfirmation{
+		{
+			OriginTxHash:      "aabb",
+			DestinationTxHash: "originalExecuteTx",
+			Status:            StatusExecuted,
+			Timestamp:         time.Duration(6000),
+		},
+		{
+			OriginTxHash:      "ccdd",
+			DestinationTxHash: "executeTx2",
+			Status:            StatusFailed,
+			Timestamp:         time.Duration(6000),
+		},
+	}, prepared.Confirmations)
+}
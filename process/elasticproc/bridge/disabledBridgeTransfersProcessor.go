@@ -0,0 +1,39 @@
+package bridge
+
+import (
+	"bytes"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type disabledBridgeTransfersProcessor struct{}
+
+// NewDisabledBridgeTransfersProcessor creates a new disabled bridge transfers processor
+func NewDisabledBridgeTransfersProcessor() *disabledBridgeTransfersProcessor {
+	return &disabledBridgeTransfersProcessor{}
+}
+
+// PrepareBridgeTransfers should do nothing and return no transfers
+func (dbtp *disabledBridgeTransfersProcessor) PrepareBridgeTransfers(_ []*data.ScResult, _ []*data.LogEvent, _ uint64, _ uint32) *data.PreparedBridgeTransfers {
+	return &data.PreparedBridgeTransfers{}
+}
+
+// SerializeBridgeTransfers should do nothing and return no error
+func (dbtp *disabledBridgeTransfersProcessor) SerializeBridgeTransfers(_ *data.PreparedBridgeTransfers, _ *data.BufferSlice, _ string) error {
+	return nil
+}
+
+// PrepareConfirmationQuery should do nothing and return a nil query
+func (dbtp *disabledBridgeTransfersProcessor) PrepareConfirmationQuery(_ *data.BridgeTransferConfirmation) (*bytes.Buffer, error) {
+	return nil, nil
+}
+
+// PrepareConfirmationsQueryInCaseOfRevert should do nothing and return a nil query
+func (dbtp *disabledBridgeTransfersProcessor) PrepareConfirmationsQueryInCaseOfRevert(_ uint64, _ uint32) *bytes.Buffer {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbtp *disabledBridgeTransfersProcessor) IsInterfaceNil() bool {
+	return dbtp == nil
+}
@@ -0,0 +1,27 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisabledBridgeTransfersProcessor(t *testing.T) {
+	t.Parallel()
+
+	dbtp := NewDisabledBridgeTransfersProcessor()
+	require.False(t, dbtp.IsInterfaceNil())
+}
+
+func TestDisabledBridgeTransfersProcessor_PrepareAndSerialize(t *testing.T) {
+	t.Parallel()
+
+	dbtp := NewDisabledBridgeTransfersProcessor()
+	prepared := dbtp.PrepareBridgeTransfers(nil, nil, 0, 0)
+	require.Empty(t, prepared.Transfers)
+	require.Empty(t, prepared.Confirmations)
+
+	err := dbtp.SerializeBridgeTransfers(prepared, nil, "")
+	require.NoError(t, err)
+	require.Nil(t, dbtp.PrepareConfirmationsQueryInCaseOfRevert(0, 0))
+}
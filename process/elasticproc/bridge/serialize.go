@@ -0,0 +1,105 @@
+package bridge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeBridgeTransfers will serialize the provided cross-chain transfers for database. The outgoing transfers are
+// upserted so a confirmation that was already indexed is not overwritten.
+func (btp *bridgeTransfersProcessor) SerializeBridgeTransfers(prepared *data.PreparedBridgeTransfers, buffSlice *data.BufferSlice, index string) error {
+	if prepared == nil {
+		return nil
+	}
+
+	for _, transfer := range prepared.Transfers {
+		err := serializeBridgeTransfer(transfer, buffSlice, index)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serializeBridgeTransfer(transfer *data.BridgeTransfer, buffSlice *data.BufferSlice, index string) error {
+	marshaledTransfer, err := json.Marshal(transfer)
+	if err != nil {
+		return err
+	}
+
+	if transfer.Direction == DirectionIncoming {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(transfer.ID), "\n"))
+		return buffSlice.PutData(meta, marshaledTransfer)
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.transfer
+		} else {
+			def status = ctx._source.status;
+			def destinationTxHash = ctx._source.destinationTxHash;
+			def executedTimestamp = ctx._source.executedTimestamp;
+			def executedShardID = ctx._source.executedShardID;
+			ctx._source = params.transfer;
+			if (status != null && status != 'pending') {
+				ctx._source.status = status;
+				ctx._source.destinationTxHash = destinationTxHash;
+				ctx._source.executedTimestamp = executedTimestamp;
+				ctx._source.executedShardID = executedShardID;
+			}
+		}
+`
+	meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(transfer.ID), "\n"))
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": {"transfer": %s}},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(marshaledTransfer))
+
+	return buffSlice.PutData(meta, []byte(serializedDataStr))
+}
+
+// PrepareConfirmationQuery will prepare the update by query that marks as executed or failed the pending outgoing
+// transfers of the deposit transaction referenced by the provided confirmation
+func (btp *bridgeTransfersProcessor) PrepareConfirmationQuery(confirmation *data.BridgeTransferConfirmation) (*bytes.Buffer, error) {
+	marshaledConfirmation, err := json.Marshal(confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	codeToExecute := `
+		ctx._source.status = params.confirmation.status;
+		ctx._source.destinationTxHash = params.confirmation.destinationTxHash;
+		ctx._source.executedTimestamp = params.confirmation.executedTimestamp;
+		ctx._source.executedShardID = params.confirmation.executedShardID;
+`
+
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"term": {"originTxHash": "%s"}},{"term": {"direction": "%s"}}]}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"confirmation": %s}}}`,
+		converters.JsonEscape(confirmation.OriginTxHash), DirectionOutgoing, converters.FormatPainlessSource(codeToExecute), string(marshaledConfirmation))
+
+	return bytes.NewBuffer([]byte(query)), nil
+}
+
+// PrepareConfirmationsQueryInCaseOfRevert will prepare the update by query that moves back to pending the transfers
+// confirmed by the reverted block
+func (btp *bridgeTransfersProcessor) PrepareConfirmationsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := `
+		ctx._source.status = 'pending';
+		ctx._source.remove('destinationTxHash');
+		ctx._source.remove('executedTimestamp');
+		ctx._source.remove('executedShardID');
+`
+
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"executedShardID": {"query": %d,"operator": "AND"}}},{"match": {"executedTimestamp": {"query": "%d","operator": "AND"}}}]}},`+
+		`"script": {"source": "%s","lang": "painless"}}`,
+		shardID, timestamp, converters.FormatPainlessSource(codeToExecute))
+
+	return bytes.NewBuffer([]byte(query))
+}
@@ -0,0 +1,82 @@
+package bridge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeTransfersProcessor_SerializeBridgeTransfers(t *testing.T) {
+	t.Parallel()
+
+	btp, _ := NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", bridgeAddress)
+
+	prepared := &data.PreparedBridgeTransfers{
+		Transfers: []*data.BridgeTransfer{
+			{
+				ID:                "in",
+				Direction:         DirectionIncoming,
+				OriginTxHash:      "in",
+				DestinationTxHash: "scr",
+				Receiver:          "receiver",
+				Tokens:            []*data.BridgeToken{{Identifier: "TKN-123456", Token: "TKN-123456", Amount: "1"}},
+				Status:            StatusExecuted,
+				Timestamp:         10,
+				ExecutedTimestamp: 10,
+			},
+			{
+				ID:           "out",
+				Direction:    DirectionOutgoing,
+				OriginTxHash: "out",
+				Receiver:     "receiver",
+				Tokens:       []*data.BridgeToken{},
+				Status:       StatusPending,
+				Timestamp:    10,
+			},
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := btp.SerializeBridgeTransfers(prepared, buffSlice, "bridgetransfers")
+	require.Nil(t, err)
+
+	expectedRes := `{ "index" : { "_index":"bridgetransfers", "_id" : "in" } }
+{"direction":"incoming","originTxHash":"in","destinationTxHash":"scr","receiver":"receiver","tokens":[{"identifier":"TKN-123456","token":"TKN-123456","amount":"1","isSovereignToken":false}],"status":"executed","shardID":0,"timestamp":10,"executedTimestamp":10}
+{ "update" : {"_index":"bridgetransfers", "_id" : "out" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.transfer} else {def status = ctx._source.status;def destinationTxHash = ctx._source.destinationTxHash;def executedTimestamp = ctx._source.executedTimestamp;def executedShardID = ctx._source.executedShardID;ctx._source = params.transfer;if (status != null && status != 'pending') {ctx._source.status = status;ctx._source.destinationTxHash = destinationTxHash;ctx._source.executedTimestamp = executedTimestamp;ctx._source.executedShardID = executedShardID;}}","lang": "painless","params": {"transfer": {"direction":"outgoing","originTxHash":"out","receiver":"receiver","tokens":[],"status":"pending","shardID":0,"timestamp":10}}},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestBridgeTransfersProcessor_PrepareConfirmationQuery(t *testing.T) {
+	t.Parallel()
+
+	btp, _ := NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", bridgeAddress)
+
+	query, err := btp.PrepareConfirmationQuery(&data.BridgeTransferConfirmation{
+		OriginTxHash:      "out",
+		DestinationTxHash: "exec",
+		Status:            StatusExecuted,
+		ShardID:           1,
+		Timestamp:         20,
+	})
+	require.Nil(t, err)
+
+	expectedQuery := `{"query": {"bool": {"must": [{"term": {"originTxHash": "out"}},{"term": {"direction": "outgoing"}}]}},` +
+		`"script": {"source": "ctx._source.status = params.confirmation.status;ctx._source.destinationTxHash = params.confirmation.destinationTxHash;ctx._source.executedTimestamp = params.confirmation.executedTimestamp;ctx._source.executedShardID = params.confirmation.executedShardID;","lang": "painless",` +
+		`"params": {"confirmation": {"originTxHash":"out","destinationTxHash":"exec","status":"executed","executedShardID":1,"executedTimestamp":20}}}}`
+	require.Equal(t, expectedQuery, query.String())
+}
+
+func TestBridgeTransfersProcessor_PrepareConfirmationsQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	btp, _ := NewBridgeTransfersProcessor(mock.NewPubkeyConverterMock(32), "sov", bridgeAddress)
+
+	query := btp.PrepareConfirmationsQueryInCaseOfRevert(5040, 1).String()
+	require.True(t, strings.HasPrefix(query, `{"query": {"bool": {"must": [{"match": {"executedShardID": {"query": 1,"operator": "AND"}}},{"match": {"executedTimestamp": {"query": "5040","operator": "AND"}}}]}},`))
+	require.Contains(t, query, `ctx._source.status = 'pending';`)
+}
@@ -0,0 +1,52 @@
+package elasticproc
+
+import (
+	"bytes"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+// BridgeTransfersHandlerMock -
+type BridgeTransfersHandlerMock struct {
+	PrepareBridgeTransfersCalled                  func(scrs []*data.ScResult, events []*data.LogEvent, timestamp uint64, shardID uint32) *data.PreparedBridgeTransfers
+	SerializeBridgeTransfersCalled                func(prepared *data.PreparedBridgeTransfers, buffSlice *data.BufferSlice, index string) error
+	PrepareConfirmationQueryCalled                func(confirmation *data.BridgeTransferConfirmation) (*bytes.Buffer, error)
+	PrepareConfirmationsQueryInCaseOfRevertCalled func(timestamp uint64, shardID uint32) *bytes.Buffer
+}
+
+// PrepareBridgeTransfers -
+func (bthm *BridgeTransfersHandlerMock) PrepareBridgeTransfers(scrs []*data.ScResult, events []*data.LogEvent, timestamp uint64, shardID uint32) *data.PreparedBridgeTransfers {
+	if bthm.PrepareBridgeTransfersCalled != nil {
+		return bthm.PrepareBridgeTransfersCalled(scrs, events, timestamp, shardID)
+	}
+	return &data.PreparedBridgeTransfers{}
+}
+
+// SerializeBridgeTransfers -
+func (bthm *BridgeTransfersHandlerMock) SerializeBridgeTransfers(prepared *data.PreparedBridgeTransfers, buffSlice *data.BufferSlice, index string) error {
+	if bthm.SerializeBridgeTransfersCalled != nil {
+		return bthm.SerializeBridgeTransfersCalled(prepared, buffSlice, index)
+	}
+	return nil
+}
+
+// PrepareConfirmationQuery -
+func (bthm *BridgeTransfersHandlerMock) PrepareConfirmationQuery(confirmation *data.BridgeTransferConfirmation) (*bytes.Buffer, error) {
+	if bthm.PrepareConfirmationQueryCalled != nil {
+		return bthm.PrepareConfirmationQueryCalled(confirmation)
+	}
+	return nil, nil
+}
+
+// PrepareConfirmationsQueryInCaseOfRevert -
+func (bthm *BridgeTransfersHandlerMock) PrepareConfirmationsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	if bthm.PrepareConfirmationsQueryInCaseOfRevertCalled != nil {
+		return bthm.PrepareConfirmationsQueryInCaseOfRevertCalled(timestamp, shardID)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bthm *BridgeTransfersHandlerMock) IsInterfaceNil() bool {
+	return bthm == nil
+}
@@ -13,6 +13,9 @@ func checkArguments(arguments *ArgElasticProcessor) error {
 	if arguments.EnabledIndexes == nil {
 		return elasticIndexer.ErrNilEnabledIndexesMap
 	}
+	if len(arguments.SupportedIndexes) == 0 {
+		return elasticIndexer.ErrEmptySupportedIndexes
+	}
 	if check.IfNilReflect(arguments.DBClient) {
 		return elasticIndexer.ErrNilDatabaseClient
 	}
@@ -40,9 +43,51 @@ func checkArguments(arguments *ArgElasticProcessor) error {
 	if check.IfNilReflect(arguments.OperationsProc) {
 		return elasticIndexer.ErrNilOperationsHandler
 	}
+	if check.IfNilReflect(arguments.TransfersProc) {
+		return elasticIndexer.ErrNilTransfersHandler
+	}
+	if check.IfNilReflect(arguments.ContractCallsProc) {
+		return elasticIndexer.ErrNilContractCallsHandler
+	}
+	if check.IfNilReflect(arguments.TxTracesProc) {
+		return elasticIndexer.ErrNilTxTracesHandler
+	}
+	if check.IfNilReflect(arguments.TxStatusHistoryProc) {
+		return elasticIndexer.ErrNilTxStatusHistoryHandler
+	}
+	if check.IfNilReflect(arguments.UsernamesProc) {
+		return elasticIndexer.ErrNilUsernamesHandler
+	}
+	if check.IfNilReflect(arguments.GuardiansProc) {
+		return elasticIndexer.ErrNilGuardiansHandler
+	}
+	if check.IfNilReflect(arguments.AddressActivityProc) {
+		return elasticIndexer.ErrNilAddressActivityHandler
+	}
+	if check.IfNilReflect(arguments.TokenHoldersProc) {
+		return elasticIndexer.ErrNilTokenHoldersHandler
+	}
 	if check.IfNilReflect(arguments.IndexTokensHandler) {
 		return elasticIndexer.ErrNilIndexTokensHandler
 	}
+	if check.IfNilReflect(arguments.BridgeTransfersHandler) {
+		return elasticIndexer.ErrNilBridgeTransfersHandler
+	}
+	if check.IfNil(arguments.DataPublisher) {
+		return elasticIndexer.ErrNilDataPublisher
+	}
+	if check.IfNil(arguments.AbiDecoder) {
+		return elasticIndexer.ErrNilAbiDecoder
+	}
+	if check.IfNil(arguments.SecondaryAddressEncoder) {
+		return elasticIndexer.ErrNilSecondaryAddressEncoder
+	}
+	if check.IfNil(arguments.AddressesFilter) {
+		return elasticIndexer.ErrNilAddressesFilter
+	}
+	if check.IfNil(arguments.PayloadsOffloader) {
+		return elasticIndexer.ErrNilPayloadsOffloader
+	}
 
 	return nil
 }
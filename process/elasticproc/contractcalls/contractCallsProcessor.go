@@ -0,0 +1,250 @@
+package contractcalls
+
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+// maxCallDepth bounds the walk on the chain of smart contract results
+const maxCallDepth = 1000
+
+type contractCallsProcessor struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewContractCallsProcessor will create a new instance of contractCallsProcessor
+func NewContractCallsProcessor(pubKeyConverter core.PubkeyConverter) (*contractCallsProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+
+	return &contractCallsProcessor{
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// PrepareContractCalls will extract the caller to callee edges from the transactions and the smart contract results that
+// are executed in the current shard. The depth is computed based on the chain of smart contract results of the block, so
+// for the calls whose parent was executed in a previous block it is only a lower bound.
+func (ccp *contractCallsProcessor) PrepareContractCalls(
+	txs []*data.Transaction,
+	scrs []*data.ScResult,
+	events []*data.LogEvent,
+	selfShardID uint32,
+) []*data.ContractCall {
+	failedHashes := getFailedHashes(events)
+	txHashes := make(map[string]struct{}, len(txs))
+	calls := make([]*data.ContractCall, 0)
+
+	for _, tx := range txs {
+		txHashes[tx.Hash] = struct{}{}
+		if !tx.IsScCall || tx.ReceiverShard != selfShardID {
+			continue
+		}
+
+		_, failed := failedHashes[tx.Hash]
+		calls = append(calls, &data.ContractCall{
+			ID:             tx.Hash,
+			TxHash:         tx.Hash,
+			OriginalTxHash: tx.Hash,
+			Caller:         tx.Sender,
+			Callee:         getCallee(tx.Receiver, tx.Receivers),
+			Function:       tx.Function,
+			Value:          tx.Value,
+			ValueNum:       tx.ValueNum,
+			GasLimit:       tx.GasLimit,
+			Success:        !failed && tx.Status != transaction.TxStatusFail.String(),
+			ShardID:        selfShardID,
+			Timestamp:      tx.Timestamp,
+		})
+	}
+
+	parents := make(map[string]string, len(scrs))
+	for _, scr := range scrs {
+		parents[scr.Hash] = scr.PrevTxHash
+	}
+
+	for _, scr := range scrs {
+		callee := getCallee(scr.Receiver, scr.Receivers)
+		isContractCall := scr.Function != "" && ccp.isSmartContract(callee)
+		if !isContractCall || scr.ReceiverShard != selfShardID {
+			continue
+		}
+
+		_, failed := failedHashes[scr.Hash]
+		calls = append(calls, &data.ContractCall{
+			ID:             scr.Hash,
+			TxHash:         scr.Hash,
+			OriginalTxHash: scr.OriginalTxHash,
+			PrevTxHash:     scr.PrevTxHash,
+			Caller:         scr.Sender,
+			Callee:         callee,
+			Function:       scr.Function,
+			CallType:       scr.CallType,
+			Value:          scr.Value,
+			ValueNum:       scr.ValueNum,
+			Depth:          computeDepth(scr.Hash, parents, txHashes),
+			GasLimit:       scr.GasLimit,
+			Success:        !failed,
+			ShardID:        selfShardID,
+			Timestamp:      scr.Timestamp,
+		})
+	}
+
+	return calls
+}
+
+// PrepareContractCallsStats will aggregate the provided calls of a block per contract and per contract and caller
+func (ccp *contractCallsProcessor) PrepareContractCallsStats(calls []*data.ContractCall, timestamp uint64, shardID uint32) *data.ContractCallsStats {
+	stats := &data.ContractCallsStats{
+		Contracts: make(map[string]*data.ContractCallStats),
+		Callers:   make(map[string]*data.ContractCallStats),
+		ShardID:   shardID,
+		Timestamp: timestamp,
+	}
+
+	for _, call := range calls {
+		contractStats, found := stats.Contracts[call.Callee]
+		if !found {
+			contractStats = &data.ContractCallStats{
+				ID:       call.Callee,
+				Contract: call.Callee,
+			}
+			stats.Contracts[call.Callee] = contractStats
+		}
+		addCall(contractStats, call)
+
+		callerID := computeCallerID(call.Callee, call.Caller)
+		callerStats, found := stats.Callers[callerID]
+		if !found {
+			callerStats = &data.ContractCallStats{
+				ID:       callerID,
+				Contract: call.Callee,
+				Caller:   call.Caller,
+			}
+			stats.Callers[callerID] = callerStats
+		}
+		addCall(callerStats, call)
+	}
+
+	return stats
+}
+
+// ComputeUniqueCallers will increase the unique callers of the contracts with the callers that are not already indexed
+func (ccp *contractCallsProcessor) ComputeUniqueCallers(stats *data.ContractCallsStats, existingCallers *data.ResponseContractCallStats) {
+	indexedCallers := make(map[string]struct{})
+	if existingCallers != nil {
+		for _, doc := range existingCallers.Docs {
+			if doc.Found {
+				indexedCallers[doc.ID] = struct{}{}
+			}
+		}
+	}
+
+	for callerID, callerStats := range stats.Callers {
+		_, isIndexed := indexedCallers[callerID]
+		if isIndexed {
+			continue
+		}
+
+		contractStats, found := stats.Contracts[callerStats.Contract]
+		if found {
+			contractStats.UniqueCallers++
+		}
+	}
+}
+
+// ComputeRemovedCallers will set as unique callers of the contracts the callers whose indexed calls are all reverted, so
+// they are subtracted from the unique callers of the contracts
+func (ccp *contractCallsProcessor) ComputeRemovedCallers(stats *data.ContractCallsStats, existingCallers *data.ResponseContractCallStats) {
+	if existingCallers == nil {
+		return
+	}
+
+	for _, doc := range existingCallers.Docs {
+		if !doc.Found || doc.Source == nil {
+			continue
+		}
+
+		callerStats, found := stats.Callers[doc.ID]
+		if !found || doc.Source.InboundCalls > callerStats.InboundCalls {
+			continue
+		}
+
+		contractStats, found := stats.Contracts[callerStats.Contract]
+		if found {
+			contractStats.UniqueCallers++
+		}
+	}
+}
+
+func (ccp *contractCallsProcessor) isSmartContract(address string) bool {
+	addressBytes, err := ccp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return false
+	}
+
+	return core.IsSmartContractAddress(addressBytes)
+}
+
+func addCall(stats *data.ContractCallStats, call *data.ContractCall) {
+	stats.InboundCalls++
+	if !call.Success {
+		stats.Failures++
+	}
+	if call.Timestamp > stats.Timestamp {
+		stats.Timestamp = call.Timestamp
+	}
+}
+
+func computeCallerID(contract, caller string) string {
+	return fmt.Sprintf("%s_%s", contract, caller)
+}
+
+// getCallee returns the real receiver for the NFT and multi transfers, where the receiver is the sender itself
+func getCallee(receiver string, receivers []string) string {
+	if len(receivers) > 0 {
+		return receivers[0]
+	}
+
+	return receiver
+}
+
+func getFailedHashes(events []*data.LogEvent) map[string]struct{} {
+	failedHashes := make(map[string]struct{})
+	for _, event := range events {
+		isErrorEvent := event.Identifier == core.SignalErrorOperation || event.Identifier == core.InternalVMErrorsOperation
+		if isErrorEvent {
+			failedHashes[event.TxHash] = struct{}{}
+		}
+	}
+
+	return failedHashes
+}
+
+func computeDepth(hash string, parents map[string]string, txHashes map[string]struct{}) uint32 {
+	depth := uint32(0)
+	current := hash
+	for depth < maxCallDepth {
+		_, isTx := txHashes[current]
+		if isTx {
+			return depth
+		}
+
+		prev, found := parents[current]
+		if !found {
+			return depth
+		}
+
+		depth++
+		current = prev
+	}
+
+	return depth
+}
@@ -0,0 +1,188 @@
+package contractcalls
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+var (
+	alice     = strings.Repeat("a1", 32)
+	contractA = strings.Repeat("00", 10) + strings.Repeat("aa", 22)
+	contractB = strings.Repeat("00", 10) + strings.Repeat("bb", 22)
+)
+
+func TestNewContractCallsProcessor(t *testing.T) {
+	t.Parallel()
+
+	ccp, err := NewContractCallsProcessor(nil)
+	require.Nil(t, ccp)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	ccp, err = NewContractCallsProcessor(mock.NewPubkeyConverterMock(32))
+	require.Nil(t, err)
+	require.NotNil(t, ccp)
+}
+
+func TestContractCallsProcessor_PrepareContractCalls(t *testing.T) {
+	t.Parallel()
+
+	ccp, _ := NewContractCallsProcessor(mock.NewPubkeyConverterMock(32))
+
+	txs := []*data.Transaction{
+		{
+			Hash:      "tx1",
+			Sender:    alice,
+			Receiver:  contractA,
+			Function:  "swap",
+			IsScCall:  true,
+			Value:     "10",
+			ValueNum:  1,
+			GasLimit:  5000000,
+			Timestamp: time.Duration(100),
+		},
+		{
+			// transaction executed in another shard
+			Hash:          "tx2",
+			Sender:        alice,
+			Receiver:      contractB,
+			Function:      "stake",
+			IsScCall:      true,
+			ReceiverShard: 1,
+		},
+	}
+	scrs := []*data.ScResult{
+		{
+			Hash:           "scr1",
+			OriginalTxHash: "tx1",
+			PrevTxHash:     "tx1",
+			Sender:         contractA,
+			Receiver:       contractB,
+			Function:       "getPrice",
+			CallType:       "1",
+			GasLimit:       1000000,
+			Timestamp:      time.Duration(100),
+		},
+		{
+			Hash:           "scr2",
+			OriginalTxHash: "tx1",
+			PrevTxHash:     "scr1",
+			Sender:         contractB,
+			Receiver:       contractA,
+			Function:       "callback",
+			CallType:       "2",
+			Timestamp:      time.Duration(100),
+		},
+		{
+			// refund to the user, not a contract call
+			Hash:           "scr3",
+			OriginalTxHash: "tx1",
+			PrevTxHash:     "scr2",
+			Sender:         contractA,
+			Receiver:       alice,
+			Value:          "5",
+		},
+		{
+			// the parent was executed in a previous block
+			Hash:           "scr4",
+			OriginalTxHash: "tx0",
+			PrevTxHash:     "scr0",
+			Sender:         contractB,
+			Receiver:       contractA,
+			Function:       "notify",
+		},
+	}
+	events := []*data.LogEvent{
+		{TxHash: "scr1", Identifier: core.SignalErrorOperation},
+		{TxHash: "tx1", Identifier: "swap"},
+	}
+
+	calls := ccp.PrepareContractCalls(txs, scrs, events, 0)
+	require.Len(t, calls, 4)
+
+	require.Equal(t, &data.ContractCall{
+		ID:             "tx1",
+		TxHash:         "tx1",
+		OriginalTxHash: "tx1",
+		Caller:         alice,
+		Callee:         contractA,
+		Function:       "swap",
+		Value:          "10",
+		ValueNum:       1,
+		GasLimit:       5000000,
+		Success:        true,
+		Timestamp:      time.Duration(100),
+	}, calls[0])
+
+	require.Equal(t, "scr1", calls[1].ID)
+	require.Equal(t, contractB, calls[1].Callee)
+	require.Equal(t, uint32(1), calls[1].Depth)
+	require.False(t, calls[1].Success)
+
+	require.Equal(t, "scr2", calls[2].ID)
+	require.Equal(t, uint32(2), calls[2].Depth)
+	require.True(t, calls[2].Success)
+
+	require.Equal(t, "scr4", calls[3].ID)
+	require.Equal(t, uint32(1), calls[3].Depth)
+}
+
+func TestContractCallsProcessor_PrepareContractCallsStatsAndUniqueCallers(t *testing.T) {
+	t.Parallel()
+
+	ccp, _ := NewContractCallsProcessor(mock.NewPubkeyConverterMock(32))
+
+	calls := []*data.ContractCall{
+		{Caller: alice, Callee: contractA, Success: true, Timestamp: 100},
+		{Caller: alice, Callee: contractA, Success: false, Timestamp: 100},
+		{Caller: contractB, Callee: contractA, Success: true, Timestamp: 100},
+		{Caller: contractA, Callee: contractB, Success: true, Timestamp: 100},
+	}
+
+	stats := ccp.PrepareContractCallsStats(calls, 100, 1)
+	require.Equal(t, uint64(100), stats.Timestamp)
+	require.Equal(t, uint32(1), stats.ShardID)
+	require.Len(t, stats.Contracts, 2)
+	require.Len(t, stats.Callers, 3)
+	require.Equal(t, uint64(3), stats.Contracts[contractA].InboundCalls)
+	require.Equal(t, uint64(1), stats.Contracts[contractA].Failures)
+	require.Equal(t, uint64(2), stats.Callers[computeCallerID(contractA, alice)].InboundCalls)
+
+	ccp.ComputeUniqueCallers(stats, &data.ResponseContractCallStats{
+		Docs: []data.ResponseContractCallStatsDB{
+			{Found: true, ID: computeCallerID(contractA, alice)},
+			{Found: false, ID: computeCallerID(contractA, contractB)},
+		},
+	})
+	require.Equal(t, uint64(1), stats.Contracts[contractA].UniqueCallers)
+	require.Equal(t, uint64(1), stats.Contracts[contractB].UniqueCallers)
+}
+
+func TestContractCallsProcessor_ComputeRemovedCallers(t *testing.T) {
+	t.Parallel()
+
+	ccp, _ := NewContractCallsProcessor(mock.NewPubkeyConverterMock(32))
+
+	calls := []*data.ContractCall{
+		{Caller: alice, Callee: contractA, Success: true, Timestamp: 100},
+		{Caller: contractB, Callee: contractA, Success: true, Timestamp: 100},
+	}
+	stats := ccp.PrepareContractCallsStats(calls, 100, 1)
+
+	ccp.ComputeRemovedCallers(stats, &data.ResponseContractCallStats{
+		Docs: []data.ResponseContractCallStatsDB{
+			// all the calls of alice are reverted
+			{Found: true, ID: computeCallerID(contractA, alice), Source: &data.ContractCallStats{InboundCalls: 1}},
+			// contractB called contractA in a previous block too
+			{Found: true, ID: computeCallerID(contractA, contractB), Source: &data.ContractCallStats{InboundCalls: 3}},
+		},
+	})
+	require.Equal(t, uint64(1), stats.Contracts[contractA].UniqueCallers)
+}
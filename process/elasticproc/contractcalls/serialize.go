@@ -0,0 +1,119 @@
+package contractcalls
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeContractCalls will serialize the provided contract calls
+func (ccp *contractCallsProcessor) SerializeContractCalls(calls []*data.ContractCall, buffSlice *data.BufferSlice, index string) error {
+	for _, call := range calls {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(call.ID), "\n"))
+		serializedData, err := json.Marshal(call)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeContractCallsStats will serialize the aggregated calls of the contracts and of their callers. The counters
+// are added to the already indexed ones. The timestamp of the last block of every shard is kept, so the same block is not
+// counted twice.
+func (ccp *contractCallsProcessor) SerializeContractCallsStats(stats *data.ContractCallsStats, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source.putAll(params.stats);
+			ctx._source.shardsTimestamps = [params.shardID: params.timestamp];
+		} else {
+			if (!ctx._source.containsKey('shardsTimestamps') || ctx._source.shardsTimestamps == null) {
+				ctx._source.shardsTimestamps = [:];
+			}
+			def timestamps = ctx._source.shardsTimestamps;
+			if (timestamps.containsKey(params.shardID) && timestamps[params.shardID] >= params.timestamp) {
+				ctx.op = 'noop';
+			} else {
+				timestamps[params.shardID] = params.timestamp;
+				ctx._source.inboundCalls += params.stats.inboundCalls;
+				ctx._source.uniqueCallers += params.stats.uniqueCallers;
+				ctx._source.failures += params.stats.failures;
+				if (params.stats.timestamp > ctx._source.timestamp) {
+					ctx._source.timestamp = params.stats.timestamp;
+				}
+			}
+		}
+`
+
+	return serializeAllStats(stats, buffSlice, index, codeToExecute)
+}
+
+// SerializeContractCallsStatsInCaseOfRevert will serialize the aggregated calls of a reverted block. The counters are
+// subtracted from the indexed ones only if the block was the last one counted for its shard, and the documents of the
+// callers without calls are removed.
+func (ccp *contractCallsProcessor) SerializeContractCallsStatsInCaseOfRevert(stats *data.ContractCallsStats, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop';
+		} else {
+			def timestamps = ctx._source.shardsTimestamps;
+			if (timestamps == null || !timestamps.containsKey(params.shardID) || timestamps[params.shardID] != params.timestamp) {
+				ctx.op = 'noop';
+			} else {
+				timestamps[params.shardID] = params.timestamp - 1;
+				ctx._source.inboundCalls -= params.stats.inboundCalls;
+				ctx._source.uniqueCallers -= params.stats.uniqueCallers;
+				ctx._source.failures -= params.stats.failures;
+				if (ctx._source.containsKey('caller') && ctx._source.inboundCalls <= 0) {
+					ctx.op = 'delete';
+				}
+			}
+		}
+`
+
+	return serializeAllStats(stats, buffSlice, index, codeToExecute)
+}
+
+func serializeAllStats(stats *data.ContractCallsStats, buffSlice *data.BufferSlice, index string, codeToExecute string) error {
+	for _, contractStats := range stats.Contracts {
+		err := serializeStats(contractStats, stats.ShardID, stats.Timestamp, buffSlice, index, codeToExecute)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, callerStats := range stats.Callers {
+		err := serializeStats(callerStats, stats.ShardID, stats.Timestamp, buffSlice, index, codeToExecute)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serializeStats(stats *data.ContractCallStats, shardID uint32, timestamp uint64, buffSlice *data.BufferSlice, index string, codeToExecute string) error {
+	meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(stats.ID), "\n"))
+	marshaledStats, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	serializedData := []byte(fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": {"stats": %s, "shardID": "%d", "timestamp": %d}},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(marshaledStats), shardID, timestamp),
+	)
+
+	return buffSlice.PutData(meta, serializedData)
+}
@@ -0,0 +1,90 @@
+package contractcalls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+)
+
+func TestContractCallsProcessor_SerializeContractCalls(t *testing.T) {
+	t.Parallel()
+
+	ccp, _ := NewContractCallsProcessor(mock.NewPubkeyConverterMock(32))
+
+	calls := []*data.ContractCall{
+		{
+			ID:             "scr1",
+			TxHash:         "scr1",
+			OriginalTxHash: "tx1",
+			PrevTxHash:     "tx1",
+			Caller:         "contractA",
+			Callee:         "contractB",
+			Function:       "getPrice",
+			Value:          "0",
+			Depth:          1,
+			GasLimit:       100,
+			Success:        true,
+			Timestamp:      5040,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := ccp.SerializeContractCalls(calls, buffSlice, "contractcalls")
+	require.Nil(t, err)
+
+	expectedRes := `{ "index" : { "_index":"contractcalls", "_id" : "scr1" } }
+{"txHash":"scr1","originalTxHash":"tx1","prevTxHash":"tx1","caller":"contractA","callee":"contractB","function":"getPrice","value":"0","valueNum":0,"depth":1,"gasLimit":100,"success":true,"shardID":0,"timestamp":5040}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestContractCallsProcessor_SerializeContractCallsStats(t *testing.T) {
+	t.Parallel()
+
+	ccp, _ := NewContractCallsProcessor(mock.NewPubkeyConverterMock(32))
+
+	stats := &data.ContractCallsStats{
+		Contracts: map[string]*data.ContractCallStats{
+			"contractA": {ID: "contractA", Contract: "contractA", InboundCalls: 2, UniqueCallers: 1, Failures: 1, Timestamp: 5040},
+		},
+		Callers:   map[string]*data.ContractCallStats{},
+		ShardID:   1,
+		Timestamp: 5040,
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := ccp.SerializeContractCallsStats(stats, buffSlice, "contractcallstats")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : {"_index":"contractcallstats", "_id" : "contractA" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source.putAll(params.stats);ctx._source.shardsTimestamps = [params.shardID: params.timestamp];} else {if (!ctx._source.containsKey('shardsTimestamps') || ctx._source.shardsTimestamps == null) {ctx._source.shardsTimestamps = [:];}def timestamps = ctx._source.shardsTimestamps;if (timestamps.containsKey(params.shardID) && timestamps[params.shardID] >= params.timestamp) {ctx.op = 'noop';} else {timestamps[params.shardID] = params.timestamp;ctx._source.inboundCalls += params.stats.inboundCalls;ctx._source.uniqueCallers += params.stats.uniqueCallers;ctx._source.failures += params.stats.failures;if (params.stats.timestamp > ctx._source.timestamp) {ctx._source.timestamp = params.stats.timestamp;}}}","lang": "painless","params": {"stats": {"contract":"contractA","inboundCalls":2,"uniqueCallers":1,"failures":1,"timestamp":5040}, "shardID": "1", "timestamp": 5040}},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestContractCallsProcessor_SerializeContractCallsStatsInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	ccp, _ := NewContractCallsProcessor(mock.NewPubkeyConverterMock(32))
+
+	stats := &data.ContractCallsStats{
+		Contracts: map[string]*data.ContractCallStats{},
+		Callers: map[string]*data.ContractCallStats{
+			"contractA_alice": {ID: "contractA_alice", Contract: "contractA", Caller: "alice", InboundCalls: 1, Timestamp: 5040},
+		},
+		ShardID:   1,
+		Timestamp: 5040,
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := ccp.SerializeContractCallsStatsInCaseOfRevert(stats, buffSlice, "contractcallstats")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : {"_index":"contractcallstats", "_id" : "contractA_alice" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {def timestamps = ctx._source.shardsTimestamps;if (timestamps == null || !timestamps.containsKey(params.shardID) || timestamps[params.shardID] != params.timestamp) {ctx.op = 'noop';} else {timestamps[params.shardID] = params.timestamp - 1;ctx._source.inboundCalls -= params.stats.inboundCalls;ctx._source.uniqueCallers -= params.stats.uniqueCallers;ctx._source.failures -= params.stats.failures;if (ctx._source.containsKey('caller') && ctx._source.inboundCalls <= 0) {ctx.op = 'delete';}}}","lang": "painless","params": {"stats": {"contract":"contractA","caller":"alice","inboundCalls":1,"uniqueCallers":0,"failures":0,"timestamp":5040}, "shardID": "1", "timestamp": 5040}},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
@@ -0,0 +1,71 @@
+package converters
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+// base64PubkeyConverter encodes or decodes the public keys as/from standard base64
+type base64PubkeyConverter struct {
+	len int
+}
+
+// NewBase64PubkeyConverter returns a base64PubkeyConverter instance
+func NewBase64PubkeyConverter(pubkeyLen int) (*base64PubkeyConverter, error) {
+	if pubkeyLen < 1 {
+		return nil, fmt.Errorf("%w when creating base64 converter, length should have been greater than 0", dataindexer.ErrInvalidPubkeyLength)
+	}
+
+	return &base64PubkeyConverter{
+		len: pubkeyLen,
+	}, nil
+}
+
+// Decode converts the provided base64 public key to bytes
+func (bpc *base64PubkeyConverter) Decode(humanReadable string) ([]byte, error) {
+	buff, err := base64.StdEncoding.DecodeString(humanReadable)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buff) != bpc.len {
+		return nil, fmt.Errorf("%w when converting to public key, expected length %d, received %d",
+			dataindexer.ErrInvalidPubkeyLength, bpc.len, len(buff))
+	}
+
+	return buff, nil
+}
+
+// Encode converts the provided bytes to base64
+func (bpc *base64PubkeyConverter) Encode(pkBytes []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(pkBytes), nil
+}
+
+// EncodeSlice converts the provided bytes slice to base64
+func (bpc *base64PubkeyConverter) EncodeSlice(pkBytesSlice [][]byte) ([]string, error) {
+	encodedSlice := make([]string, 0, len(pkBytesSlice))
+	for _, item := range pkBytesSlice {
+		encodedSlice = append(encodedSlice, base64.StdEncoding.EncodeToString(item))
+	}
+
+	return encodedSlice, nil
+}
+
+// SilentEncode converts the provided bytes to base64
+func (bpc *base64PubkeyConverter) SilentEncode(pkBytes []byte, _ core.Logger) string {
+	return base64.StdEncoding.EncodeToString(pkBytes)
+}
+
+// Len returns the decoded public key length
+func (bpc *base64PubkeyConverter) Len() int {
+	return bpc.len
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bpc *base64PubkeyConverter) IsInterfaceNil() bool {
+	return bpc == nil
+}
@@ -0,0 +1,48 @@
+package converters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func TestNewBase64PubkeyConverter(t *testing.T) {
+	t.Parallel()
+
+	bpc, err := NewBase64PubkeyConverter(0)
+	require.Nil(t, bpc)
+	require.True(t, errors.Is(err, dataindexer.ErrInvalidPubkeyLength))
+
+	bpc, err = NewBase64PubkeyConverter(4)
+	require.Nil(t, err)
+	require.False(t, bpc.IsInterfaceNil())
+	require.Equal(t, 4, bpc.Len())
+}
+
+func TestBase64PubkeyConverter_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	bpc, _ := NewBase64PubkeyConverter(4)
+
+	encoded, err := bpc.Encode([]byte("abcd"))
+	require.Nil(t, err)
+	require.Equal(t, "YWJjZA==", encoded)
+	require.Equal(t, "YWJjZA==", bpc.SilentEncode([]byte("abcd"), nil))
+
+	encodedSlice, err := bpc.EncodeSlice([][]byte{[]byte("abcd"), []byte("efgh")})
+	require.Nil(t, err)
+	require.Equal(t, []string{"YWJjZA==", "ZWZnaA=="}, encodedSlice)
+
+	decoded, err := bpc.Decode("YWJjZA==")
+	require.Nil(t, err)
+	require.Equal(t, []byte("abcd"), decoded)
+
+	_, err = bpc.Decode("not base64")
+	require.NotNil(t, err)
+
+	_, err = bpc.Decode("YWJj")
+	require.True(t, errors.Is(err, dataindexer.ErrInvalidPubkeyLength))
+}
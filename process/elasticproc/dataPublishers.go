@@ -0,0 +1,40 @@
+package elasticproc
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+type dataPublishers struct {
+	pipeline   string
+	publishers []DataPublisher
+}
+
+// NewDataPublishers will create a DataPublisher that forwards the indexed data to all the provided publishers
+func NewDataPublishers(pipeline string, publishers ...DataPublisher) (*dataPublishers, error) {
+	for _, publisher := range publishers {
+		if check.IfNil(publisher) {
+			return nil, dataindexer.ErrNilDataPublisher
+		}
+	}
+
+	return &dataPublishers{
+		pipeline:   pipeline,
+		publishers: publishers,
+	}, nil
+}
+
+// Publish will tag the indexed data with the name of the pipeline and will forward it to all the publishers
+func (dp *dataPublishers) Publish(indexedData *data.IndexedBlockData) {
+	indexedData.Pipeline = dp.pipeline
+	for _, publisher := range dp.publishers {
+		publisher.Publish(indexedData)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dp *dataPublishers) IsInterfaceNil() bool {
+	return dp == nil
+}
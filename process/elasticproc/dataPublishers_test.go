@@ -0,0 +1,41 @@
+package elasticproc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func TestNewDataPublishers(t *testing.T) {
+	t.Parallel()
+
+	dp, err := NewDataPublishers("main-chain", &mock.DataPublisherStub{}, nil)
+	require.Nil(t, dp)
+	require.Equal(t, dataindexer.ErrNilDataPublisher, err)
+
+	dp, err = NewDataPublishers("main-chain", &mock.DataPublisherStub{})
+	require.Nil(t, err)
+	require.False(t, dp.IsInterfaceNil())
+}
+
+func TestDataPublishers_PublishShouldForwardToAllPublishers(t *testing.T) {
+	t.Parallel()
+
+	indexedData := &data.IndexedBlockData{Nonce: 7}
+	numCalls := 0
+	publisher := &mock.DataPublisherStub{
+		PublishCalled: func(d *data.IndexedBlockData) {
+			require.Equal(t, indexedData, d)
+			require.Equal(t, "main-chain", d.Pipeline)
+			numCalls++
+		},
+	}
+
+	dp, _ := NewDataPublishers("main-chain", publisher, publisher)
+	dp.Publish(indexedData)
+	require.Equal(t, 2, numCalls)
+}
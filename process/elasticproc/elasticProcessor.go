@@ -32,50 +32,92 @@ var (
 		elasticIndexer.TransactionsIndex, elasticIndexer.BlockIndex, elasticIndexer.MiniblocksIndex, elasticIndexer.RatingIndex, elasticIndexer.RoundsIndex, elasticIndexer.ValidatorsIndex,
 		elasticIndexer.AccountsIndex, elasticIndexer.AccountsHistoryIndex, elasticIndexer.ReceiptsIndex, elasticIndexer.ScResultsIndex, elasticIndexer.AccountsDCDTHistoryIndex, elasticIndexer.AccountsDCDTIndex,
 		elasticIndexer.EpochInfoIndex, elasticIndexer.SCDeploysIndex, elasticIndexer.TokensIndex, elasticIndexer.TagsIndex, elasticIndexer.LogsIndex, elasticIndexer.DelegatorsIndex, elasticIndexer.OperationsIndex,
-		elasticIndexer.DCDTsIndex, elasticIndexer.ValuesIndex, elasticIndexer.EventsIndex,
+		elasticIndexer.DCDTsIndex, elasticIndexer.ValuesIndex, elasticIndexer.EventsIndex, elasticIndexer.TransfersIndex, elasticIndexer.ContractCallsIndex,
+		elasticIndexer.ContractCallStatsIndex, elasticIndexer.TxTracesIndex, elasticIndexer.TxStatusHistoryIndex, elasticIndexer.UsernamesIndex, elasticIndexer.GuardiansIndex, elasticIndexer.ProvidersIndex, elasticIndexer.NodesIndex, elasticIndexer.AddressActivityIndex, elasticIndexer.BridgeOperationsIndex,
+		elasticIndexer.BridgeTransfersIndex, elasticIndexer.PayloadsIndex,
 	}
 )
 
 const versionStr = "indexer-version"
 
+// GetAllIndexes returns all the indexes known by the indexer. Every chain flavour supports a subset of them
+func GetAllIndexes() []string {
+	allIndexes := make([]string, len(indexes))
+	copy(allIndexes, indexes)
+
+	return allIndexes
+}
+
 // ArgElasticProcessor holds all dependencies required by the elasticProcessor in order to create
 // new instances
 type ArgElasticProcessor struct {
-	BulkRequestMaxSize int
-	UseKibana          bool
-	ImportDB           bool
-	IndexTemplates     map[string]*bytes.Buffer
-	IndexPolicies      map[string]*bytes.Buffer
-	ExtraMappings      []templates.ExtraMapping
-	EnabledIndexes     map[string]struct{}
-	TransactionsProc   DBTransactionsHandler
-	AccountsProc       DBAccountHandler
-	BlockProc          DBBlockHandler
-	MiniblocksProc     DBMiniblocksHandler
-	StatisticsProc     DBStatisticsHandler
-	ValidatorsProc     DBValidatorsHandler
-	DBClient           DatabaseClientHandler
-	LogsAndEventsProc  DBLogsAndEventsHandler
-	OperationsProc     OperationsHandler
-	Version            string
-	IndexTokensHandler IndexTokensHandler
+	BulkRequestMaxSize      int
+	UseKibana               bool
+	ImportDB                bool
+	IndexTemplates          map[string]*bytes.Buffer
+	IndexPolicies           map[string]*bytes.Buffer
+	ExtraMappings           []templates.ExtraMapping
+	EnabledIndexes          map[string]struct{}
+	SupportedIndexes        []string
+	CustomEventsIndices     []string
+	TransactionsProc        DBTransactionsHandler
+	AccountsProc            DBAccountHandler
+	BlockProc               DBBlockHandler
+	MiniblocksProc          DBMiniblocksHandler
+	StatisticsProc          DBStatisticsHandler
+	ValidatorsProc          DBValidatorsHandler
+	DBClient                DatabaseClientHandler
+	LogsAndEventsProc       DBLogsAndEventsHandler
+	OperationsProc          OperationsHandler
+	TransfersProc           TransfersHandler
+	ContractCallsProc       ContractCallsHandler
+	TxTracesProc            TxTracesHandler
+	TxStatusHistoryProc     TxStatusHistoryHandler
+	UsernamesProc           UsernamesHandler
+	GuardiansProc           GuardiansHandler
+	AddressActivityProc     AddressActivityHandler
+	TokenHoldersProc        TokenHoldersHandler
+	Version                 string
+	IndexTokensHandler      IndexTokensHandler
+	BridgeTransfersHandler  BridgeTransfersHandler
+	DataPublisher           DataPublisher
+	AbiDecoder              AbiDecoderHandler
+	SecondaryAddressEncoder SecondaryAddressEncoderHandler
+	AddressesFilter         AddressesFilterHandler
+	PayloadsOffloader       PayloadsOffloaderHandler
 }
 
 type elasticProcessor struct {
-	bulkRequestMaxSize int
-	importDB           bool
-	enabledIndexes     map[string]struct{}
-	mutex              sync.RWMutex
-	elasticClient      DatabaseClientHandler
-	accountsProc       DBAccountHandler
-	blockProc          DBBlockHandler
-	transactionsProc   DBTransactionsHandler
-	miniblocksProc     DBMiniblocksHandler
-	statisticsProc     DBStatisticsHandler
-	validatorsProc     DBValidatorsHandler
-	logsAndEventsProc  DBLogsAndEventsHandler
-	operationsProc     OperationsHandler
-	indexTokensHandler IndexTokensHandler
+	bulkRequestMaxSize      int
+	importDB                bool
+	enabledIndexes          map[string]struct{}
+	supportedIndexes        []string
+	customEventsIndices     []string
+	mutex                   sync.RWMutex
+	elasticClient           DatabaseClientHandler
+	accountsProc            DBAccountHandler
+	blockProc               DBBlockHandler
+	transactionsProc        DBTransactionsHandler
+	miniblocksProc          DBMiniblocksHandler
+	statisticsProc          DBStatisticsHandler
+	validatorsProc          DBValidatorsHandler
+	logsAndEventsProc       DBLogsAndEventsHandler
+	operationsProc          OperationsHandler
+	transfersProc           TransfersHandler
+	contractCallsProc       ContractCallsHandler
+	txTracesProc            TxTracesHandler
+	txStatusHistoryProc     TxStatusHistoryHandler
+	usernamesProc           UsernamesHandler
+	guardiansProc           GuardiansHandler
+	addressActivityProc     AddressActivityHandler
+	tokenHoldersProc        TokenHoldersHandler
+	indexTokensHandler      IndexTokensHandler
+	bridgeTransfersHandler  BridgeTransfersHandler
+	dataPublisher           DataPublisher
+	abiDecoder              AbiDecoderHandler
+	secondaryAddressEncoder SecondaryAddressEncoderHandler
+	addressesFilter         AddressesFilterHandler
+	payloadsOffloader       PayloadsOffloaderHandler
 }
 
 // NewElasticProcessor handles Elasticsearch operations such as initialization, adding, modifying or removing data
@@ -86,18 +128,34 @@ func NewElasticProcessor(arguments *ArgElasticProcessor) (*elasticProcessor, err
 	}
 
 	ei := &elasticProcessor{
-		elasticClient:      arguments.DBClient,
-		enabledIndexes:     arguments.EnabledIndexes,
-		accountsProc:       arguments.AccountsProc,
-		blockProc:          arguments.BlockProc,
-		miniblocksProc:     arguments.MiniblocksProc,
-		transactionsProc:   arguments.TransactionsProc,
-		statisticsProc:     arguments.StatisticsProc,
-		validatorsProc:     arguments.ValidatorsProc,
-		logsAndEventsProc:  arguments.LogsAndEventsProc,
-		operationsProc:     arguments.OperationsProc,
-		bulkRequestMaxSize: arguments.BulkRequestMaxSize,
-		indexTokensHandler: arguments.IndexTokensHandler,
+		elasticClient:           arguments.DBClient,
+		enabledIndexes:          arguments.EnabledIndexes,
+		supportedIndexes:        arguments.SupportedIndexes,
+		customEventsIndices:     arguments.CustomEventsIndices,
+		accountsProc:            arguments.AccountsProc,
+		blockProc:               arguments.BlockProc,
+		miniblocksProc:          arguments.MiniblocksProc,
+		transactionsProc:        arguments.TransactionsProc,
+		statisticsProc:          arguments.StatisticsProc,
+		validatorsProc:          arguments.ValidatorsProc,
+		logsAndEventsProc:       arguments.LogsAndEventsProc,
+		operationsProc:          arguments.OperationsProc,
+		transfersProc:           arguments.TransfersProc,
+		contractCallsProc:       arguments.ContractCallsProc,
+		txTracesProc:            arguments.TxTracesProc,
+		txStatusHistoryProc:     arguments.TxStatusHistoryProc,
+		usernamesProc:           arguments.UsernamesProc,
+		guardiansProc:           arguments.GuardiansProc,
+		addressActivityProc:     arguments.AddressActivityProc,
+		tokenHoldersProc:        arguments.TokenHoldersProc,
+		bulkRequestMaxSize:      arguments.BulkRequestMaxSize,
+		indexTokensHandler:      arguments.IndexTokensHandler,
+		bridgeTransfersHandler:  arguments.BridgeTransfersHandler,
+		dataPublisher:           arguments.DataPublisher,
+		abiDecoder:              arguments.AbiDecoder,
+		secondaryAddressEncoder: arguments.SecondaryAddressEncoder,
+		addressesFilter:         arguments.AddressesFilter,
+		payloadsOffloader:       arguments.PayloadsOffloader,
 	}
 
 	err = ei.init(arguments.UseKibana, arguments.IndexTemplates, arguments.IndexPolicies, arguments.ExtraMappings)
@@ -211,7 +269,7 @@ func (ei *elasticProcessor) createOpenDistroTemplates(indexTemplates map[string]
 }
 
 func (ei *elasticProcessor) createIndexTemplates(indexTemplates map[string]*bytes.Buffer) error {
-	for _, index := range indexes {
+	for _, index := range ei.supportedIndexes {
 		indexTemplate := getTemplateByName(index, indexTemplates)
 		if indexTemplate != nil {
 			err := ei.elasticClient.CheckAndCreateTemplate(index, indexTemplate)
@@ -225,7 +283,7 @@ func (ei *elasticProcessor) createIndexTemplates(indexTemplates map[string]*byte
 
 func (ei *elasticProcessor) createIndexes() error {
 
-	for _, index := range indexes {
+	for _, index := range ei.supportedIndexes {
 		indexName := fmt.Sprintf("%s-%s", index, elasticIndexer.IndexSuffix)
 		err := ei.elasticClient.CheckAndCreateIndex(indexName)
 		if err != nil {
@@ -236,7 +294,7 @@ func (ei *elasticProcessor) createIndexes() error {
 }
 
 func (ei *elasticProcessor) createAliases() error {
-	for _, index := range indexes {
+	for _, index := range ei.supportedIndexes {
 		indexName := fmt.Sprintf("%s-%s", index, elasticIndexer.IndexSuffix)
 		err := ei.elasticClient.CheckAndCreateAlias(index, indexName)
 		if err != nil {
@@ -278,9 +336,24 @@ func (ei *elasticProcessor) SaveHeader(outportBlockWithHeader *outport.OutportBl
 		return err
 	}
 
+	err = ei.indexBridgeOperations(elasticBlock, buffSlice)
+	if err != nil {
+		return err
+	}
+
 	return ei.doBulkRequests("", buffSlice.Buffers(), outportBlockWithHeader.ShardID)
 }
 
+func (ei *elasticProcessor) indexBridgeOperations(elasticBlock *data.Block, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.BridgeOperationsIndex) {
+		return nil
+	}
+
+	bridgeOperations := ei.blockProc.PrepareBridgeOperations(elasticBlock)
+
+	return ei.blockProc.SerializeBridgeOperations(bridgeOperations, buffSlice, elasticIndexer.BridgeOperationsIndex)
+}
+
 func (ei *elasticProcessor) indexEpochInfoData(header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
 	if !ei.isIndexEnabled(elasticIndexer.EpochInfoIndex) ||
 		header.GetShardID() != core.MetachainShardId {
@@ -298,11 +371,25 @@ func (ei *elasticProcessor) RemoveHeader(header coreData.HeaderHandler) error {
 	}
 
 	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.RemoveTopic, header.GetShardID()))
-	return ei.elasticClient.DoQueryRemove(
+	err = ei.elasticClient.DoQueryRemove(
 		ctxWithValue,
 		elasticIndexer.BlockIndex,
 		converters.PrepareHashesForQueryRemove([]string{hex.EncodeToString(headerHash)}),
 	)
+	if err != nil {
+		return err
+	}
+
+	return ei.removeBridgeOperations(ctxWithValue, hex.EncodeToString(headerHash))
+}
+
+func (ei *elasticProcessor) removeBridgeOperations(ctx context.Context, blockHash string) error {
+	if !ei.isIndexEnabled(elasticIndexer.BridgeOperationsIndex) {
+		return nil
+	}
+
+	query := fmt.Sprintf(`{"query": {"match": {"blockHash": {"query": "%s","operator": "AND"}}}}`, blockHash)
+	return ei.elasticClient.DoQueryRemove(ctx, elasticIndexer.BridgeOperationsIndex, bytes.NewBuffer([]byte(query)))
 }
 
 // RemoveMiniblocks will remove all miniblocks that are in header from elasticsearch server
@@ -345,14 +432,117 @@ func (ei *elasticProcessor) RemoveTransactions(header coreData.HeaderHandler, bo
 		return err
 	}
 
+	err = ei.updateTxTracesInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexTxStatusHistoryInCaseOfRevert(header, encodedTxsHashes, encodedScrsHashes)
+	if err != nil {
+		return err
+	}
+
+	err = ei.updateUsernamesInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
 	err = ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.EventsIndex)
 	if err != nil {
 		return err
 	}
 
+	err = ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.TransfersIndex)
+	if err != nil {
+		return err
+	}
+
+	err = ei.updateContractCallsStatsInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.ContractCallsIndex)
+	if err != nil {
+		return err
+	}
+
+	err = ei.updateCollectionsHoldersInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.updateCollectionsStatsInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.removeCustomEventsInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.updateAddressesActivityInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.removeBridgeTransfersInCaseOfRevert(header)
+	if err != nil {
+		return err
+	}
+
 	return ei.updateDelegatorsInCaseOfRevert(header, body)
 }
 
+func (ei *elasticProcessor) updateTxTracesInCaseOfRevert(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.TxTracesIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	txTracesQuery := ei.txTracesProc.PrepareTxTracesQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.TxTracesIndex, txTracesQuery)
+}
+
+func (ei *elasticProcessor) removeCustomEventsInCaseOfRevert(header coreData.HeaderHandler) error {
+	for _, index := range ei.customEventsIndices {
+		err := ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), index)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ei *elasticProcessor) updateAddressesActivityInCaseOfRevert(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.AddressActivityIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	activityQuery := ei.addressActivityProc.PrepareAddressesActivityQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.AddressActivityIndex, activityQuery)
+}
+
+func (ei *elasticProcessor) removeBridgeTransfersInCaseOfRevert(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.BridgeTransfersIndex) {
+		return nil
+	}
+
+	confirmationsQuery := ei.bridgeTransfersHandler.PrepareConfirmationsQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+	if confirmationsQuery != nil {
+		ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+		err := ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.BridgeTransfersIndex, confirmationsQuery)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.BridgeTransfersIndex)
+}
+
 func (ei *elasticProcessor) updateDelegatorsInCaseOfRevert(header coreData.HeaderHandler, body *block.Body) error {
 	// delegators index should be updated in case of revert only if the observer is in Metachain and the reverted block has miniblocks
 	isMeta := header.GetShardID() == core.MetachainShardId
@@ -425,9 +615,45 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 	miniBlocks := append(obh.BlockData.Body.MiniBlocks, obh.BlockData.IntraShardMiniBlocks...)
 	preparedResults := ei.transactionsProc.PrepareTransactionsForDatabase(miniBlocks, obh.Header, obh.TransactionPool, ei.isImportDB(), obh.NumberOfShards)
 	logsData := ei.logsAndEventsProc.ExtractDataFromLogs(obh.TransactionPool.Logs, preparedResults, headerTimestamp, obh.Header.GetShardID(), obh.NumberOfShards)
+	ei.abiDecoder.DecodeData(preparedResults, logsData, obh.AlteredAccounts)
+	ei.addressesFilter.FilterTransactionsData(preparedResults, logsData)
+	ei.secondaryAddressEncoder.EncodeTransactionsData(preparedResults, logsData)
+	alteredAccounts := ei.addressesFilter.FilterAlteredAccounts(obh.AlteredAccounts)
 
 	buffers := data.NewBufferSlice(ei.bulkRequestMaxSize)
-	err := ei.indexTransactions(preparedResults.Transactions, logsData.TxHashStatusInfo, obh.Header, buffers)
+	err := ei.prepareAndIndexContractCalls(preparedResults.Transactions, preparedResults.ScResults, logsData.DBEvents, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.prepareAndIndexTxTraces(preparedResults, logsData, headerTimestamp, obh.Header.GetShardID(), buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.prepareAndIndexTxStatusHistory(preparedResults, logsData, obh.BlockData.HeaderHash, headerTimestamp, obh.Header.GetShardID(), buffers)
+	if err != nil {
+		return err
+	}
+
+	usernames, err := ei.prepareAndIndexUsernames(preparedResults, headerTimestamp, obh.Header.GetShardID(), buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.prepareAndIndexGuardians(preparedResults, logsData.DBEvents, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	// the data fields are offloaded after the processors that parse them
+	payloads := ei.offloadPayloads(preparedResults, headerTimestamp)
+	err = ei.indexTransactions(preparedResults.Transactions, logsData.TxHashStatusInfo, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.payloadsOffloader.SerializePayloads(payloads, buffers, elasticIndexer.PayloadsIndex)
 	if err != nil {
 		return err
 	}
@@ -437,6 +663,16 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
+	err = ei.prepareAndIndexTransfers(preparedResults.Transactions, preparedResults.ScResults, logsData.DBEvents, obh.Header, obh.NumberOfShards, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.prepareAndIndexAddressesActivity(preparedResults.Transactions, preparedResults.ScResults, alteredAccounts, headerTimestamp, obh.Header.GetShardID(), buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.indexTransactionsFeeData(preparedResults.TxHashFee, buffers)
 	if err != nil {
 		return err
@@ -457,6 +693,11 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
+	err = ei.logsAndEventsProc.SerializeCustomEvents(logsData.CustomEvents, buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.indexScResults(preparedResults.ScResults, buffers)
 	if err != nil {
 		return err
@@ -468,7 +709,7 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 	}
 
 	tagsCount := tags.NewTagsCount()
-	err = ei.indexAlteredAccounts(headerTimestamp, logsData.NFTsDataUpdates, obh.AlteredAccounts, buffers, tagsCount, obh.Header.GetShardID())
+	err = ei.indexAlteredAccounts(headerTimestamp, logsData.NFTsDataUpdates, alteredAccounts, buffers, tagsCount, obh.Header.GetShardID())
 	if err != nil {
 		return err
 	}
@@ -488,11 +729,26 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
+	err = ei.prepareAndIndexProviders(logsData.Providers, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.prepareAndIndexNodes(logsData.Nodes, buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.indexNFTBurnInfo(logsData.TokensSupply, buffers, obh.ShardID)
 	if err != nil {
 		return err
 	}
 
+	err = ei.prepareAndIndexCollectionsStats(logsData.CollectionsStats, logsData.TokensSupply, headerTimestamp, buffers, obh.ShardID)
+	if err != nil {
+		return err
+	}
+
 	err = ei.prepareAndIndexRolesData(logsData.TokenRolesAndProperties, buffers, elasticIndexer.TokensIndex)
 	if err != nil {
 		return err
@@ -512,7 +768,33 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
-	return ei.doBulkRequests("", buffers.Buffers(), obh.ShardID)
+	err = ei.prepareAndIndexBridgeTransfers(preparedResults.ScResults, logsData.DBEvents, headerTimestamp, obh.Header.GetShardID(), buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.doBulkRequests("", buffers.Buffers(), obh.ShardID)
+	if err != nil {
+		return err
+	}
+
+	err = ei.markSupersededUsernames(usernames, obh.Header.GetShardID())
+	if err != nil {
+		return err
+	}
+
+	ei.dataPublisher.Publish(&data.IndexedBlockData{
+		HeaderHash:   hex.EncodeToString(obh.BlockData.HeaderHash),
+		Nonce:        obh.Header.GetNonce(),
+		ShardID:      obh.Header.GetShardID(),
+		Timestamp:    headerTimestamp,
+		Transactions: preparedResults.Transactions,
+		ScResults:    preparedResults.ScResults,
+		Events:       logsData.DBEvents,
+		Tokens:       logsData.TokensInfo,
+	})
+
+	return nil
 }
 
 func (ei *elasticProcessor) prepareAndIndexRolesData(tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties, buffSlice *data.BufferSlice, index string) error {
@@ -531,6 +813,22 @@ func (ei *elasticProcessor) prepareAndIndexDelegators(delegators map[string]*dat
 	return ei.logsAndEventsProc.SerializeDelegators(delegators, buffSlice, elasticIndexer.DelegatorsIndex)
 }
 
+func (ei *elasticProcessor) prepareAndIndexProviders(providers map[string]*data.StakingProvider, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.ProvidersIndex) {
+		return nil
+	}
+
+	return ei.logsAndEventsProc.SerializeProviders(providers, buffSlice, elasticIndexer.ProvidersIndex)
+}
+
+func (ei *elasticProcessor) prepareAndIndexNodes(nodes map[string]*data.StakingNode, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.NodesIndex) {
+		return nil
+	}
+
+	return ei.logsAndEventsProc.SerializeNodes(nodes, buffSlice, elasticIndexer.NodesIndex)
+}
+
 func (ei *elasticProcessor) indexTransactionsFeeData(txsHashFeeData map[string]*data.FeeData, buffSlice *data.BufferSlice) error {
 	if len(txsHashFeeData) == 0 {
 		return nil
@@ -603,6 +901,378 @@ func (ei *elasticProcessor) prepareAndIndexOperations(
 	return ei.operationsProc.SerializeSCRs(processedSCRs, buffSlice, elasticIndexer.OperationsIndex, header.GetShardID())
 }
 
+func (ei *elasticProcessor) prepareAndIndexTransfers(
+	txs []*data.Transaction,
+	scrs []*data.ScResult,
+	events []*data.LogEvent,
+	header coreData.HeaderHandler,
+	numOfShards uint32,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.TransfersIndex) {
+		return nil
+	}
+
+	transfers := ei.transfersProc.PrepareTransfers(txs, scrs, events, header.GetShardID(), numOfShards)
+
+	return ei.transfersProc.SerializeTransfers(transfers, buffSlice, elasticIndexer.TransfersIndex)
+}
+
+// prepareAndIndexContractCalls will index the edges of the call graph and will update the aggregated calls of the contracts
+func (ei *elasticProcessor) prepareAndIndexContractCalls(
+	txs []*data.Transaction,
+	scrs []*data.ScResult,
+	events []*data.LogEvent,
+	header coreData.HeaderHandler,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.ContractCallsIndex) {
+		return nil
+	}
+
+	calls := ei.contractCallsProc.PrepareContractCalls(txs, scrs, events, header.GetShardID())
+	err := ei.contractCallsProc.SerializeContractCalls(calls, buffSlice, elasticIndexer.ContractCallsIndex)
+	if err != nil {
+		return err
+	}
+
+	shouldSkipStats := !ei.isIndexEnabled(elasticIndexer.ContractCallStatsIndex) || len(calls) == 0
+	if shouldSkipStats {
+		return nil
+	}
+
+	stats := ei.contractCallsProc.PrepareContractCallsStats(calls, header.GetTimeStamp(), header.GetShardID())
+	existingCallers, err := ei.getContractCallers(stats, false)
+	if err != nil {
+		return err
+	}
+
+	ei.contractCallsProc.ComputeUniqueCallers(stats, existingCallers)
+
+	return ei.contractCallsProc.SerializeContractCallsStats(stats, buffSlice, elasticIndexer.ContractCallStatsIndex)
+}
+
+func (ei *elasticProcessor) getContractCallers(stats *data.ContractCallsStats, withSource bool) (*data.ResponseContractCallStats, error) {
+	callerIDs := make([]string, 0, len(stats.Callers))
+	for callerID := range stats.Callers {
+		callerIDs = append(callerIDs, callerID)
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, stats.ShardID))
+	existingCallers := &data.ResponseContractCallStats{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, callerIDs, elasticIndexer.ContractCallStatsIndex, withSource, existingCallers)
+
+	return existingCallers, err
+}
+
+// updateContractCallsStatsInCaseOfRevert will subtract the calls of the reverted block from the aggregated calls of the
+// contracts. The calls are read from the contract calls index, so it has to be called before they are removed.
+func (ei *elasticProcessor) updateContractCallsStatsInCaseOfRevert(header coreData.HeaderHandler) error {
+	shouldSkip := !ei.isIndexEnabled(elasticIndexer.ContractCallsIndex) || !ei.isIndexEnabled(elasticIndexer.ContractCallStatsIndex)
+	if shouldSkip {
+		return nil
+	}
+
+	calls := make([]*data.ContractCall, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			call := &data.ContractCall{}
+			err = json.Unmarshal(hit.Source, call)
+			if err != nil {
+				return err
+			}
+
+			call.ID = hit.ID
+			calls = append(calls, call)
+		}
+
+		return nil
+	}
+
+	shardID := header.GetShardID()
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.ScrollTopic, shardID))
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"shardID": {"query": %d,"operator": "AND"}}},{"match": {"timestamp": {"query": "%d","operator": "AND"}}}]}}}`, shardID, header.GetTimeStamp())
+	err := ei.elasticClient.DoScrollRequest(ctxWithValue, elasticIndexer.ContractCallsIndex, []byte(query), true, handlerFunc)
+	if err != nil || len(calls) == 0 {
+		return err
+	}
+
+	stats := ei.contractCallsProc.PrepareContractCallsStats(calls, header.GetTimeStamp(), shardID)
+	existingCallers, err := ei.getContractCallers(stats, true)
+	if err != nil {
+		return err
+	}
+
+	ei.contractCallsProc.ComputeRemovedCallers(stats, existingCallers)
+
+	buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
+	err = ei.contractCallsProc.SerializeContractCallsStatsInCaseOfRevert(stats, buffSlice, elasticIndexer.ContractCallStatsIndex)
+	if err != nil {
+		return err
+	}
+
+	return ei.doBulkRequests(elasticIndexer.ContractCallStatsIndex, buffSlice.Buffers(), shardID)
+}
+
+// the data fields are offloaded only if the payloads index is enabled, otherwise the full data would be lost
+func (ei *elasticProcessor) offloadPayloads(preparedResults *data.PreparedResults, timestamp uint64) map[string]*data.Payload {
+	if !ei.isIndexEnabled(elasticIndexer.PayloadsIndex) {
+		return make(map[string]*data.Payload)
+	}
+
+	return ei.payloadsOffloader.OffloadPayloads(preparedResults, timestamp)
+}
+
+func (ei *elasticProcessor) prepareAndIndexTxTraces(
+	preparedResults *data.PreparedResults,
+	logsData *data.PreparedLogsResults,
+	timestamp uint64,
+	shardID uint32,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.TxTracesIndex) {
+		return nil
+	}
+
+	traces := ei.txTracesProc.PrepareTxTraces(preparedResults, logsData, timestamp, shardID)
+
+	return ei.txTracesProc.SerializeTxTraces(traces, buffSlice, elasticIndexer.TxTracesIndex)
+}
+
+func (ei *elasticProcessor) prepareAndIndexTxStatusHistory(
+	preparedResults *data.PreparedResults,
+	logsData *data.PreparedLogsResults,
+	headerHash []byte,
+	timestamp uint64,
+	shardID uint32,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.TxStatusHistoryIndex) {
+		return nil
+	}
+
+	transitions := ei.txStatusHistoryProc.PrepareStatusTransitions(preparedResults, logsData, hex.EncodeToString(headerHash), timestamp, shardID)
+
+	return ei.txStatusHistoryProc.SerializeStatusTransitions(transitions, buffSlice, elasticIndexer.TxStatusHistoryIndex)
+}
+
+func (ei *elasticProcessor) prepareAndIndexUsernames(preparedResults *data.PreparedResults, timestamp uint64, shardID uint32, buffSlice *data.BufferSlice) ([]*data.Username, error) {
+	if !ei.isIndexEnabled(elasticIndexer.UsernamesIndex) {
+		return nil, nil
+	}
+
+	usernames := ei.usernamesProc.ExtractUsernames(preparedResults, timestamp, shardID)
+	return usernames, ei.usernamesProc.SerializeUsernames(usernames, buffSlice, elasticIndexer.UsernamesIndex)
+}
+
+// markSupersededUsernames marks the older usernames of the owners of the registered usernames with a single update by
+// query, which is done only after the bulk requests of the block succeeded
+func (ei *elasticProcessor) markSupersededUsernames(usernames []*data.Username, shardID uint32) error {
+	query := ei.usernamesProc.PrepareSupersededUsernamesQuery(usernames)
+	if query == nil {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, shardID))
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.UsernamesIndex, query)
+}
+
+// updateUsernamesInCaseOfRevert removes the registrations of the reverted block from the usernames registry and moves
+// back the usernames of the affected accounts
+func (ei *elasticProcessor) updateUsernamesInCaseOfRevert(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.UsernamesIndex) {
+		return nil
+	}
+
+	usernames := make([]*data.Username, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			username := &data.Username{}
+			err = json.Unmarshal(hit.Source, username)
+			if err != nil {
+				return err
+			}
+
+			usernames = append(usernames, username)
+		}
+
+		return nil
+	}
+
+	shardID := header.GetShardID()
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.ScrollTopic, shardID))
+	query := ei.usernamesProc.PrepareUsernamesQueryInCaseOfRevert(header.GetTimeStamp(), shardID)
+	err := ei.elasticClient.DoScrollRequest(ctxWithValue, elasticIndexer.UsernamesIndex, query, true, handlerFunc)
+	if err != nil || len(usernames) == 0 {
+		return err
+	}
+
+	accountsUsernames := ei.usernamesProc.RevertUsernames(usernames, header.GetTimeStamp(), shardID)
+	buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
+	err = ei.usernamesProc.SerializeRevertedUsernames(usernames, buffSlice, elasticIndexer.UsernamesIndex)
+	if err != nil {
+		return err
+	}
+
+	if ei.isIndexEnabled(elasticIndexer.AccountsIndex) {
+		err = ei.usernamesProc.SerializeAccountsUsernamesInCaseOfRevert(accountsUsernames, buffSlice, elasticIndexer.AccountsIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ei.doBulkRequests("", buffSlice.Buffers(), shardID)
+}
+
+func (ei *elasticProcessor) prepareAndIndexGuardians(
+	preparedResults *data.PreparedResults,
+	events []*data.LogEvent,
+	header coreData.HeaderHandler,
+	buffSlice *data.BufferSlice,
+) error {
+	isGuardiansIndexEnabled := ei.isIndexEnabled(elasticIndexer.GuardiansIndex)
+	isAccountsIndexEnabled := ei.isIndexEnabled(elasticIndexer.AccountsIndex)
+	if !isGuardiansIndexEnabled && !isAccountsIndexEnabled {
+		return nil
+	}
+
+	err := ei.promotePendingGuardians(header, isGuardiansIndexEnabled, isAccountsIndexEnabled)
+	if err != nil {
+		return err
+	}
+
+	changes := ei.guardiansProc.ExtractGuardianChanges(preparedResults, events, header.GetEpoch(), header.GetShardID())
+	if isGuardiansIndexEnabled {
+		err = ei.guardiansProc.SerializeGuardianChanges(changes, buffSlice, elasticIndexer.GuardiansIndex)
+		if err != nil {
+			return err
+		}
+	}
+	if !isAccountsIndexEnabled {
+		return nil
+	}
+
+	return ei.guardiansProc.SerializeAccountsGuardians(changes, buffSlice, elasticIndexer.AccountsIndex)
+}
+
+// promotePendingGuardians makes active, on the first block of an epoch, the pending guardians whose activation epoch
+// is reached, even if their accounts do not change the guardian state again
+func (ei *elasticProcessor) promotePendingGuardians(header coreData.HeaderHandler, isGuardiansIndexEnabled bool, isAccountsIndexEnabled bool) error {
+	if !header.IsStartOfEpochBlock() {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	if isGuardiansIndexEnabled {
+		query := ei.guardiansProc.PreparePendingGuardiansQuery(header.GetEpoch(), header.GetShardID())
+		err := ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.GuardiansIndex, query)
+		if err != nil {
+			return err
+		}
+	}
+	if !isAccountsIndexEnabled {
+		return nil
+	}
+
+	query := ei.guardiansProc.PrepareAccountsPendingGuardiansQuery(header.GetEpoch(), header.GetShardID())
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.AccountsIndex, query)
+}
+
+// indexTxStatusHistoryInCaseOfRevert records the removal of the reverted transactions and smart contract results, so the
+// history keeps the statuses that were indexed before the revert
+func (ei *elasticProcessor) indexTxStatusHistoryInCaseOfRevert(header coreData.HeaderHandler, txHashes []string, scrHashes []string) error {
+	if !ei.isIndexEnabled(elasticIndexer.TxStatusHistoryIndex) {
+		return nil
+	}
+
+	headerHash, err := ei.blockProc.ComputeHeaderHash(header)
+	if err != nil {
+		return err
+	}
+
+	transitions := ei.txStatusHistoryProc.PrepareRevertTransitions(txHashes, scrHashes, hex.EncodeToString(headerHash), header.GetTimeStamp(), header.GetShardID())
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
+	err = ei.txStatusHistoryProc.SerializeStatusTransitions(transitions, buffSlice, elasticIndexer.TxStatusHistoryIndex)
+	if err != nil {
+		return err
+	}
+
+	return ei.doBulkRequests(elasticIndexer.TxStatusHistoryIndex, buffSlice.Buffers(), header.GetShardID())
+}
+
+func (ei *elasticProcessor) prepareAndIndexBridgeTransfers(
+	scrs []*data.ScResult,
+	events []*data.LogEvent,
+	timestamp uint64,
+	shardID uint32,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.BridgeTransfersIndex) {
+		return nil
+	}
+
+	prepared := ei.bridgeTransfersHandler.PrepareBridgeTransfers(scrs, events, timestamp, shardID)
+	err := ei.bridgeTransfersHandler.SerializeBridgeTransfers(prepared, buffSlice, elasticIndexer.BridgeTransfersIndex)
+	if err != nil {
+		return err
+	}
+
+	return ei.confirmBridgeTransfers(prepared.Confirmations, shardID)
+}
+
+func (ei *elasticProcessor) confirmBridgeTransfers(confirmations []*data.BridgeTransferConfirmation, shardID uint32) error {
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, shardID))
+	for _, confirmation := range confirmations {
+		query, err := ei.bridgeTransfersHandler.PrepareConfirmationQuery(confirmation)
+		if err != nil {
+			return err
+		}
+		if query == nil {
+			continue
+		}
+
+		err = ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.BridgeTransfersIndex, query)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ei *elasticProcessor) prepareAndIndexAddressesActivity(
+	txs []*data.Transaction,
+	scrs []*data.ScResult,
+	coreAlteredAccounts map[string]*alteredAccount.AlteredAccount,
+	timestamp uint64,
+	shardID uint32,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.AddressActivityIndex) {
+		return nil
+	}
+
+	activities := ei.addressActivityProc.PrepareAddressesActivity(txs, scrs, coreAlteredAccounts, timestamp, shardID)
+
+	return ei.addressActivityProc.SerializeAddressesActivity(activities, buffSlice, elasticIndexer.AddressActivityIndex)
+}
+
 // SaveValidatorsRating will save validators rating
 func (ei *elasticProcessor) SaveValidatorsRating(ratingData *outport.ValidatorsRating) error {
 	if !ei.isIndexEnabled(elasticIndexer.RatingIndex) {
@@ -675,6 +1345,11 @@ func (ei *elasticProcessor) saveAccountsDCDT(
 		return err
 	}
 
+	err = ei.prepareAndIndexTokensHolders(accountsDCDTMap, buffSlice, timestamp, shardID)
+	if err != nil {
+		return err
+	}
+
 	err = ei.indexAccountsDCDT(accountsDCDTMap, updatesNFTsData, buffSlice)
 	if err != nil {
 		return err
@@ -701,6 +1376,69 @@ func (ei *elasticProcessor) addTokenTypeAndCurrentOwnerInAccountsDCDT(tokensData
 	return nil
 }
 
+func (ei *elasticProcessor) prepareAndIndexTokensHolders(accountsDCDTMap map[string]*data.AccountInfo, buffSlice *data.BufferSlice, timestamp uint64, shardID uint32) error {
+	// the previous balances are read from the accountsdcdt index
+	shouldSkipIndex := !ei.isIndexEnabled(elasticIndexer.TokensIndex) || !ei.isIndexEnabled(elasticIndexer.AccountsDCDTIndex) || len(accountsDCDTMap) == 0
+	if shouldSkipIndex {
+		return nil
+	}
+
+	ids := ei.tokenHoldersProc.GetAccountsDCDTIDs(accountsDCDTMap)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	indexedAccounts := &data.ResponseAccountsDCDT{}
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, ids, elasticIndexer.AccountsDCDTIndex, true, indexedAccounts)
+	if err != nil {
+		return err
+	}
+
+	tokensHolders := ei.tokenHoldersProc.PrepareTokensHolders(accountsDCDTMap, indexedAccounts)
+	err = ei.tokenHoldersProc.SerializeTokensHolders(tokensHolders, buffSlice, elasticIndexer.TokensIndex)
+	if err != nil {
+		return err
+	}
+
+	collectionsChanges := ei.tokenHoldersProc.PrepareCollectionsAccountsChanges(accountsDCDTMap, indexedAccounts)
+	if len(collectionsChanges) == 0 {
+		return nil
+	}
+
+	collectionsHeld := &data.ResponseCollectionsHeld{}
+	query := ei.tokenHoldersProc.PrepareCollectionsHeldQuery(collectionsChanges)
+	err = ei.elasticClient.DoSearchRequest(ctxWithValue, elasticIndexer.AccountsDCDTIndex, query, collectionsHeld)
+	if err != nil {
+		return err
+	}
+
+	collectionsHolders := ei.tokenHoldersProc.PrepareCollectionsHolders(collectionsChanges, collectionsHeld, timestamp, shardID)
+
+	return ei.tokenHoldersProc.SerializeCollectionsHolders(collectionsHolders, buffSlice, elasticIndexer.TokensIndex)
+}
+
+func (ei *elasticProcessor) updateCollectionsHoldersInCaseOfRevert(header coreData.HeaderHandler) error {
+	shouldSkip := !ei.isIndexEnabled(elasticIndexer.TokensIndex) || !ei.isIndexEnabled(elasticIndexer.AccountsDCDTIndex)
+	if shouldSkip {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	holdersQuery := ei.tokenHoldersProc.PrepareCollectionsHoldersQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.TokensIndex, holdersQuery)
+}
+
+func (ei *elasticProcessor) updateCollectionsStatsInCaseOfRevert(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.TokensIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	statsQuery := ei.logsAndEventsProc.PrepareCollectionsStatsQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.TokensIndex, statsQuery)
+}
+
 func (ei *elasticProcessor) prepareAndIndexTagsCount(tagsCount data.CountTags, buffSlice *data.BufferSlice) error {
 	shouldSkipIndex := !ei.isIndexEnabled(elasticIndexer.TagsIndex) || tagsCount.Len() == 0
 	if shouldSkipIndex {
@@ -761,12 +1499,43 @@ func (ei *elasticProcessor) indexNFTBurnInfo(tokensData data.TokensHandler, buff
 	return ei.logsAndEventsProc.SerializeSupplyData(tokensData, buffSlice, elasticIndexer.TokensIndex)
 }
 
+func (ei *elasticProcessor) prepareAndIndexCollectionsStats(
+	collectionsStats map[string]*data.CollectionStats,
+	tokensSupply data.TokensHandler,
+	timestamp uint64,
+	buffSlice *data.BufferSlice,
+	shardID uint32,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.TokensIndex) {
+		return nil
+	}
+	if collectionsStats == nil {
+		collectionsStats = make(map[string]*data.CollectionStats)
+	}
+
+	// the documents of the burned NFTs are read before they are deleted by the bulk request
+	burnedNFTs := ei.logsAndEventsProc.GetBurnedNFTsIdentifiers(tokensSupply)
+	if len(burnedNFTs) > 0 {
+		responseTokens := &data.ResponseTokenInfo{}
+		ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+		err := ei.elasticClient.DoMultiGet(ctxWithValue, burnedNFTs, elasticIndexer.TokensIndex, true, responseTokens)
+		if err != nil {
+			return err
+		}
+
+		ei.logsAndEventsProc.AddBurnedNFTsInCollectionsStats(responseTokens, collectionsStats, timestamp, shardID)
+	}
+
+	return ei.logsAndEventsProc.SerializeCollectionsStats(collectionsStats, buffSlice, elasticIndexer.TokensIndex)
+}
+
 // SaveAccounts will prepare and save information about provided accounts in elasticsearch server
 func (ei *elasticProcessor) SaveAccounts(accountsData *outport.Accounts) error {
 	buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
 
-	accounts := make([]*data.Account, 0, len(accountsData.AlteredAccounts))
-	for _, account := range accountsData.AlteredAccounts {
+	alteredAccounts := ei.addressesFilter.FilterAlteredAccounts(accountsData.AlteredAccounts)
+	accounts := make([]*data.Account, 0, len(alteredAccounts))
+	for _, account := range alteredAccounts {
 		accounts = append(accounts, &data.Account{
 			UserAccount: account,
 			IsSender:    false,
@@ -791,6 +1560,8 @@ func (ei *elasticProcessor) indexAccounts(accountsMap map[string]*data.AccountIn
 		return nil
 	}
 
+	ei.secondaryAddressEncoder.EncodeAccounts(accountsMap)
+
 	return ei.serializeAndIndexAccounts(accountsMap, index, buffSlice)
 }
 
@@ -3,6 +3,7 @@ package elasticproc
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
@@ -18,29 +19,48 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/accounts"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/activity"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/block"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/contractcalls"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/guardians"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/holders"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/miniblocks"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/operations"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/statistics"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/tags"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transfers"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/txstatushistory"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/txtraces"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/usernames"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/validators"
 )
 
 func newElasticsearchProcessor(elasticsearchWriter DatabaseClientHandler, arguments *ArgElasticProcessor) *elasticProcessor {
 	return &elasticProcessor{
-		elasticClient:      elasticsearchWriter,
-		enabledIndexes:     arguments.EnabledIndexes,
-		blockProc:          arguments.BlockProc,
-		transactionsProc:   arguments.TransactionsProc,
-		miniblocksProc:     arguments.MiniblocksProc,
-		accountsProc:       arguments.AccountsProc,
-		validatorsProc:     arguments.ValidatorsProc,
-		statisticsProc:     arguments.StatisticsProc,
-		logsAndEventsProc:  arguments.LogsAndEventsProc,
-		indexTokensHandler: arguments.IndexTokensHandler,
+		elasticClient:           elasticsearchWriter,
+		enabledIndexes:          arguments.EnabledIndexes,
+		blockProc:               arguments.BlockProc,
+		transactionsProc:        arguments.TransactionsProc,
+		miniblocksProc:          arguments.MiniblocksProc,
+		accountsProc:            arguments.AccountsProc,
+		validatorsProc:          arguments.ValidatorsProc,
+		statisticsProc:          arguments.StatisticsProc,
+		logsAndEventsProc:       arguments.LogsAndEventsProc,
+		indexTokensHandler:      arguments.IndexTokensHandler,
+		dataPublisher:           arguments.DataPublisher,
+		abiDecoder:              arguments.AbiDecoder,
+		secondaryAddressEncoder: arguments.SecondaryAddressEncoder,
+		addressesFilter:         arguments.AddressesFilter,
+		payloadsOffloader:       arguments.PayloadsOffloader,
+		txStatusHistoryProc:     arguments.TxStatusHistoryProc,
+		usernamesProc:           arguments.UsernamesProc,
+		guardiansProc:           arguments.GuardiansProc,
+		customEventsIndices:     arguments.CustomEventsIndices,
+		contractCallsProc:       arguments.ContractCallsProc,
+		tokenHoldersProc:        arguments.TokenHoldersProc,
 	}
 }
 
@@ -67,29 +87,56 @@ func createMockElasticProcessorArgs() *ArgElasticProcessor {
 	bp, _ := block.NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
 	mp, _ := miniblocks.NewMiniblocksProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
 	vp, _ := validators.NewValidatorsProcessor(mock.NewPubkeyConverterMock(32), 0)
+	customEventsRegistry, _ := logsevents.NewCustomEventsRegistry(logsevents.ArgsCustomEventsRegistry{PubKeyConverter: &mock.PubkeyConverterMock{}})
 	args := logsevents.ArgsLogsAndEventsProcessor{
-		PubKeyConverter:  &mock.PubkeyConverterMock{},
-		Marshalizer:      &mock.MarshalizerMock{},
-		BalanceConverter: balanceConverter,
-		Hasher:           &mock.HasherMock{},
+		PubKeyConverter:          &mock.PubkeyConverterMock{},
+		ValidatorPubKeyConverter: &mock.PubkeyConverterMock{},
+		Marshalizer:              &mock.MarshalizerMock{},
+		BalanceConverter:         balanceConverter,
+		Hasher:                   &mock.HasherMock{},
+		CustomEventsRegistry:     customEventsRegistry,
+		EventsProcessorsCreator:  logsevents.NewEventsProcessorsFactory(),
 	}
 	lp, _ := logsevents.NewLogsAndEventsProcessor(args)
 	op, _ := operations.NewOperationsProcessor()
+	tp, _ := transfers.NewTransfersProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+	ccp, _ := contractcalls.NewContractCallsProcessor(&mock.PubkeyConverterMock{})
+	ttp, _ := txtraces.NewTxTracesProcessor()
+	tshp, _ := txstatushistory.NewTxStatusHistoryProcessor()
+	up, _ := usernames.NewUsernamesProcessor()
+	gp, _ := guardians.NewGuardiansProcessor(&mock.PubkeyConverterMock{}, 20)
+	aap, _ := activity.NewAddressActivityProcessor()
+	thp, _ := holders.NewTokenHoldersProcessor(balanceConverter)
 
 	return &ArgElasticProcessor{
 		DBClient: &mock.DatabaseWriterStub{},
 		EnabledIndexes: map[string]struct{}{
 			dataindexer.BlockIndex: {}, dataindexer.TransactionsIndex: {}, dataindexer.MiniblocksIndex: {}, dataindexer.ValidatorsIndex: {}, dataindexer.RoundsIndex: {}, dataindexer.AccountsIndex: {}, dataindexer.RatingIndex: {}, dataindexer.AccountsHistoryIndex: {},
 		},
-		ValidatorsProc:     vp,
-		StatisticsProc:     statistics.NewStatisticsProcessor(),
-		TransactionsProc:   &mock.DBTransactionProcessorStub{},
-		MiniblocksProc:     mp,
-		AccountsProc:       acp,
-		BlockProc:          bp,
-		LogsAndEventsProc:  lp,
-		OperationsProc:     op,
-		IndexTokensHandler: &IndexTokenHandlerMock{},
+		SupportedIndexes:        GetAllIndexes(),
+		ValidatorsProc:          vp,
+		StatisticsProc:          statistics.NewStatisticsProcessor(),
+		TransactionsProc:        &mock.DBTransactionProcessorStub{},
+		MiniblocksProc:          mp,
+		AccountsProc:            acp,
+		BlockProc:               bp,
+		LogsAndEventsProc:       lp,
+		OperationsProc:          op,
+		TransfersProc:           tp,
+		ContractCallsProc:       ccp,
+		TxTracesProc:            ttp,
+		TxStatusHistoryProc:     tshp,
+		UsernamesProc:           up,
+		GuardiansProc:           gp,
+		AddressActivityProc:     aap,
+		TokenHoldersProc:        thp,
+		IndexTokensHandler:      &IndexTokenHandlerMock{},
+		BridgeTransfersHandler:  &BridgeTransfersHandlerMock{},
+		DataPublisher:           &mock.DataPublisherStub{},
+		AbiDecoder:              &mock.AbiDecoderStub{},
+		SecondaryAddressEncoder: &mock.SecondaryAddressEncoderStub{},
+		AddressesFilter:         &mock.AddressesFilterStub{},
+		PayloadsOffloader:       &mock.PayloadsOffloaderStub{},
 	}
 }
 
@@ -140,6 +187,15 @@ func TestNewElasticProcessor(t *testing.T) {
 			},
 			exErr: dataindexer.ErrNilEnabledIndexesMap,
 		},
+		{
+			name: "EmptySupportedIndexes",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.SupportedIndexes = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrEmptySupportedIndexes,
+		},
 		{
 			name: "NilDatabaseClient",
 			args: func() *ArgElasticProcessor {
@@ -203,6 +259,132 @@ func TestNewElasticProcessor(t *testing.T) {
 			},
 			exErr: dataindexer.ErrNilTransactionsHandler,
 		},
+		{
+			name: "NilDataPublisher",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.DataPublisher = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilDataPublisher,
+		},
+		{
+			name: "NilAbiDecoder",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.AbiDecoder = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilAbiDecoder,
+		},
+		{
+			name: "NilSecondaryAddressEncoder",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.SecondaryAddressEncoder = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilSecondaryAddressEncoder,
+		},
+		{
+			name: "NilAddressesFilter",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.AddressesFilter = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilAddressesFilter,
+		},
+		{
+			name: "NilPayloadsOffloader",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.PayloadsOffloader = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilPayloadsOffloader,
+		},
+		{
+			name: "NilBridgeTransfersHandler",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.BridgeTransfersHandler = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilBridgeTransfersHandler,
+		},
+		{
+			name: "NilTransfersProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.TransfersProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilTransfersHandler,
+		},
+		{
+			name: "NilContractCallsProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.ContractCallsProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilContractCallsHandler,
+		},
+		{
+			name: "NilTxTracesProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.TxTracesProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilTxTracesHandler,
+		},
+		{
+			name: "NilTxStatusHistoryProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.TxStatusHistoryProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilTxStatusHistoryHandler,
+		},
+		{
+			name: "NilUsernamesProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.UsernamesProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilUsernamesHandler,
+		},
+		{
+			name: "NilGuardiansProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.GuardiansProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilGuardiansHandler,
+		},
+		{
+			name: "NilAddressActivityProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.AddressActivityProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilAddressActivityHandler,
+		},
+		{
+			name: "NilTokenHoldersProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.TokenHoldersProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilTokenHoldersHandler,
+		},
 		{
 			name: "InitError",
 			args: func() *ArgElasticProcessor {
@@ -468,8 +650,10 @@ func TestElasticProcessor_RemoveTransactions(t *testing.T) {
 	dbWriter := &mock.DatabaseWriterStub{
 		DoQueryRemoveCalled: func(index string, body *bytes.Buffer) error {
 			bodyStr := body.String()
-			require.Contains(t, []string{dataindexer.TransactionsIndex, dataindexer.OperationsIndex, dataindexer.LogsIndex, dataindexer.EventsIndex}, index)
-			if index != dataindexer.EventsIndex {
+			require.Contains(t, []string{dataindexer.TransactionsIndex, dataindexer.OperationsIndex, dataindexer.LogsIndex, dataindexer.EventsIndex, dataindexer.TransfersIndex, dataindexer.ContractCallsIndex,
+				dataindexer.TxTracesIndex}, index)
+			isRemovedByTimestamp := index == dataindexer.EventsIndex || index == dataindexer.TransfersIndex || index == dataindexer.ContractCallsIndex
+			if !isRemovedByTimestamp {
 				require.True(t, strings.Contains(bodyStr, expectedHashes[0]))
 				require.True(t, strings.Contains(bodyStr, expectedHashes[1]))
 				called = true
@@ -515,6 +699,251 @@ func TestElasticProcessor_RemoveTransactions(t *testing.T) {
 	require.True(t, called)
 }
 
+func TestElasticProcessor_RemoveTransactionsRecordsRevertInStatusHistory(t *testing.T) {
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.TxStatusHistoryIndex: {}}
+
+	bulkBody := ""
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, dataindexer.TxStatusHistoryIndex, index)
+			bulkBody += buff.String()
+			return nil
+		},
+	}
+
+	args := &transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+	}
+	txDbProc, _ := transactions.NewTransactionsProcessor(args)
+	arguments.TransactionsProc = txDbProc
+
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	header := &dataBlock.Header{ShardID: 1, TimeStamp: 5000, MiniBlockHeaders: []dataBlock.MiniBlockHeader{{}}}
+	blk := &dataBlock.Body{
+		MiniBlocks: dataBlock.MiniBlockSlice{
+			{
+				TxHashes:        [][]byte{[]byte("tx1")},
+				Type:            dataBlock.TxBlock,
+				SenderShardID:   0,
+				ReceiverShardID: 1,
+			},
+		},
+	}
+
+	err := elasticSearchProc.RemoveTransactions(header, blk)
+	require.Nil(t, err)
+	require.Contains(t, bulkBody, `{"txHash":"`+hex.EncodeToString([]byte("tx1"))+`","type":"transaction","cause":"revert","shardID":1,"timestamp":5000}`)
+}
+
+func TestElasticProcessor_RemoveTransactionsRemovesCustomEvents(t *testing.T) {
+	arguments := createMockElasticProcessorArgs()
+	arguments.CustomEventsIndices = []string{"swaps", "deposits"}
+
+	removedIndices := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoQueryRemoveCalled: func(index string, body *bytes.Buffer) error {
+			if index == "swaps" || index == "deposits" {
+				require.Equal(t,
+					`{"query": {"bool": {"must": [{"match": {"shardID": {"query": 1,"operator": "AND"}}},{"match": {"timestamp": {"query": "5000","operator": "AND"}}}]}}}`,
+					body.String(),
+				)
+				removedIndices = append(removedIndices, index)
+			}
+
+			return nil
+		},
+	}
+
+	args := &transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+	}
+	txDbProc, _ := transactions.NewTransactionsProcessor(args)
+	arguments.TransactionsProc = txDbProc
+
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	header := &dataBlock.Header{ShardID: 1, TimeStamp: 5000}
+	err := elasticSearchProc.RemoveTransactions(header, &dataBlock.Body{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"swaps", "deposits"}, removedIndices)
+}
+
+func TestElasticProcessor_RemoveTransactionsUpdatesContractCallsStats(t *testing.T) {
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.ContractCallsIndex: {}, dataindexer.ContractCallStatsIndex: {}}
+
+	bulkBody := ""
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.ContractCallsIndex, index)
+			require.Equal(t, `{"query": {"bool": {"must": [{"match": {"shardID": {"query": 1,"operator": "AND"}}},{"match": {"timestamp": {"query": "5000","operator": "AND"}}}]}}}`, string(body))
+			return handlerFunc([]byte(`{"hits":{"hits":[{"_id":"scr1","_source":{"caller":"alice","callee":"contract","success":true,"shardID":1,"timestamp":5000}}]}}`))
+		},
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Equal(t, dataindexer.ContractCallStatsIndex, index)
+			require.True(t, withSource)
+			return json.Unmarshal([]byte(`{"docs":[{"found":true,"_id":"`+ids[0]+`","_source":{"contract":"contract","caller":"alice","inboundCalls":1}}]}`), response)
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, dataindexer.ContractCallStatsIndex, index)
+			bulkBody += buff.String()
+			return nil
+		},
+	}
+
+	args := &transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+	}
+	txDbProc, _ := transactions.NewTransactionsProcessor(args)
+	arguments.TransactionsProc = txDbProc
+
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	header := &dataBlock.Header{ShardID: 1, TimeStamp: 5000}
+	err := elasticSearchProc.RemoveTransactions(header, &dataBlock.Body{})
+	require.Nil(t, err)
+	require.Contains(t, bulkBody, `"params": {"stats": {"contract":"contract","inboundCalls":1,"uniqueCallers":1,"failures":0,"timestamp":5000}, "shardID": "1", "timestamp": 5000}`)
+	require.Contains(t, bulkBody, `"params": {"stats": {"contract":"contract","caller":"alice","inboundCalls":1,"uniqueCallers":0,"failures":0,"timestamp":5000}, "shardID": "1", "timestamp": 5000}`)
+}
+
+func TestElasticProcessor_RemoveTransactionsRevertsCollectionsStats(t *testing.T) {
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.TokensIndex: {}}
+
+	updatedQueries := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			require.Equal(t, dataindexer.TokensIndex, index)
+			updatedQueries = append(updatedQueries, buff.String())
+			return nil
+		},
+	}
+
+	args := &transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+	}
+	txDbProc, _ := transactions.NewTransactionsProcessor(args)
+	arguments.TransactionsProc = txDbProc
+
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	header := &dataBlock.Header{ShardID: 1, TimeStamp: 5000}
+	err := elasticSearchProc.RemoveTransactions(header, &dataBlock.Body{})
+	require.Nil(t, err)
+	require.Len(t, updatedQueries, 1)
+	require.True(t, strings.HasPrefix(updatedQueries[0], `{"query": {"bool": {"must": [{"match": {"collectionStats.statsChanges.shardID": {"query": 1,"operator": "AND"}}},{"match": {"collectionStats.statsChanges.timestamp": {"query": "5000","operator": "AND"}}}]}},`))
+}
+
+func TestElasticProcessor_SaveTransactionsPromotesPendingGuardiansOnEpochStart(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.GuardiansIndex: {}, dataindexer.AccountsIndex: {}}
+	arguments.TransactionsProc = &mock.DBTransactionProcessorStub{
+		PrepareTransactionsForDatabaseCalled: func(mbs []*dataBlock.MiniBlock, header coreData.HeaderHandler, pool *outport.TransactionPool) *data.PreparedResults {
+			return &data.PreparedResults{}
+		},
+	}
+
+	updatedIndexes := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			require.Contains(t, buff.String(), `"params": {"epoch": 7}`)
+			updatedIndexes = append(updatedIndexes, index)
+			return nil
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	obh := createEmptyOutportBlockWithHeader()
+	obh.Header = &dataBlock.Header{Nonce: 1, Epoch: 7, ShardID: 1}
+	err := elasticSearchProc.SaveTransactions(obh)
+	require.Nil(t, err)
+	require.Empty(t, updatedIndexes)
+
+	obh.Header = &dataBlock.Header{Nonce: 2, Epoch: 7, ShardID: 1, EpochStartMetaHash: []byte("metaHash")}
+	err = elasticSearchProc.SaveTransactions(obh)
+	require.Nil(t, err)
+	require.Equal(t, []string{dataindexer.GuardiansIndex, dataindexer.AccountsIndex}, updatedIndexes)
+}
+
+func TestElasticProcessor_RemoveTransactionsRevertsUsernames(t *testing.T) {
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.UsernamesIndex: {}, dataindexer.AccountsIndex: {}}
+
+	bulkBody := ""
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.UsernamesIndex, index)
+			require.True(t, withSource)
+			return handlerFunc([]byte(`{"hits":{"hits":[` +
+				`{"_id":"alice.drt","_source":{"userName":"alice.drt","address":"drt1alice","txHash":"tx2","shardID":1,"timestamp":5000,"history":[{"address":"drt1alice","txHash":"tx2","shardID":1,"timestamp":5000}]}},` +
+				`{"_id":"old.drt","_source":{"userName":"old.drt","address":"drt1alice","txHash":"tx1","shardID":1,"timestamp":4000,"supersededBy":"alice.drt","supersededTimestamp":5000,"history":[{"address":"drt1alice","txHash":"tx1","shardID":1,"timestamp":4000}]}}]}}`))
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkBody += buff.String()
+			return nil
+		},
+	}
+
+	args := &transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+	}
+	txDbProc, _ := transactions.NewTransactionsProcessor(args)
+	arguments.TransactionsProc = txDbProc
+
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	header := &dataBlock.Header{ShardID: 1, TimeStamp: 5000}
+	err := elasticSearchProc.RemoveTransactions(header, &dataBlock.Body{})
+	require.Nil(t, err)
+	require.Contains(t, bulkBody, `{ "delete" : { "_index": "usernames", "_id" : "alice.drt" } }`)
+	require.Contains(t, bulkBody, `{"userName":"old.drt","address":"drt1alice","txHash":"tx1","shardID":1,"timestamp":4000,"history":`)
+	require.Contains(t, bulkBody, `"params": {"userName": "old.drt"}`)
+}
+
+func TestElasticProcessor_RemoveTransactionsUpdatesCollectionsHolders(t *testing.T) {
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.TokensIndex: {}, dataindexer.AccountsDCDTIndex: {}}
+
+	called := false
+	dbWriter := &mock.DatabaseWriterStub{
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			require.Equal(t, dataindexer.TokensIndex, index)
+			require.Contains(t, buff.String(), `"params": {"shardID": 1, "timestamp": 5000}`)
+			called = true
+			return nil
+		},
+	}
+
+	args := &transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+	}
+	txDbProc, _ := transactions.NewTransactionsProcessor(args)
+	arguments.TransactionsProc = txDbProc
+
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	header := &dataBlock.Header{ShardID: 1, TimeStamp: 5000}
+	err := elasticSearchProc.RemoveTransactions(header, &dataBlock.Body{})
+	require.Nil(t, err)
+	require.True(t, called)
+}
+
 func TestElasticProcessor_IndexEpochInfoData(t *testing.T) {
 	called := false
 	arguments := createMockElasticProcessorArgs()
@@ -570,6 +999,161 @@ func TestElasticProcessor_SaveTransactionNoDataShouldNotDoRequest(t *testing.T)
 	require.False(t, called)
 }
 
+func TestElasticProcessor_SaveTransactionsShouldPublishIndexedData(t *testing.T) {
+	t.Parallel()
+
+	txs := []*data.Transaction{{Hash: "tx1"}}
+	arguments := createMockElasticProcessorArgs()
+	arguments.TransactionsProc = &mock.DBTransactionProcessorStub{
+		PrepareTransactionsForDatabaseCalled: func(mbs []*dataBlock.MiniBlock, header coreData.HeaderHandler, pool *outport.TransactionPool) *data.PreparedResults {
+			return &data.PreparedResults{
+				Transactions: txs,
+				ScResults:    []*data.ScResult{{Hash: "scr1"}},
+			}
+		},
+		SerializeScResultsCalled: func(scrs []*data.ScResult, buffSlice *data.BufferSlice, _ string) error {
+			return buffSlice.PutData([]byte("meta"), []byte("scr"))
+		},
+	}
+
+	localErr := errors.New("local error")
+	bulkErr := localErr
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return bulkErr
+		},
+	}
+
+	var publishedData *data.IndexedBlockData
+	arguments.DataPublisher = &mock.DataPublisherStub{
+		PublishCalled: func(indexedData *data.IndexedBlockData) {
+			publishedData = indexedData
+		},
+	}
+
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+	elasticSearchProc.enabledIndexes[dataindexer.ScResultsIndex] = struct{}{}
+	outportBlock := createEmptyOutportBlockWithHeader()
+	outportBlock.BlockData.HeaderHash = []byte("hash")
+
+	err := elasticSearchProc.SaveTransactions(outportBlock)
+	require.Equal(t, localErr, err)
+	require.Nil(t, publishedData)
+
+	bulkErr = nil
+	err = elasticSearchProc.SaveTransactions(outportBlock)
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("hash")), publishedData.HeaderHash)
+	require.Equal(t, uint64(1), publishedData.Nonce)
+	require.Equal(t, txs, publishedData.Transactions)
+}
+
+func TestElasticProcessor_SaveTransactionsOffloadsPayloadsOnlyIfIndexEnabled(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.TransactionsProc = &mock.DBTransactionProcessorStub{
+		PrepareTransactionsForDatabaseCalled: func(mbs []*dataBlock.MiniBlock, header coreData.HeaderHandler, pool *outport.TransactionPool) *data.PreparedResults {
+			return &data.PreparedResults{Transactions: []*data.Transaction{{Hash: "tx1"}}}
+		},
+	}
+	numOffloadCalls := 0
+	serializedPayloads := 0
+	arguments.PayloadsOffloader = &mock.PayloadsOffloaderStub{
+		OffloadPayloadsCalled: func(preparedResults *data.PreparedResults, timestamp uint64) map[string]*data.Payload {
+			numOffloadCalls++
+			return map[string]*data.Payload{"aa": {Hash: "aa"}}
+		},
+		SerializePayloadsCalled: func(payloads map[string]*data.Payload, buffSlice *data.BufferSlice, index string) error {
+			require.Equal(t, dataindexer.PayloadsIndex, index)
+			serializedPayloads += len(payloads)
+			return nil
+		},
+	}
+
+	elasticSearchProc := newElasticsearchProcessor(&mock.DatabaseWriterStub{}, arguments)
+	err := elasticSearchProc.SaveTransactions(createEmptyOutportBlockWithHeader())
+	require.Nil(t, err)
+	require.Equal(t, 0, numOffloadCalls)
+	require.Equal(t, 0, serializedPayloads)
+
+	elasticSearchProc.enabledIndexes[dataindexer.PayloadsIndex] = struct{}{}
+	err = elasticSearchProc.SaveTransactions(createEmptyOutportBlockWithHeader())
+	require.Nil(t, err)
+	require.Equal(t, 1, numOffloadCalls)
+	require.Equal(t, 1, serializedPayloads)
+}
+
+func TestElasticProcessor_SaveTransactionsOffloadsPayloadsAfterTheDataIsParsed(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.TransactionsProc = &mock.DBTransactionProcessorStub{
+		PrepareTransactionsForDatabaseCalled: func(mbs []*dataBlock.MiniBlock, header coreData.HeaderHandler, pool *outport.TransactionPool) *data.PreparedResults {
+			return &data.PreparedResults{Transactions: []*data.Transaction{{
+				Hash:     "tx1",
+				Receiver: "addr",
+				Status:   transaction.TxStatusSuccess.String(),
+				Data:     []byte("SetUserName@" + hex.EncodeToString([]byte("alice"))),
+			}}}
+		},
+	}
+	arguments.PayloadsOffloader = &mock.PayloadsOffloaderStub{
+		OffloadPayloadsCalled: func(preparedResults *data.PreparedResults, timestamp uint64) map[string]*data.Payload {
+			preparedResults.Transactions[0].Data = []byte("Set")
+			return map[string]*data.Payload{}
+		},
+	}
+	arguments.EnabledIndexes = map[string]struct{}{
+		dataindexer.PayloadsIndex:  {},
+		dataindexer.UsernamesIndex: {},
+	}
+
+	bulkBody := ""
+	elasticSearchProc := newElasticsearchProcessor(&mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkBody += buff.String()
+			return nil
+		},
+	}, arguments)
+	err := elasticSearchProc.SaveTransactions(createEmptyOutportBlockWithHeader())
+	require.Nil(t, err)
+	require.Contains(t, bulkBody, `"_index":"usernames"`)
+	require.Contains(t, bulkBody, "alice")
+}
+
+func TestElasticProcessor_SaveTransactionsMarksSupersededUsernamesAfterTheBulk(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.TransactionsProc = &mock.DBTransactionProcessorStub{
+		PrepareTransactionsForDatabaseCalled: func(mbs []*dataBlock.MiniBlock, header coreData.HeaderHandler, pool *outport.TransactionPool) *data.PreparedResults {
+			return &data.PreparedResults{Transactions: []*data.Transaction{
+				{Hash: "tx1", Receiver: "addr1", Status: transaction.TxStatusSuccess.String(), Data: []byte("SetUserName@" + hex.EncodeToString([]byte("alice")))},
+				{Hash: "tx2", Receiver: "addr2", Status: transaction.TxStatusSuccess.String(), Data: []byte("SetUserName@" + hex.EncodeToString([]byte("bob")))},
+			}}
+		},
+	}
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.UsernamesIndex: {}}
+
+	calls := make([]string, 0)
+	elasticSearchProc := newElasticsearchProcessor(&mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			calls = append(calls, "bulk")
+			return nil
+		},
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			require.Equal(t, dataindexer.UsernamesIndex, index)
+			require.Contains(t, buff.String(), `{"terms": {"address": ["addr1","addr2"]}}`)
+			calls = append(calls, "updateByQuery")
+			return nil
+		},
+	}, arguments)
+	err := elasticSearchProc.SaveTransactions(createEmptyOutportBlockWithHeader())
+	require.Nil(t, err)
+	require.Equal(t, []string{"bulk", "updateByQuery"}, calls)
+}
+
 func TestElasticProcessor_IndexAlteredAccounts(t *testing.T) {
 	called := false
 	dbWriter := &mock.DatabaseWriterStub{
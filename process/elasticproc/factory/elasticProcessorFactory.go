@@ -1,48 +1,97 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/hashing"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
 
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
-	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/accounts"
-	blockProc "github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/block"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/abi"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/activity"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/addresses"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/contractcalls"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/guardians"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/holders"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/miniblocks"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/operations"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/payloads"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/statistics"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/templatesAndPolicies"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transactions"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/transfers"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/txstatushistory"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/txtraces"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/usernames"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/validators"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/watchlist"
 )
 
+var log = logger.GetOrCreate("indexer/process/factory")
+
 // ElasticConfig holds the elastic search settings
 type ElasticConfig struct {
-	Enabled  bool
-	Url      string
-	UserName string
-	Password string
+	Enabled                     bool
+	Url                         string
+	UserName                    string
+	Password                    string
+	TokensCacheSize             int
+	TokensNotFoundCacheTTLInSec uint32
+	RetryIntervalInSec          uint32
+}
+
+// WatchedAddressesConfig holds the settings of the light indexing mode, where only the data that involves the
+// watched addresses is indexed
+type WatchedAddressesConfig struct {
+	Enabled             bool
+	Source              string
+	FilePath            string
+	Index               string
+	ReloadIntervalInSec uint32
+}
+
+// PayloadsOffloadingConfig holds the settings of the offloading of the large data fields into the payloads index
+type PayloadsOffloadingConfig struct {
+	Enabled             bool
+	ThresholdInBytes    int
+	PrefixLengthInBytes int
 }
 
 // ArgElasticProcessorFactory is struct that is used to store all components that are needed to create an elastic processor factory
 type ArgElasticProcessorFactory struct {
-	Marshalizer              marshal.Marshalizer
-	Hasher                   hashing.Hasher
-	AddressPubkeyConverter   core.PubkeyConverter
-	ValidatorPubkeyConverter core.PubkeyConverter
-	DBClient                 elasticproc.DatabaseClientHandler
-	EnabledIndexes           []string
-	Version                  string
-	Denomination             int
-	BulkRequestMaxSize       int
-	UseKibana                bool
-	ImportDB                 bool
-	TxHashExtractor          transactions.TxHashExtractor
-	RewardTxData             transactions.RewardTxDataHandler
-	IndexTokensHandler       elasticproc.IndexTokensHandler
+	Marshalizer                   marshal.Marshalizer
+	Hasher                        hashing.Hasher
+	AddressPubkeyConverter        core.PubkeyConverter
+	ValidatorPubkeyConverter      core.PubkeyConverter
+	SecondaryPubkeyConverter      core.PubkeyConverter
+	DBClient                      elasticproc.DatabaseClientHandler
+	EnabledIndexes                []string
+	SupportedIndexes              []string
+	Version                       string
+	Denomination                  int
+	GuardianActivationEpochsDelay uint32
+	BulkRequestMaxSize            int
+	UseKibana                     bool
+	ImportDB                      bool
+	TxHashExtractor               transactions.TxHashExtractor
+	RewardTxData                  transactions.RewardTxDataHandler
+	IndexTokensHandler            elasticproc.IndexTokensHandler
+	BridgeTransfersHandler        elasticproc.BridgeTransfersHandler
+	BlockProcessorCreator         elasticproc.BlockProcessorCreator
+	AccountsProcessorCreator      elasticproc.AccountsProcessorCreator
+	EventsProcessorsCreator       logsevents.EventsProcessorsCreator
+	DataPublisher                 elasticproc.DataPublisher
+	CustomEventsPlugins           []string
+	CustomEventRules              []logsevents.CustomEventRule
+	ContractAbis                  []abi.ContractAbi
+	WatchedAddresses              WatchedAddressesConfig
+	PayloadsOffloading            PayloadsOffloadingConfig
 }
 
 // CreateElasticProcessor will create a new instance of ElasticProcessor
@@ -57,12 +106,15 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 		return nil, err
 	}
 
-	enabledIndexesMap := make(map[string]struct{})
-	for _, index := range arguments.EnabledIndexes {
-		enabledIndexesMap[index] = struct{}{}
+	enabledIndexesMap, err := createEnabledIndexesMap(arguments.EnabledIndexes, arguments.SupportedIndexes)
+	if err != nil {
+		return nil, err
 	}
-	if len(enabledIndexesMap) == 0 {
-		return nil, dataindexer.ErrEmptyEnabledIndexes
+	if check.IfNil(arguments.BlockProcessorCreator) {
+		return nil, dataindexer.ErrNilBlockProcessorCreator
+	}
+	if check.IfNil(arguments.AccountsProcessorCreator) {
+		return nil, dataindexer.ErrNilAccountsProcessorCreator
 	}
 
 	balanceConverter, err := converters.NewBalanceConverter(arguments.Denomination)
@@ -70,15 +122,12 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 		return nil, err
 	}
 
-	accountsProc, err := accounts.NewAccountsProcessor(
-		arguments.AddressPubkeyConverter,
-		balanceConverter,
-	)
+	accountsProc, err := arguments.AccountsProcessorCreator.CreateAccountsProcessor(arguments.AddressPubkeyConverter, balanceConverter)
 	if err != nil {
 		return nil, err
 	}
 
-	blockProcHandler, err := blockProc.NewBlockProcessor(arguments.Hasher, arguments.Marshalizer)
+	blockProcHandler, err := arguments.BlockProcessorCreator.CreateBlockProcessor(arguments.Hasher, arguments.Marshalizer)
 	if err != nil {
 		return nil, err
 	}
@@ -107,11 +156,32 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 		return nil, err
 	}
 
+	customEventsRegistry, err := logsevents.NewCustomEventsRegistry(logsevents.ArgsCustomEventsRegistry{
+		PubKeyConverter: arguments.AddressPubkeyConverter,
+		EnabledPlugins:  arguments.CustomEventsPlugins,
+		Rules:           arguments.CustomEventRules,
+		ReservedIndices: elasticproc.GetAllIndexes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	customEventsIndices := customEventsRegistry.GetIndices()
+	supportedIndexes := make([]string, 0, len(arguments.SupportedIndexes)+len(customEventsIndices))
+	supportedIndexes = append(supportedIndexes, arguments.SupportedIndexes...)
+	for _, index := range customEventsIndices {
+		indexTemplates[index] = templatesAndPoliciesReader.GetCustomEventsTemplate(index)
+		supportedIndexes = append(supportedIndexes, index)
+	}
+
 	argsLogsAndEventsProc := logsevents.ArgsLogsAndEventsProcessor{
-		PubKeyConverter:  arguments.AddressPubkeyConverter,
-		Marshalizer:      arguments.Marshalizer,
-		BalanceConverter: balanceConverter,
-		Hasher:           arguments.Hasher,
+		PubKeyConverter:          arguments.AddressPubkeyConverter,
+		ValidatorPubKeyConverter: arguments.ValidatorPubkeyConverter,
+		Marshalizer:              arguments.Marshalizer,
+		BalanceConverter:         balanceConverter,
+		Hasher:                   arguments.Hasher,
+		CustomEventsRegistry:     customEventsRegistry,
+		EventsProcessorsCreator:  arguments.EventsProcessorsCreator,
 	}
 	logsAndEventsProc, err := logsevents.NewLogsAndEventsProcessor(argsLogsAndEventsProc)
 	if err != nil {
@@ -123,26 +193,178 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 		return nil, err
 	}
 
+	transfersProc, err := transfers.NewTransfersProcessor(arguments.AddressPubkeyConverter, balanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	contractCallsProc, err := contractcalls.NewContractCallsProcessor(arguments.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	txTracesProc, err := txtraces.NewTxTracesProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	txStatusHistoryProc, err := txstatushistory.NewTxStatusHistoryProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	usernamesProc, err := usernames.NewUsernamesProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	guardiansProc, err := guardians.NewGuardiansProcessor(arguments.AddressPubkeyConverter, arguments.GuardianActivationEpochsDelay)
+	if err != nil {
+		return nil, err
+	}
+
+	addressActivityProc, err := activity.NewAddressActivityProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenHoldersProc, err := holders.NewTokenHoldersProcessor(balanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	abiDecoder, err := createAbiDecoder(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	secondaryAddressEncoder, err := createSecondaryAddressEncoder(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	addressesFilter, err := createAddressesFilter(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadsOffloader, err := createPayloadsOffloader(arguments)
+	if err != nil {
+		return nil, err
+	}
+
 	args := &elasticproc.ArgElasticProcessor{
-		BulkRequestMaxSize: arguments.BulkRequestMaxSize,
-		TransactionsProc:   txsProc,
-		AccountsProc:       accountsProc,
-		BlockProc:          blockProcHandler,
-		MiniblocksProc:     miniblocksProc,
-		ValidatorsProc:     validatorsProc,
-		StatisticsProc:     generalInfoProc,
-		LogsAndEventsProc:  logsAndEventsProc,
-		DBClient:           arguments.DBClient,
-		EnabledIndexes:     enabledIndexesMap,
-		UseKibana:          arguments.UseKibana,
-		IndexTemplates:     indexTemplates,
-		IndexPolicies:      indexPolicies,
-		ExtraMappings:      extraMappings,
-		OperationsProc:     operationsProc,
-		ImportDB:           arguments.ImportDB,
-		Version:            arguments.Version,
-		IndexTokensHandler: arguments.IndexTokensHandler,
+		BulkRequestMaxSize:      arguments.BulkRequestMaxSize,
+		TransactionsProc:        txsProc,
+		AccountsProc:            accountsProc,
+		BlockProc:               blockProcHandler,
+		MiniblocksProc:          miniblocksProc,
+		ValidatorsProc:          validatorsProc,
+		StatisticsProc:          generalInfoProc,
+		LogsAndEventsProc:       logsAndEventsProc,
+		DBClient:                arguments.DBClient,
+		EnabledIndexes:          enabledIndexesMap,
+		SupportedIndexes:        supportedIndexes,
+		CustomEventsIndices:     customEventsIndices,
+		UseKibana:               arguments.UseKibana,
+		IndexTemplates:          indexTemplates,
+		IndexPolicies:           indexPolicies,
+		ExtraMappings:           extraMappings,
+		OperationsProc:          operationsProc,
+		TransfersProc:           transfersProc,
+		ContractCallsProc:       contractCallsProc,
+		TxTracesProc:            txTracesProc,
+		TxStatusHistoryProc:     txStatusHistoryProc,
+		UsernamesProc:           usernamesProc,
+		GuardiansProc:           guardiansProc,
+		AddressActivityProc:     addressActivityProc,
+		TokenHoldersProc:        tokenHoldersProc,
+		ImportDB:                arguments.ImportDB,
+		Version:                 arguments.Version,
+		IndexTokensHandler:      arguments.IndexTokensHandler,
+		BridgeTransfersHandler:  arguments.BridgeTransfersHandler,
+		DataPublisher:           arguments.DataPublisher,
+		AbiDecoder:              abiDecoder,
+		SecondaryAddressEncoder: secondaryAddressEncoder,
+		AddressesFilter:         addressesFilter,
+		PayloadsOffloader:       payloadsOffloader,
 	}
 
 	return elasticproc.NewElasticProcessor(args)
 }
+
+// createEnabledIndexesMap keeps only the enabled indexes that are supported by the chain flavour
+func createEnabledIndexesMap(enabledIndexes []string, supportedIndexes []string) (map[string]struct{}, error) {
+	if len(supportedIndexes) == 0 {
+		return nil, dataindexer.ErrEmptySupportedIndexes
+	}
+
+	supportedIndexesMap := make(map[string]struct{}, len(supportedIndexes))
+	for _, index := range supportedIndexes {
+		supportedIndexesMap[index] = struct{}{}
+	}
+
+	enabledIndexesMap := make(map[string]struct{})
+	for _, index := range enabledIndexes {
+		_, isSupported := supportedIndexesMap[index]
+		if !isSupported {
+			log.Warn("the index is not supported by the chain flavour and will not be indexed", "index", index)
+			continue
+		}
+
+		enabledIndexesMap[index] = struct{}{}
+	}
+	if len(enabledIndexesMap) == 0 {
+		return nil, dataindexer.ErrEmptyEnabledIndexes
+	}
+
+	return enabledIndexesMap, nil
+}
+
+func createSecondaryAddressEncoder(arguments ArgElasticProcessorFactory) (elasticproc.SecondaryAddressEncoderHandler, error) {
+	if check.IfNil(arguments.SecondaryPubkeyConverter) {
+		return addresses.NewDisabledSecondaryAddressEncoder(), nil
+	}
+
+	return addresses.NewSecondaryAddressEncoder(addresses.ArgsSecondaryAddressEncoder{
+		AddressPubkeyConverter:   arguments.AddressPubkeyConverter,
+		SecondaryPubkeyConverter: arguments.SecondaryPubkeyConverter,
+	})
+}
+
+func createAbiDecoder(arguments ArgElasticProcessorFactory) (elasticproc.AbiDecoderHandler, error) {
+	if len(arguments.ContractAbis) == 0 {
+		return abi.NewDisabledAbiDecoder(), nil
+	}
+
+	return abi.NewAbiDecoder(abi.ArgsAbiDecoder{
+		PubKeyConverter: arguments.AddressPubkeyConverter,
+		Contracts:       arguments.ContractAbis,
+	})
+}
+
+func createAddressesFilter(arguments ArgElasticProcessorFactory) (elasticproc.AddressesFilterHandler, error) {
+	if !arguments.WatchedAddresses.Enabled {
+		return watchlist.NewDisabledAddressesFilter(), nil
+	}
+
+	return watchlist.NewWatchedAddressesFilter(watchlist.ArgsWatchedAddressesFilter{
+		Source:         arguments.WatchedAddresses.Source,
+		FilePath:       arguments.WatchedAddresses.FilePath,
+		Index:          arguments.WatchedAddresses.Index,
+		DBClient:       arguments.DBClient,
+		ReloadInterval: time.Duration(arguments.WatchedAddresses.ReloadIntervalInSec) * time.Second,
+	})
+}
+
+func createPayloadsOffloader(arguments ArgElasticProcessorFactory) (elasticproc.PayloadsOffloaderHandler, error) {
+	if !arguments.PayloadsOffloading.Enabled {
+		return payloads.NewDisabledPayloadsOffloader(), nil
+	}
+
+	return payloads.NewPayloadsOffloader(payloads.ArgsPayloadsOffloader{
+		Hasher:           arguments.Hasher,
+		ThresholdInBytes: arguments.PayloadsOffloading.ThresholdInBytes,
+		PrefixLength:     arguments.PayloadsOffloading.PrefixLengthInBytes,
+	})
+}
@@ -1,31 +1,131 @@
 package factory
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	"github.com/stretchr/testify/require"
 
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/accounts"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/block"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/payloads"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/watchlist"
 )
 
-func TestCreateElasticProcessor(t *testing.T) {
-
-	args := ArgElasticProcessorFactory{
+func createMockArgElasticProcessorFactory() ArgElasticProcessorFactory {
+	return ArgElasticProcessorFactory{
 		Marshalizer:              &mock.MarshalizerMock{},
 		Hasher:                   &mock.HasherMock{},
 		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
 		ValidatorPubkeyConverter: &mock.PubkeyConverterMock{},
 		DBClient:                 &mock.DatabaseWriterStub{},
 		EnabledIndexes:           []string{"blocks"},
+		SupportedIndexes:         elasticproc.GetAllIndexes(),
 		Denomination:             1,
 		UseKibana:                false,
 		TxHashExtractor:          &mock.TxHashExtractorMock{},
 		RewardTxData:             &mock.RewardTxDataMock{},
 		IndexTokensHandler:       &elasticproc.IndexTokenHandlerMock{},
+		BridgeTransfersHandler:   &elasticproc.BridgeTransfersHandlerMock{},
+		BlockProcessorCreator: &elasticproc.BlockProcessorCreatorMock{
+			CreateBlockProcessorCalled: func(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (elasticproc.DBBlockHandler, error) {
+				return block.NewBlockProcessor(hasher, marshalizer)
+			},
+		},
+		AccountsProcessorCreator: &elasticproc.AccountsProcessorCreatorMock{
+			CreateAccountsProcessorCalled: func(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (elasticproc.DBAccountHandler, error) {
+				return accounts.NewAccountsProcessor(pubKeyConverter, balanceConverter)
+			},
+		},
+		EventsProcessorsCreator: logsevents.NewEventsProcessorsFactory(),
+		DataPublisher:           &mock.DataPublisherStub{},
+	}
+}
+
+func TestCreateElasticProcessor(t *testing.T) {
+
+	args := createMockArgElasticProcessorFactory()
+
+	ep, err := CreateElasticProcessor(args)
+	require.Nil(t, err)
+	require.NotNil(t, ep)
+}
+
+func TestCreateElasticProcessor_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgElasticProcessorFactory()
+	args.SupportedIndexes = nil
+	ep, err := CreateElasticProcessor(args)
+	require.Nil(t, ep)
+	require.Equal(t, dataindexer.ErrEmptySupportedIndexes, err)
+
+	args = createMockArgElasticProcessorFactory()
+	args.SupportedIndexes = []string{dataindexer.TransactionsIndex}
+	ep, err = CreateElasticProcessor(args)
+	require.Nil(t, ep)
+	require.Equal(t, dataindexer.ErrEmptyEnabledIndexes, err)
+
+	args = createMockArgElasticProcessorFactory()
+	args.BlockProcessorCreator = nil
+	ep, err = CreateElasticProcessor(args)
+	require.Nil(t, ep)
+	require.Equal(t, dataindexer.ErrNilBlockProcessorCreator, err)
+
+	args = createMockArgElasticProcessorFactory()
+	args.AccountsProcessorCreator = nil
+	ep, err = CreateElasticProcessor(args)
+	require.Nil(t, ep)
+	require.Equal(t, dataindexer.ErrNilAccountsProcessorCreator, err)
+
+	args = createMockArgElasticProcessorFactory()
+	args.WatchedAddresses = WatchedAddressesConfig{
+		Enabled: true,
+		Source:  "unknown",
+	}
+	ep, err = CreateElasticProcessor(args)
+	require.Nil(t, ep)
+	require.True(t, errors.Is(err, watchlist.ErrInvalidAddressesSource))
+
+	args = createMockArgElasticProcessorFactory()
+	args.PayloadsOffloading = PayloadsOffloadingConfig{
+		Enabled:          true,
+		ThresholdInBytes: 0,
 	}
+	ep, err = CreateElasticProcessor(args)
+	require.Nil(t, ep)
+	require.Equal(t, payloads.ErrInvalidThreshold, err)
+}
+
+func TestCreateElasticProcessor_WithWatchedAddresses(t *testing.T) {
+	t.Parallel()
 
+	args := createMockArgElasticProcessorFactory()
+	args.WatchedAddresses = WatchedAddressesConfig{
+		Enabled:             true,
+		Source:              watchlist.IndexSource,
+		Index:               "watched-addresses",
+		ReloadIntervalInSec: 60,
+	}
 	ep, err := CreateElasticProcessor(args)
 	require.Nil(t, err)
 	require.NotNil(t, ep)
 }
+
+func TestCreateEnabledIndexesMap(t *testing.T) {
+	t.Parallel()
+
+	enabledIndexes, err := createEnabledIndexesMap(
+		[]string{dataindexer.BlockIndex, dataindexer.BridgeTransfersIndex},
+		[]string{dataindexer.BlockIndex, dataindexer.TransactionsIndex},
+	)
+	require.Nil(t, err)
+	require.Equal(t, map[string]struct{}{dataindexer.BlockIndex: {}}, enabledIndexes)
+}
@@ -0,0 +1,116 @@
+package guardians
+
+import (
+	"encoding/hex"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+const (
+	guardianTopicIndex   = 0
+	serviceUIDTopicIndex = 1
+)
+
+var log = logger.GetOrCreate("indexer/process/guardians")
+
+var guardianEvents = map[string]struct{}{
+	core.BuiltInFunctionSetGuardian:    {},
+	core.BuiltInFunctionGuardAccount:   {},
+	core.BuiltInFunctionUnGuardAccount: {},
+}
+
+type guardiansProcessor struct {
+	pubKeyConverter       core.PubkeyConverter
+	activationEpochsDelay uint32
+}
+
+// NewGuardiansProcessor will create a new instance of guardiansProcessor. The activation epochs delay is the number of
+// epochs after which a guardian that was not set by the active guardian of the account becomes active.
+func NewGuardiansProcessor(pubKeyConverter core.PubkeyConverter, activationEpochsDelay uint32) (*guardiansProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+
+	return &guardiansProcessor{
+		pubKeyConverter:       pubKeyConverter,
+		activationEpochsDelay: activationEpochsDelay,
+	}, nil
+}
+
+// ExtractGuardianChanges will extract the guardian lifecycle events of a block, in the order they were generated. A
+// guardian set by a transaction co-signed by the active guardian of the account is active instantly, otherwise it is
+// pending until its activation epoch.
+func (gp *guardiansProcessor) ExtractGuardianChanges(preparedResults *data.PreparedResults, events []*data.LogEvent, epoch uint32, selfShardID uint32) []*data.GuardianChange {
+	changes := make([]*data.GuardianChange, 0)
+	if len(events) == 0 {
+		return changes
+	}
+
+	coSignedTxs := make(map[string]struct{})
+	if preparedResults != nil {
+		for _, tx := range preparedResults.Transactions {
+			if tx.GuardianAddress != "" {
+				coSignedTxs[tx.Hash] = struct{}{}
+			}
+		}
+	}
+
+	for _, event := range events {
+		_, isGuardianEvent := guardianEvents[event.Identifier]
+		if !isGuardianEvent {
+			continue
+		}
+
+		change := &data.GuardianChange{
+			Address:    event.Address,
+			Identifier: event.Identifier,
+			Epoch:      epoch,
+			TxHash:     event.TxHash,
+			ShardID:    selfShardID,
+			Timestamp:  event.Timestamp,
+		}
+
+		if event.Identifier == core.BuiltInFunctionSetGuardian {
+			if !gp.fillSetGuardianChange(change, event.Topics) {
+				continue
+			}
+			_, change.IsInstant = coSignedTxs[event.TxHash]
+			if !change.IsInstant {
+				change.ActivationEpoch = epoch + gp.activationEpochsDelay
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// SetGuardian event:
+// topics[0] -- the address of the new guardian
+// topics[1] -- the unique identifier of the guardian service
+func (gp *guardiansProcessor) fillSetGuardianChange(change *data.GuardianChange, hexTopics []string) bool {
+	if len(hexTopics) <= serviceUIDTopicIndex {
+		return false
+	}
+
+	guardian, err := hex.DecodeString(hexTopics[guardianTopicIndex])
+	if err != nil || len(guardian) != gp.pubKeyConverter.Len() {
+		log.Warn("guardiansProcessor.fillSetGuardianChange: invalid guardian address", "txHash", change.TxHash)
+		return false
+	}
+	serviceUID, err := hex.DecodeString(hexTopics[serviceUIDTopicIndex])
+	if err != nil {
+		return false
+	}
+
+	change.Guardian = gp.pubKeyConverter.SilentEncode(guardian, log)
+	change.ServiceUID = string(serviceUID)
+
+	return true
+}
@@ -0,0 +1,115 @@
+package guardians
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func createSetGuardianEvent(txHash string, guardian []byte, serviceUID string) *data.LogEvent {
+	return &data.LogEvent{
+		TxHash:     txHash,
+		Address:    "drt1account",
+		Identifier: core.BuiltInFunctionSetGuardian,
+		Topics:     []string{hex.EncodeToString(guardian), hex.EncodeToString([]byte(serviceUID))},
+		Timestamp:  5000,
+	}
+}
+
+func TestNewGuardiansProcessor(t *testing.T) {
+	t.Parallel()
+
+	gp, err := NewGuardiansProcessor(nil, 20)
+	require.Nil(t, gp)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	gp, err = NewGuardiansProcessor(mock.NewPubkeyConverterMock(32), 20)
+	require.Nil(t, err)
+	require.NotNil(t, gp)
+}
+
+func TestGuardiansProcessor_ExtractGuardianChanges(t *testing.T) {
+	t.Parallel()
+
+	guardian := bytes.Repeat([]byte{1}, 32)
+	gp, _ := NewGuardiansProcessor(mock.NewPubkeyConverterMock(32), 20)
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "tx1"},
+			{Hash: "tx2", GuardianAddress: "drt1guardian"},
+		},
+	}
+	events := []*data.LogEvent{
+		createSetGuardianEvent("tx1", guardian, "serviceUID"),
+		createSetGuardianEvent("tx2", guardian, "serviceUID"),
+		{TxHash: "tx3", Address: "drt1account", Identifier: core.BuiltInFunctionGuardAccount, Timestamp: 5000},
+		{TxHash: "tx4", Address: "drt1account", Identifier: core.BuiltInFunctionUnGuardAccount, Timestamp: 5000},
+		{TxHash: "tx5", Address: "drt1account", Identifier: core.BuiltInFunctionSetGuardian, Topics: []string{"0102"}, Timestamp: 5000},
+		{TxHash: "tx6", Address: "drt1account", Identifier: core.WriteLogIdentifier, Timestamp: 5000},
+	}
+
+	changes := gp.ExtractGuardianChanges(preparedResults, events, 100, 1)
+	require.Equal(t, []*data.GuardianChange{
+		{
+			Address:         "drt1account",
+			Identifier:      core.BuiltInFunctionSetGuardian,
+			Guardian:        hex.EncodeToString(guardian),
+			ServiceUID:      "serviceUID",
+			ActivationEpoch: 120,
+			Epoch:           100,
+			TxHash:          "tx1",
+			ShardID:         1,
+			Timestamp:       5000,
+		},
+		{
+			Address:    "drt1account",
+			Identifier: core.BuiltInFunctionSetGuardian,
+			Guardian:   hex.EncodeToString(guardian),
+			ServiceUID: "serviceUID",
+			IsInstant:  true,
+			Epoch:      100,
+			TxHash:     "tx2",
+			ShardID:    1,
+			Timestamp:  5000,
+		},
+		{
+			Address:    "drt1account",
+			Identifier: core.BuiltInFunctionGuardAccount,
+			Epoch:      100,
+			TxHash:     "tx3",
+			ShardID:    1,
+			Timestamp:  5000,
+		},
+		{
+			Address:    "drt1account",
+			Identifier: core.BuiltInFunctionUnGuardAccount,
+			Epoch:      100,
+			TxHash:     "tx4",
+			ShardID:    1,
+			Timestamp:  5000,
+		},
+	}, changes)
+}
+
+func TestGuardiansProcessor_ExtractGuardianChangesInvalidGuardian(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGuardiansProcessor(mock.NewPubkeyConverterMock(32), 20)
+
+	events := []*data.LogEvent{
+		createSetGuardianEvent("tx1", []byte("short"), "serviceUID"),
+		{TxHash: "tx2", Address: "drt1account", Identifier: core.BuiltInFunctionSetGuardian, Topics: []string{"zz", "zz"}},
+	}
+
+	changes := gp.ExtractGuardianChanges(nil, events, 100, 1)
+	require.Empty(t, changes)
+	require.Empty(t, gp.ExtractGuardianChanges(nil, nil, 100, 1))
+}
@@ -0,0 +1,176 @@
+package guardians
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// promotePendingGuardianFunction makes active the pending guardian once its activation epoch is reached
+const promotePendingGuardianFunction = `
+		void promotePendingGuardian(Map state, def epoch) {
+			def activationEpoch = state.get('pendingActivationEpoch');
+			if (state.get('pendingGuardian') != null && (activationEpoch == null || activationEpoch <= epoch)) {
+				state.activeGuardian = state.pendingGuardian;
+				state.activeServiceUID = state.pendingServiceUID;
+				state.remove('pendingGuardian');
+				state.remove('pendingServiceUID');
+				state.remove('pendingActivationEpoch');
+			}
+		}
+`
+
+// applyChangeFunction promotes the pending guardian if its activation epoch is reached, then applies the change.
+// Guarding an account removes its pending guardian, as the node does.
+var applyChangeFunction = promotePendingGuardianFunction + fmt.Sprintf(`
+		void applyChange(Map state, Map change) {
+			promotePendingGuardian(state, change.epoch);
+			if (change.identifier == '%s') {
+				if (change.isInstant == true) {
+					state.activeGuardian = change.guardian;
+					state.activeServiceUID = change.serviceUID;
+					state.remove('pendingGuardian');
+					state.remove('pendingServiceUID');
+					state.remove('pendingActivationEpoch');
+				} else {
+					state.pendingGuardian = change.guardian;
+					state.pendingServiceUID = change.serviceUID;
+					state.pendingActivationEpoch = change.activationEpoch;
+				}
+			} else if (change.identifier == '%s') {
+				state.isGuarded = true;
+				state.remove('pendingGuardian');
+				state.remove('pendingServiceUID');
+				state.remove('pendingActivationEpoch');
+			} else if (change.identifier == '%s') {
+				state.isGuarded = false;
+			}
+			state.txHash = change.txHash;
+			state.timestamp = change.timestamp;
+		}
+`, core.BuiltInFunctionSetGuardian, core.BuiltInFunctionGuardAccount, core.BuiltInFunctionUnGuardAccount)
+
+// SerializeGuardianChanges will serialize the provided guardian changes in a way that Elasticsearch expects a bulk
+// request. The changes older than the guardian state of the account are ignored.
+func (gp *guardiansProcessor) SerializeGuardianChanges(changes []*data.GuardianChange, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := applyChangeFunction + `
+		if ('create' == ctx.op) {
+			ctx._source = params.guardian;
+		} else if (ctx._source.timestamp <= params.change.timestamp) {
+			applyChange(ctx._source, params.change);
+			ctx._source.shardID = params.change.shardID;
+		} else {
+			ctx.op = 'noop';
+		}
+`
+
+	return serializeChanges(changes, buffSlice, index, codeToExecute)
+}
+
+// SerializeAccountsGuardians will serialize the guardian state of the accounts that changed it in the guardian field of
+// the accounts documents. The changes older than the guardian state of the account are ignored.
+func (gp *guardiansProcessor) SerializeAccountsGuardians(changes []*data.GuardianChange, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := applyChangeFunction + `
+		if ('create' == ctx.op) {
+			ctx._source = ['address': params.change.address, 'shardID': params.change.shardID];
+		}
+		if (ctx._source.get('guardian') == null) {
+			ctx._source.guardian = params.guardian;
+			ctx._source.guardian.remove('address');
+			ctx._source.guardian.remove('shardID');
+		} else if (ctx._source.guardian.timestamp <= params.change.timestamp) {
+			applyChange(ctx._source.guardian, params.change);
+		} else {
+			ctx.op = 'noop';
+		}
+`
+
+	return serializeChanges(changes, buffSlice, index, codeToExecute)
+}
+
+// PreparePendingGuardiansQuery will prepare the update by query that makes active the pending guardians of the guardians
+// documents of a shard whose activation epoch is reached in the provided epoch
+func (gp *guardiansProcessor) PreparePendingGuardiansQuery(epoch uint32, shardID uint32) *bytes.Buffer {
+	codeToExecute := promotePendingGuardianFunction + `
+		promotePendingGuardian(ctx._source, params.epoch);
+`
+
+	return preparePendingGuardiansQuery("pendingActivationEpoch", epoch, shardID, codeToExecute)
+}
+
+// PrepareAccountsPendingGuardiansQuery will prepare the update by query that makes active the pending guardians of the
+// accounts documents of a shard whose activation epoch is reached in the provided epoch
+func (gp *guardiansProcessor) PrepareAccountsPendingGuardiansQuery(epoch uint32, shardID uint32) *bytes.Buffer {
+	codeToExecute := promotePendingGuardianFunction + `
+		promotePendingGuardian(ctx._source.guardian, params.epoch);
+`
+
+	return preparePendingGuardiansQuery("guardian.pendingActivationEpoch", epoch, shardID, codeToExecute)
+}
+
+func preparePendingGuardiansQuery(activationEpochField string, epoch uint32, shardID uint32, codeToExecute string) *bytes.Buffer {
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"term": {"shardID": %d}},{"range": {"%s": {"lte": %d}}}]}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"epoch": %d}}}`,
+		shardID, activationEpochField, epoch, converters.FormatPainlessSource(codeToExecute), epoch)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+func serializeChanges(changes []*data.GuardianChange, buffSlice *data.BufferSlice, index string, codeToExecute string) error {
+	for _, change := range changes {
+		meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(change.Address), "\n"))
+		marshaledChange, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+		marshaledGuardian, err := json.Marshal(newAccountGuardian(change))
+		if err != nil {
+			return err
+		}
+
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": {"change": %s, "guardian": %s}},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(marshaledChange), string(marshaledGuardian))
+
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newAccountGuardian returns the guardian state of an account that was not indexed before the change
+func newAccountGuardian(change *data.GuardianChange) *data.AccountGuardian {
+	guardian := &data.AccountGuardian{
+		Address:   change.Address,
+		TxHash:    change.TxHash,
+		ShardID:   change.ShardID,
+		Timestamp: change.Timestamp,
+	}
+
+	switch change.Identifier {
+	case core.BuiltInFunctionSetGuardian:
+		if change.IsInstant {
+			guardian.ActiveGuardian = change.Guardian
+			guardian.ActiveServiceUID = change.ServiceUID
+		} else {
+			guardian.PendingGuardian = change.Guardian
+			guardian.PendingServiceUID = change.ServiceUID
+			guardian.PendingActivationEpoch = change.ActivationEpoch
+		}
+	case core.BuiltInFunctionGuardAccount:
+		guardian.IsGuarded = true
+	}
+
+	return guardian
+}
@@ -0,0 +1,99 @@
+package guardians
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+)
+
+func createGuardianChanges() []*data.GuardianChange {
+	return []*data.GuardianChange{
+		{
+			Address:         "drt1account",
+			Identifier:      core.BuiltInFunctionSetGuardian,
+			Guardian:        "drt1guardian",
+			ServiceUID:      "serviceUID",
+			ActivationEpoch: 120,
+			Epoch:           100,
+			TxHash:          "tx1",
+			ShardID:         1,
+			Timestamp:       5000,
+		},
+	}
+}
+
+func TestGuardiansProcessor_SerializeGuardianChanges(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGuardiansProcessor(mock.NewPubkeyConverterMock(32), 20)
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := gp.SerializeGuardianChanges(createGuardianChanges(), buffSlice, "guardians")
+	require.Nil(t, err)
+
+	lines := strings.Split(buffSlice.Buffers()[0].String(), "\n")
+	require.Equal(t, `{ "update" : {"_index":"guardians", "_id" : "drt1account" } }`, lines[0])
+	require.True(t, strings.HasPrefix(lines[1], `{"scripted_upsert": true, "script": {"source": "void promotePendingGuardian(Map state, def epoch) {`))
+	require.Contains(t, lines[1], `if ('create' == ctx.op) {ctx._source = params.guardian;} else if (ctx._source.timestamp <= params.change.timestamp) {applyChange(ctx._source, params.change);`)
+	require.True(t, strings.HasSuffix(lines[1], `"params": {"change": {"address":"drt1account","identifier":"SetGuardian","guardian":"drt1guardian","serviceUID":"serviceUID","isInstant":false,"activationEpoch":120,"epoch":100,"txHash":"tx1","shardID":1,"timestamp":5000}, `+
+		`"guardian": {"address":"drt1account","isGuarded":false,"pendingGuardian":"drt1guardian","pendingServiceUID":"serviceUID","pendingActivationEpoch":120,"txHash":"tx1","shardID":1,"timestamp":5000}}},"upsert": {}}`))
+}
+
+func TestGuardiansProcessor_SerializeAccountsGuardians(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGuardiansProcessor(mock.NewPubkeyConverterMock(32), 20)
+
+	changes := createGuardianChanges()
+	changes[0].IsInstant = true
+	changes[0].ActivationEpoch = 0
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := gp.SerializeAccountsGuardians(changes, buffSlice, "accounts")
+	require.Nil(t, err)
+
+	lines := strings.Split(buffSlice.Buffers()[0].String(), "\n")
+	require.Equal(t, `{ "update" : {"_index":"accounts", "_id" : "drt1account" } }`, lines[0])
+	require.Contains(t, lines[1], `if (ctx._source.get('guardian') == null) {ctx._source.guardian = params.guardian;`)
+	require.True(t, strings.HasSuffix(lines[1], `"guardian": {"address":"drt1account","isGuarded":false,"activeGuardian":"drt1guardian","activeServiceUID":"serviceUID","txHash":"tx1","shardID":1,"timestamp":5000}}},"upsert": {}}`))
+}
+
+func TestGuardiansProcessor_PreparePendingGuardiansQuery(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGuardiansProcessor(mock.NewPubkeyConverterMock(32), 20)
+
+	query := gp.PreparePendingGuardiansQuery(120, 1).String()
+	require.True(t, strings.HasPrefix(query, `{"query": {"bool": {"must": [{"term": {"shardID": 1}},{"range": {"pendingActivationEpoch": {"lte": 120}}}]}},"script": {"source": "void promotePendingGuardian(Map state, def epoch) {`))
+	require.True(t, strings.HasSuffix(query, `promotePendingGuardian(ctx._source, params.epoch);","lang": "painless","params": {"epoch": 120}}}`))
+
+	query = gp.PrepareAccountsPendingGuardiansQuery(120, 1).String()
+	require.True(t, strings.HasPrefix(query, `{"query": {"bool": {"must": [{"term": {"shardID": 1}},{"range": {"guardian.pendingActivationEpoch": {"lte": 120}}}]}},`))
+	require.True(t, strings.HasSuffix(query, `promotePendingGuardian(ctx._source.guardian, params.epoch);","lang": "painless","params": {"epoch": 120}}}`))
+}
+
+func TestNewAccountGuardian(t *testing.T) {
+	t.Parallel()
+
+	guardAccount := &data.GuardianChange{Address: "drt1account", Identifier: core.BuiltInFunctionGuardAccount, TxHash: "tx2", ShardID: 1, Timestamp: 5000}
+	require.Equal(t, &data.AccountGuardian{
+		Address:   "drt1account",
+		IsGuarded: true,
+		TxHash:    "tx2",
+		ShardID:   1,
+		Timestamp: 5000,
+	}, newAccountGuardian(guardAccount))
+
+	unGuardAccount := &data.GuardianChange{Address: "drt1account", Identifier: core.BuiltInFunctionUnGuardAccount, TxHash: "tx3", ShardID: 1, Timestamp: 5000}
+	require.Equal(t, &data.AccountGuardian{
+		Address:   "drt1account",
+		TxHash:    "tx3",
+		ShardID:   1,
+		Timestamp: 5000,
+	}, newAccountGuardian(unGuardAccount))
+}
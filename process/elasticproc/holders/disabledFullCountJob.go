@@ -0,0 +1,18 @@
+package holders
+
+type disabledFullCountJob struct{}
+
+// NewDisabledFullCountJob will create a new instance of disabledFullCountJob
+func NewDisabledFullCountJob() *disabledFullCountJob {
+	return &disabledFullCountJob{}
+}
+
+// Close returns nil
+func (dfcj *disabledFullCountJob) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dfcj *disabledFullCountJob) IsInterfaceNil() bool {
+	return dfcj == nil
+}
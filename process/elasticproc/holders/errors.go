@@ -0,0 +1,6 @@
+package holders
+
+import "errors"
+
+// ErrInvalidFullCountInterval signals that the interval between two full counts is not valid
+var ErrInvalidFullCountInterval = errors.New("invalid full count interval")
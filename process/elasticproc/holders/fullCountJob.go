@@ -0,0 +1,230 @@
+package holders
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+const (
+	accountsDCDTQuery   = `{"query": {"match_all": {}}}`
+	tokensWithHolders   = `{"query": {"range": {"accountsCount": {"gt": 0}}}}`
+	fullCountTopicShard = core.AllShardId
+)
+
+// ArgsFullCountJob holds all the components needed to create a new instance of fullCountJob
+type ArgsFullCountJob struct {
+	DBClient           DatabaseClientHandler
+	PubKeyConverter    core.PubkeyConverter
+	BalanceConverter   dataindexer.BalanceConverter
+	Interval           time.Duration
+	BulkRequestMaxSize int
+}
+
+// fullCountJob periodically recomputes the holders counters and the circulating supply of all the tokens from the
+// accountsdcdt index. It reconciles the values that are incremented on every block, which can drift on reverts.
+type fullCountJob struct {
+	dbClient           DatabaseClientHandler
+	pubKeyConverter    core.PubkeyConverter
+	holdersProc        *tokenHoldersProcessor
+	interval           time.Duration
+	bulkRequestMaxSize int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type tokenCounter struct {
+	holdersCount  int64
+	accountsCount int64
+	supply        *big.Int
+}
+
+// NewFullCountJob will create a new instance of fullCountJob and will start the periodic full count
+func NewFullCountJob(args ArgsFullCountJob) (*fullCountJob, error) {
+	if check.IfNil(args.DBClient) {
+		return nil, dataindexer.ErrNilDatabaseClient
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+	if args.Interval <= 0 {
+		return nil, ErrInvalidFullCountInterval
+	}
+
+	holdersProc, err := NewTokenHoldersProcessor(args.BalanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fcj := &fullCountJob{
+		dbClient:           args.DBClient,
+		pubKeyConverter:    args.PubKeyConverter,
+		holdersProc:        holdersProc,
+		interval:           args.Interval,
+		bulkRequestMaxSize: args.BulkRequestMaxSize,
+		cancel:             cancel,
+	}
+
+	fcj.wg.Add(1)
+	go fcj.run(ctx)
+
+	return fcj, nil
+}
+
+func (fcj *fullCountJob) run(ctx context.Context) {
+	defer fcj.wg.Done()
+
+	ticker := time.NewTicker(fcj.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			startTime := time.Now()
+			err := fcj.countHolders(ctx)
+			if err != nil {
+				log.Warn("fullCountJob.run: cannot count the token holders", "error", err)
+				continue
+			}
+
+			log.Debug("fullCountJob.run: counted the token holders", "duration", time.Since(startTime))
+		}
+	}
+}
+
+func (fcj *fullCountJob) countHolders(ctx context.Context) error {
+	counters := make(map[string]*tokenCounter)
+	countHandler := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			account := &data.AccountInfo{}
+			err = json.Unmarshal(hit.Source, account)
+			if err != nil {
+				return err
+			}
+
+			fcj.addAccount(counters, account)
+		}
+
+		return nil
+	}
+
+	scrollCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.ScrollTopic, fullCountTopicShard))
+	err := fcj.dbClient.DoScrollRequest(scrollCtx, dataindexer.AccountsDCDTIndex, []byte(accountsDCDTQuery), true, countHandler)
+	if err != nil {
+		return err
+	}
+
+	tokensHolders := make(map[string]*data.TokenHolders, len(counters))
+	for identifier, counter := range counters {
+		tokensHolders[identifier] = &data.TokenHolders{
+			Identifier:           identifier,
+			HoldersCount:         counter.holdersCount,
+			AccountsCount:        counter.accountsCount,
+			CirculatingSupply:    counter.supply.String(),
+			CirculatingSupplyNum: fcj.holdersProc.computeSupplyNum(counter.supply),
+		}
+	}
+
+	// the tokens that are not held by any account anymore are reset
+	resetHandler := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		errUnmarshal := json.Unmarshal(responseBytes, responseScroll)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			_, found := tokensHolders[hit.ID]
+			if !found {
+				tokensHolders[hit.ID] = &data.TokenHolders{
+					Identifier:        hit.ID,
+					CirculatingSupply: "0",
+				}
+			}
+		}
+
+		return nil
+	}
+
+	err = fcj.dbClient.DoScrollRequest(scrollCtx, dataindexer.TokensIndex, []byte(tokensWithHolders), false, resetHandler)
+	if err != nil {
+		return err
+	}
+
+	buffSlice := data.NewBufferSlice(fcj.bulkRequestMaxSize)
+	err = fcj.holdersProc.SerializeTokensHoldersCounts(tokensHolders, buffSlice, dataindexer.TokensIndex)
+	if err != nil {
+		return err
+	}
+
+	bulkCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.BulkTopic, fullCountTopicShard))
+	for _, buff := range buffSlice.Buffers() {
+		err = fcj.dbClient.DoBulkRequest(bulkCtx, buff, dataindexer.TokensIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (fcj *fullCountJob) addAccount(counters map[string]*tokenCounter, account *data.AccountInfo) {
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+	if !ok || balance.Sign() <= 0 || account.TokenName == "" {
+		return
+	}
+
+	identifier := tokenIdentifier(account)
+
+	counter, found := counters[identifier]
+	if !found {
+		counter = &tokenCounter{
+			supply: big.NewInt(0),
+		}
+		counters[identifier] = counter
+	}
+
+	counter.accountsCount++
+	counter.supply.Add(counter.supply, balance)
+
+	addressBytes, err := fcj.pubKeyConverter.Decode(account.Address)
+	if err != nil {
+		log.Debug("fullCountJob.addAccount: cannot decode address", "address", account.Address, "error", err)
+		return
+	}
+	if !core.IsSmartContractAddress(addressBytes) {
+		counter.holdersCount++
+	}
+}
+
+// Close will stop the periodic full count
+func (fcj *fullCountJob) Close() error {
+	fcj.cancel()
+	fcj.wg.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fcj *fullCountJob) IsInterfaceNil() bool {
+	return fcj == nil
+}
@@ -0,0 +1,114 @@
+package holders
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+var (
+	alice    = strings.Repeat("a1", 32)
+	bob      = strings.Repeat("b1", 32)
+	contract = strings.Repeat("00", 10) + strings.Repeat("cc", 22)
+)
+
+func createMockArgsFullCountJob() ArgsFullCountJob {
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+
+	return ArgsFullCountJob{
+		DBClient:           &mock.DatabaseWriterStub{},
+		PubKeyConverter:    mock.NewPubkeyConverterMock(32),
+		BalanceConverter:   balanceConverter,
+		Interval:           time.Hour,
+		BulkRequestMaxSize: data.DefaultMaxBulkSize,
+	}
+}
+
+func TestNewFullCountJob(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFullCountJob()
+	args.DBClient = nil
+	fcj, err := NewFullCountJob(args)
+	require.Nil(t, fcj)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	args = createMockArgsFullCountJob()
+	args.PubKeyConverter = nil
+	fcj, err = NewFullCountJob(args)
+	require.Nil(t, fcj)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	args = createMockArgsFullCountJob()
+	args.Interval = 0
+	fcj, err = NewFullCountJob(args)
+	require.Nil(t, fcj)
+	require.Equal(t, ErrInvalidFullCountInterval, err)
+
+	args = createMockArgsFullCountJob()
+	args.BalanceConverter = nil
+	fcj, err = NewFullCountJob(args)
+	require.Nil(t, fcj)
+	require.Equal(t, dataindexer.ErrNilBalanceConverter, err)
+
+	fcj, err = NewFullCountJob(createMockArgsFullCountJob())
+	require.Nil(t, err)
+	require.NotNil(t, fcj)
+	require.Nil(t, fcj.Close())
+}
+
+func TestFullCountJob_CountHolders(t *testing.T) {
+	t.Parallel()
+
+	accountsResponse := `{"hits": {"hits": [
+		{"_id": "1", "_source": {"address": "` + alice + `", "token": "TKN-abcd", "balance": "1000000000000000000"}},
+		{"_id": "2", "_source": {"address": "` + bob + `", "token": "TKN-abcd", "balance": "1000000000000000000"}},
+		{"_id": "3", "_source": {"address": "` + contract + `", "token": "TKN-abcd", "balance": "500000000000000000"}},
+		{"_id": "4", "_source": {"address": "` + alice + `", "token": "NFT-abcd", "tokenNonce": 1, "balance": "1"}},
+		{"_id": "5", "_source": {"address": "` + bob + `", "token": "SFT-abcd", "identifier": "SFT-abcd-01", "balance": "0"}}
+	]}}`
+	tokensResponse := `{"hits": {"hits": [{"_id": "TKN-abcd"}, {"_id": "OLD-abcd"}]}}`
+
+	bulkBody := ""
+	args := createMockArgsFullCountJob()
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			if index == dataindexer.AccountsDCDTIndex {
+				require.True(t, withSource)
+				return handlerFunc([]byte(accountsResponse))
+			}
+
+			require.Equal(t, dataindexer.TokensIndex, index)
+			require.Equal(t, tokensWithHolders, string(body))
+			return handlerFunc([]byte(tokensResponse))
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, dataindexer.TokensIndex, index)
+			bulkBody += buff.String()
+			return nil
+		},
+	}
+	fcj, _ := NewFullCountJob(args)
+	defer func() {
+		_ = fcj.Close()
+	}()
+
+	err := fcj.countHolders(context.Background())
+	require.Nil(t, err)
+
+	require.Equal(t, 3, strings.Count(bulkBody, `{ "update" : `))
+	require.Contains(t, bulkBody, `"params": {"holders": {"holdersCount":2,"accountsCount":3,"circulatingSupply":"2500000000000000000","circulatingSupplyNum":2.5}}}`)
+	require.Contains(t, bulkBody, `{ "update" : {"_index":"tokens", "_id" : "NFT-abcd-01" } }`)
+	require.Contains(t, bulkBody, `"params": {"holders": {"holdersCount":1,"accountsCount":1,"circulatingSupply":"1","circulatingSupplyNum":1e-18}}}`)
+	require.Contains(t, bulkBody, `{ "update" : {"_index":"tokens", "_id" : "OLD-abcd" } }`)
+	require.Contains(t, bulkBody, `"params": {"holders": {"holdersCount":0,"accountsCount":0,"circulatingSupply":"0","circulatingSupplyNum":0}}}`)
+}
@@ -0,0 +1,13 @@
+package holders
+
+import (
+	"bytes"
+	"context"
+)
+
+// DatabaseClientHandler defines the actions that the full count job needs from the database client
+type DatabaseClientHandler interface {
+	DoBulkRequest(ctx context.Context, buff *bytes.Buffer, index string) error
+	DoScrollRequest(ctx context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,147 @@
+package holders
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeTokensHolders will serialize the changes of the holders counters and of the circulating supply. The changes
+// are added to the values of the indexed tokens, and a token that is not indexed is skipped.
+func (thp *tokenHoldersProcessor) SerializeTokensHolders(tokensHolders map[string]*data.TokenHolders, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop'
+		} else {
+			if (!ctx._source.containsKey('accountsCount')) {
+				ctx._source.holdersCount = 0;
+				ctx._source.accountsCount = 0;
+				ctx._source.circulatingSupply = '0';
+				ctx._source.circulatingSupplyNum = 0;
+			}
+			ctx._source.holdersCount += params.holders.holdersCount;
+			ctx._source.accountsCount += params.holders.accountsCount;
+			ctx._source.circulatingSupply = new BigInteger(ctx._source.circulatingSupply).add(new BigInteger(params.holders.circulatingSupply)).toString();
+			ctx._source.circulatingSupplyNum += params.holders.circulatingSupplyNum;
+		}
+`
+
+	return serializeTokensHolders(tokensHolders, codeToExecute, buffSlice, index)
+}
+
+// SerializeTokensHoldersCounts will serialize the holders counters and the circulating supply computed by a full count.
+// The provided values replace the indexed ones.
+func (thp *tokenHoldersProcessor) SerializeTokensHoldersCounts(tokensHolders map[string]*data.TokenHolders, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop'
+		} else {
+			ctx._source.holdersCount = params.holders.holdersCount;
+			ctx._source.accountsCount = params.holders.accountsCount;
+			ctx._source.circulatingSupply = params.holders.circulatingSupply;
+			ctx._source.circulatingSupplyNum = params.holders.circulatingSupplyNum;
+		}
+`
+
+	return serializeTokensHolders(tokensHolders, codeToExecute, buffSlice, index)
+}
+
+func serializeTokensHolders(tokensHolders map[string]*data.TokenHolders, codeToExecute string, buffSlice *data.BufferSlice, index string) error {
+	for _, holders := range tokensHolders {
+		meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(holders.Identifier), "\n"))
+		marshaledHolders, err := json.Marshal(holders)
+		if err != nil {
+			return err
+		}
+
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": {"holders": %s}},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(marshaledHolders))
+
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeCollectionsHolders will serialize the changes done by a block in the number of unique holders of the NFT
+// collections. The last change of every shard is kept together with the timestamp of its block, so the same block is not
+// counted twice and the change can be subtracted in case of revert. The timestamps of the statistics computed from the
+// events are not used, because those statistics are updated by the same block.
+func (thp *tokenHoldersProcessor) SerializeCollectionsHolders(collectionsHolders map[string]*data.CollectionStats, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop'
+		} else {
+			if (!ctx._source.containsKey('collectionStats') || ctx._source.collectionStats == null) {
+				ctx._source.collectionStats = ['minted': '0', 'burned': '0', 'supply': '0', 'holders': 0, 'nftsWithURIs': 0];
+			}
+			def stats = ctx._source.collectionStats;
+			if (!stats.containsKey('holdersChanges') || stats.holdersChanges == null) {
+				stats.holdersChanges = [];
+			}
+			def lastChange = null;
+			for (change in stats.holdersChanges) {
+				if (change.shardID == params.shardID) {
+					lastChange = change;
+				}
+			}
+			if (lastChange != null && lastChange.timestamp >= params.timestamp) {
+				ctx.op = 'noop';
+			} else {
+				if (lastChange == null) {
+					lastChange = ['shardID': params.shardID];
+					stats.holdersChanges.add(lastChange);
+				}
+				lastChange.timestamp = params.timestamp;
+				lastChange.holders = params.holders;
+				stats.holders += params.holders;
+			}
+		}
+`
+	for _, stats := range collectionsHolders {
+		meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(stats.Collection), "\n"))
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": {"holders": %d, "shardID": %d, "timestamp": %d}},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), stats.Holders, stats.ShardID, stats.Timestamp)
+
+		err := buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareCollectionsHoldersQueryInCaseOfRevert will prepare the update by query that subtracts the changes done by the
+// reverted block in the number of unique holders of the NFT collections
+func (thp *tokenHoldersProcessor) PrepareCollectionsHoldersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := `
+		for (change in ctx._source.collectionStats.holdersChanges) {
+			if (change.shardID == params.shardID && change.timestamp == params.timestamp) {
+				ctx._source.collectionStats.holders -= change.holders;
+				change.timestamp = params.timestamp - 1;
+				change.holders = 0;
+			}
+		}
+`
+
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"collectionStats.holdersChanges.shardID": {"query": %d,"operator": "AND"}}},{"match": {"collectionStats.holdersChanges.timestamp": {"query": "%d","operator": "AND"}}}]}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"shardID": %d, "timestamp": %d}}}`,
+		shardID, timestamp, converters.FormatPainlessSource(codeToExecute), shardID, timestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
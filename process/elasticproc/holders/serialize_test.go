@@ -0,0 +1,92 @@
+package holders
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestTokenHoldersProcessor_SerializeTokensHolders(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	tokensHolders := map[string]*data.TokenHolders{
+		"TKN-abcd": {
+			Identifier:           "TKN-abcd",
+			HoldersCount:         1,
+			AccountsCount:        -1,
+			CirculatingSupply:    "-5",
+			CirculatingSupplyNum: 0,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := thp.SerializeTokensHolders(tokensHolders, buffSlice, "tokens")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : {"_index":"tokens", "_id" : "TKN-abcd" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop'} else {if (!ctx._source.containsKey('accountsCount')) {ctx._source.holdersCount = 0;ctx._source.accountsCount = 0;ctx._source.circulatingSupply = '0';ctx._source.circulatingSupplyNum = 0;}ctx._source.holdersCount += params.holders.holdersCount;ctx._source.accountsCount += params.holders.accountsCount;ctx._source.circulatingSupply = new BigInteger(ctx._source.circulatingSupply).add(new BigInteger(params.holders.circulatingSupply)).toString();ctx._source.circulatingSupplyNum += params.holders.circulatingSupplyNum;}","lang": "painless","params": {"holders": {"holdersCount":1,"accountsCount":-1,"circulatingSupply":"-5","circulatingSupplyNum":0}}},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestTokenHoldersProcessor_SerializeTokensHoldersCounts(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	tokensHolders := map[string]*data.TokenHolders{
+		"TKN-abcd": {
+			Identifier:           "TKN-abcd",
+			HoldersCount:         2,
+			AccountsCount:        3,
+			CirculatingSupply:    "100",
+			CirculatingSupplyNum: 0,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := thp.SerializeTokensHoldersCounts(tokensHolders, buffSlice, "tokens")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : {"_index":"tokens", "_id" : "TKN-abcd" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop'} else {ctx._source.holdersCount = params.holders.holdersCount;ctx._source.accountsCount = params.holders.accountsCount;ctx._source.circulatingSupply = params.holders.circulatingSupply;ctx._source.circulatingSupplyNum = params.holders.circulatingSupplyNum;}","lang": "painless","params": {"holders": {"holdersCount":2,"accountsCount":3,"circulatingSupply":"100","circulatingSupplyNum":0}}},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestTokenHoldersProcessor_SerializeCollectionsHolders(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	collectionsHolders := map[string]*data.CollectionStats{
+		"NFT-abcd": {Collection: "NFT-abcd", Holders: -1, ShardID: 1, Timestamp: 5000},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := thp.SerializeCollectionsHolders(collectionsHolders, buffSlice, "tokens")
+	require.Nil(t, err)
+
+	res := buffSlice.Buffers()[0].String()
+	require.True(t, strings.HasPrefix(res, `{ "update" : {"_index":"tokens", "_id" : "NFT-abcd" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop'}`))
+	require.Contains(t, res, `if (lastChange != null && lastChange.timestamp >= params.timestamp) {ctx.op = 'noop';}`)
+	require.True(t, strings.HasSuffix(res, `"lang": "painless","params": {"holders": -1, "shardID": 1, "timestamp": 5000}},"upsert": {}}
+`))
+}
+
+func TestTokenHoldersProcessor_PrepareCollectionsHoldersQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	query := thp.PrepareCollectionsHoldersQueryInCaseOfRevert(5000, 1).String()
+	require.True(t, strings.HasPrefix(query, `{"query": {"bool": {"must": [{"match": {"collectionStats.holdersChanges.shardID": {"query": 1,"operator": "AND"}}},{"match": {"collectionStats.holdersChanges.timestamp": {"query": "5000","operator": "AND"}}}]}},`))
+	require.Contains(t, query, `ctx._source.collectionStats.holders -= change.holders;change.timestamp = params.timestamp - 1;change.holders = 0;`)
+	require.True(t, strings.HasSuffix(query, `"params": {"shardID": 1, "timestamp": 5000}}}`))
+}
@@ -0,0 +1,316 @@
+package holders
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+var log = logger.GetOrCreate("indexer/process/holders")
+
+type tokenHoldersProcessor struct {
+	balanceConverter dataindexer.BalanceConverter
+}
+
+// NewTokenHoldersProcessor will create a new instance of tokenHoldersProcessor
+func NewTokenHoldersProcessor(balanceConverter dataindexer.BalanceConverter) (*tokenHoldersProcessor, error) {
+	if check.IfNil(balanceConverter) {
+		return nil, dataindexer.ErrNilBalanceConverter
+	}
+
+	return &tokenHoldersProcessor{
+		balanceConverter: balanceConverter,
+	}, nil
+}
+
+// GetAccountsDCDTIDs will return the IDs of the accountsdcdt documents of the provided accounts
+func (thp *tokenHoldersProcessor) GetAccountsDCDTIDs(accounts map[string]*data.AccountInfo) []string {
+	ids := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		if account.TokenName == "" {
+			continue
+		}
+
+		ids = append(ids, accountDCDTID(account))
+	}
+
+	return ids
+}
+
+// PrepareTokensHolders will compute the changes done by the block in the holders counters and in the circulating supply
+// of the tokens. The previous balances are taken from the indexed accounts, and an account that is already indexed with
+// the timestamp of the block or a newer one is skipped, so the same block is not counted twice.
+func (thp *tokenHoldersProcessor) PrepareTokensHolders(accounts map[string]*data.AccountInfo, indexedAccounts *data.ResponseAccountsDCDT) map[string]*data.TokenHolders {
+	indexedAccountsMap := getIndexedAccountsMap(indexedAccounts)
+
+	supplyChanges := make(map[string]*big.Int)
+	tokensHolders := make(map[string]*data.TokenHolders)
+	for _, account := range accounts {
+		if account.TokenName == "" {
+			continue
+		}
+
+		previousBalance := "0"
+		indexedAccount, found := indexedAccountsMap[accountDCDTID(account)]
+		if found {
+			if indexedAccount.Timestamp >= account.Timestamp {
+				continue
+			}
+			previousBalance = indexedAccount.Balance
+		}
+
+		previousValue, ok := big.NewInt(0).SetString(previousBalance, 10)
+		if !ok {
+			log.Warn("tokenHoldersProcessor.PrepareTokensHolders: cannot parse the indexed balance",
+				"address", account.Address, "token", tokenIdentifier(account), "balance", previousBalance)
+			continue
+		}
+		currentValue, ok := big.NewInt(0).SetString(account.Balance, 10)
+		if !ok {
+			currentValue = big.NewInt(0)
+		}
+
+		identifier := tokenIdentifier(account)
+		holders, found := tokensHolders[identifier]
+		if !found {
+			holders = &data.TokenHolders{
+				Identifier: identifier,
+			}
+			tokensHolders[identifier] = holders
+			supplyChanges[identifier] = big.NewInt(0)
+		}
+
+		countChange := computeCountChange(previousValue, currentValue)
+		holders.AccountsCount += countChange
+		if !account.IsSmartContract {
+			holders.HoldersCount += countChange
+		}
+		supplyChanges[identifier].Add(supplyChanges[identifier], currentValue.Sub(currentValue, previousValue))
+	}
+
+	for identifier, holders := range tokensHolders {
+		supplyChange := supplyChanges[identifier]
+		if holders.AccountsCount == 0 && holders.HoldersCount == 0 && supplyChange.Sign() == 0 {
+			delete(tokensHolders, identifier)
+			continue
+		}
+
+		holders.CirculatingSupply = supplyChange.String()
+		holders.CirculatingSupplyNum = thp.computeSupplyNum(supplyChange)
+	}
+
+	return tokensHolders
+}
+
+// PrepareCollectionsAccountsChanges will compute, for every address that is not a smart contract, the change done by
+// the block in the number of NFTs of a collection that are held by the address
+func (thp *tokenHoldersProcessor) PrepareCollectionsAccountsChanges(accounts map[string]*data.AccountInfo, indexedAccounts *data.ResponseAccountsDCDT) []*data.CollectionAccountChange {
+	indexedAccountsMap := getIndexedAccountsMap(indexedAccounts)
+
+	changesMap := make(map[string]*data.CollectionAccountChange)
+	for _, account := range accounts {
+		shouldSkip := account.TokenName == "" || account.TokenNonce == 0 || account.IsSmartContract
+		if shouldSkip {
+			continue
+		}
+
+		previousBalance := "0"
+		indexedAccount, found := indexedAccountsMap[accountDCDTID(account)]
+		if found {
+			if indexedAccount.Timestamp >= account.Timestamp {
+				continue
+			}
+			previousBalance = indexedAccount.Balance
+		}
+
+		previousValue, ok := big.NewInt(0).SetString(previousBalance, 10)
+		if !ok {
+			continue
+		}
+		currentValue, ok := big.NewInt(0).SetString(account.Balance, 10)
+		if !ok {
+			currentValue = big.NewInt(0)
+		}
+
+		countChange := computeCountChange(previousValue, currentValue)
+		if countChange == 0 {
+			continue
+		}
+
+		key := account.Address + "-" + account.TokenName
+		change, found := changesMap[key]
+		if !found {
+			change = &data.CollectionAccountChange{
+				Address:    account.Address,
+				Collection: account.TokenName,
+			}
+			changesMap[key] = change
+		}
+		change.Change += countChange
+	}
+
+	changes := make([]*data.CollectionAccountChange, 0, len(changesMap))
+	for _, change := range changesMap {
+		if change.Change != 0 {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// PrepareCollectionsHeldQuery will prepare the aggregation that counts, for every address and collection of the provided
+// changes, the NFTs of the collection that are held by the address
+func (thp *tokenHoldersProcessor) PrepareCollectionsHeldQuery(changes []*data.CollectionAccountChange) []byte {
+	addressesMap := make(map[string]struct{})
+	collectionsMap := make(map[string]struct{})
+	for _, change := range changes {
+		addressesMap[change.Address] = struct{}{}
+		collectionsMap[change.Collection] = struct{}{}
+	}
+
+	addresses := sortedKeys(addressesMap)
+	collections := sortedKeys(collectionsMap)
+	marshaledAddresses, _ := json.Marshal(addresses)
+	marshaledCollections, _ := json.Marshal(collections)
+
+	query := fmt.Sprintf(`{"size": 0,"query": {"bool": {"filter": [{"terms": {"address": %s}},{"terms": {"token": %s}}]}},`+
+		`"aggs": {"addresses": {"terms": {"field": "address","size": %d},"aggs": {"collections": {"terms": {"field": "token","size": %d}}}}}}`,
+		marshaledAddresses, marshaledCollections, len(addresses), len(collections))
+
+	return []byte(query)
+}
+
+// PrepareCollectionsHolders will compute the changes done by the block in the number of unique holders of the
+// collections. The provided response holds the number of NFTs of the collections that were held by the addresses before
+// the block.
+func (thp *tokenHoldersProcessor) PrepareCollectionsHolders(
+	changes []*data.CollectionAccountChange,
+	collectionsHeld *data.ResponseCollectionsHeld,
+	timestamp uint64,
+	shardID uint32,
+) map[string]*data.CollectionStats {
+	heldMap := make(map[string]uint64)
+	if collectionsHeld != nil {
+		for _, addressBucket := range collectionsHeld.Aggregations.Addresses.Buckets {
+			for _, collectionBucket := range addressBucket.Collections.Buckets {
+				heldMap[addressBucket.Key+"-"+collectionBucket.Key] = collectionBucket.DocCount
+			}
+		}
+	}
+
+	collectionsHolders := make(map[string]*data.CollectionStats)
+	for _, change := range changes {
+		heldBefore := heldMap[change.Address+"-"+change.Collection]
+		heldAfter := int64(heldBefore) + change.Change
+		holdersChange := int64(0)
+		switch {
+		case heldBefore == 0 && heldAfter > 0:
+			holdersChange = 1
+		case heldBefore > 0 && heldAfter <= 0:
+			holdersChange = -1
+		}
+		if holdersChange == 0 {
+			continue
+		}
+
+		stats, found := collectionsHolders[change.Collection]
+		if !found {
+			stats = &data.CollectionStats{
+				Collection: change.Collection,
+				Minted:     "0",
+				Burned:     "0",
+				Supply:     "0",
+				ShardID:    shardID,
+				Timestamp:  time.Duration(timestamp),
+			}
+			collectionsHolders[change.Collection] = stats
+		}
+		stats.Holders += holdersChange
+	}
+
+	for collection, stats := range collectionsHolders {
+		if stats.Holders == 0 {
+			delete(collectionsHolders, collection)
+		}
+	}
+
+	return collectionsHolders
+}
+
+func (thp *tokenHoldersProcessor) computeSupplyNum(supply *big.Int) float64 {
+	supplyNum, err := thp.balanceConverter.ConvertBigValueToFloat(big.NewInt(0).Abs(supply))
+	if err != nil {
+		log.Warn("tokenHoldersProcessor.computeSupplyNum: cannot compute supply as num", "supply", supply, "error", err)
+		return 0
+	}
+	if supply.Sign() < 0 {
+		return -supplyNum
+	}
+
+	return supplyNum
+}
+
+func computeCountChange(previousValue *big.Int, currentValue *big.Int) int64 {
+	wasHolder := previousValue.Sign() > 0
+	isHolder := currentValue.Sign() > 0
+
+	switch {
+	case !wasHolder && isHolder:
+		return 1
+	case wasHolder && !isHolder:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func getIndexedAccountsMap(indexedAccounts *data.ResponseAccountsDCDT) map[string]*data.AccountInfo {
+	indexedAccountsMap := make(map[string]*data.AccountInfo)
+	if indexedAccounts == nil {
+		return indexedAccountsMap
+	}
+
+	for idx := range indexedAccounts.Docs {
+		if indexedAccounts.Docs[idx].Found {
+			indexedAccountsMap[indexedAccounts.Docs[idx].ID] = &indexedAccounts.Docs[idx].Source
+		}
+	}
+
+	return indexedAccountsMap
+}
+
+// tokenIdentifier returns the token of a fungible token account, which has no identifier, or the identifier of the NFT
+func tokenIdentifier(account *data.AccountInfo) string {
+	if account.TokenNonce == 0 {
+		return account.TokenName
+	}
+	if account.TokenIdentifier != "" {
+		return account.TokenIdentifier
+	}
+
+	return converters.ComputeTokenIdentifier(account.TokenName, account.TokenNonce)
+}
+
+func sortedKeys(keysMap map[string]struct{}) []string {
+	keys := make([]string, 0, len(keysMap))
+	for key := range keysMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func accountDCDTID(account *data.AccountInfo) string {
+	return fmt.Sprintf("%s-%s-%s", account.Address, account.TokenName, converters.EncodeNonceToHex(account.TokenNonce))
+}
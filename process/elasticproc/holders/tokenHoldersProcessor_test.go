@@ -0,0 +1,204 @@
+package holders
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+func createTokenHoldersProcessor() *tokenHoldersProcessor {
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	thp, _ := NewTokenHoldersProcessor(balanceConverter)
+
+	return thp
+}
+
+func TestNewTokenHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	thp, err := NewTokenHoldersProcessor(nil)
+	require.Nil(t, thp)
+	require.Equal(t, dataindexer.ErrNilBalanceConverter, err)
+
+	thp = createTokenHoldersProcessor()
+	require.NotNil(t, thp)
+}
+
+func TestTokenHoldersProcessor_GetAccountsDCDTIDs(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	ids := thp.GetAccountsDCDTIDs(map[string]*data.AccountInfo{
+		"a": {Address: "alice", TokenName: "NFT-abcd", TokenNonce: 10},
+		"b": {Address: "bob"},
+	})
+	require.Equal(t, []string{"alice-NFT-abcd-0a"}, ids)
+}
+
+func TestTokenHoldersProcessor_PrepareTokensHolders(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	accounts := map[string]*data.AccountInfo{
+		// new holder
+		"a": {Address: "alice", TokenName: "TKN-abcd", Balance: "1000000000000000000", Timestamp: 10},
+		// a contract that is not a holder anymore
+		"b": {Address: "contract", TokenName: "TKN-abcd", Balance: "0", Timestamp: 10, IsSmartContract: true},
+		// the balance is changed, but it is still a holder
+		"c": {Address: "carol", TokenName: "TKN-abcd", Balance: "5", Timestamp: 10},
+		// already indexed by the same block
+		"d": {Address: "dave", TokenName: "TKN-abcd", Balance: "7", Timestamp: 10},
+		// the supply is not changed
+		"e": {Address: "erin", TokenName: "OTHER-abcd", Balance: "3", Timestamp: 10},
+	}
+	indexedAccounts := &data.ResponseAccountsDCDT{
+		Docs: []data.ResponseAccountDCDTDB{
+			{Found: false, ID: "alice-TKN-abcd-00"},
+			{Found: true, ID: "contract-TKN-abcd-00", Source: data.AccountInfo{Balance: "500000000000000000", Timestamp: 5}},
+			{Found: true, ID: "carol-TKN-abcd-00", Source: data.AccountInfo{Balance: "2", Timestamp: 5}},
+			{Found: true, ID: "dave-TKN-abcd-00", Source: data.AccountInfo{Balance: "1", Timestamp: 10}},
+			{Found: true, ID: "erin-OTHER-abcd-00", Source: data.AccountInfo{Balance: "3", Timestamp: 5}},
+		},
+	}
+
+	tokensHolders := thp.PrepareTokensHolders(accounts, indexedAccounts)
+	require.Equal(t, map[string]*data.TokenHolders{
+		"TKN-abcd": {
+			Identifier:           "TKN-abcd",
+			HoldersCount:         1,
+			AccountsCount:        0,
+			CirculatingSupply:    "500000000000000003",
+			CirculatingSupplyNum: 0.5,
+		},
+	}, tokensHolders)
+}
+
+func TestTokenHoldersProcessor_PrepareTokensHoldersNegativeSupply(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	accounts := map[string]*data.AccountInfo{
+		"a": {Address: "alice", TokenName: "TKN-abcd", Balance: "0", Timestamp: 10},
+	}
+	indexedAccounts := &data.ResponseAccountsDCDT{
+		Docs: []data.ResponseAccountDCDTDB{
+			{Found: true, ID: "alice-TKN-abcd-00", Source: data.AccountInfo{Balance: "2000000000000000000", Timestamp: 5}},
+		},
+	}
+
+	tokensHolders := thp.PrepareTokensHolders(accounts, indexedAccounts)
+	require.Equal(t, &data.TokenHolders{
+		Identifier:           "TKN-abcd",
+		HoldersCount:         -1,
+		AccountsCount:        -1,
+		CirculatingSupply:    "-2000000000000000000",
+		CirculatingSupplyNum: -2,
+	}, tokensHolders["TKN-abcd"])
+}
+
+func TestTokenHoldersProcessor_PrepareTokensHoldersFungibleAndNFTs(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+
+	accounts := map[string]*data.AccountInfo{
+		"a": {Address: "alice", TokenName: "TKN-abcd", Balance: "1", Timestamp: 10},
+		"b": {Address: "bob", TokenName: "OTHER-abcd", Balance: "1", Timestamp: 10},
+		"c": {Address: "alice", TokenName: "NFT-abcd", TokenNonce: 10, TokenIdentifier: "NFT-abcd-0a", Balance: "1", Timestamp: 10},
+	}
+
+	tokensHolders := thp.PrepareTokensHolders(accounts, &data.ResponseAccountsDCDT{})
+	require.Len(t, tokensHolders, 3)
+	require.Equal(t, int64(1), tokensHolders["TKN-abcd"].HoldersCount)
+	require.Equal(t, int64(1), tokensHolders["OTHER-abcd"].HoldersCount)
+	require.Equal(t, int64(1), tokensHolders["NFT-abcd-0a"].HoldersCount)
+}
+
+func TestTokenHoldersProcessor_PrepareCollectionsAccountsChanges(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+	accounts := map[string]*data.AccountInfo{
+		"a1": {Address: "alice", TokenName: "NFT-abcd", TokenNonce: 1, Balance: "1", Timestamp: 100},
+		"a2": {Address: "alice", TokenName: "NFT-abcd", TokenNonce: 2, Balance: "1", Timestamp: 100},
+		"a3": {Address: "bob", TokenName: "NFT-abcd", TokenNonce: 3, Balance: "0", Timestamp: 100},
+		"a4": {Address: "bob", TokenName: "TKN-abcd", Balance: "10", Timestamp: 100},
+		"a5": {Address: "contract", TokenName: "NFT-abcd", TokenNonce: 4, Balance: "1", Timestamp: 100, IsSmartContract: true},
+	}
+	indexedAccounts := &data.ResponseAccountsDCDT{
+		Docs: []data.ResponseAccountDCDTDB{
+			{Found: true, ID: "bob-NFT-abcd-03", Source: data.AccountInfo{Balance: "1", Timestamp: 90}},
+		},
+	}
+
+	changes := thp.PrepareCollectionsAccountsChanges(accounts, indexedAccounts)
+	require.Len(t, changes, 2)
+
+	changesMap := make(map[string]int64)
+	for _, change := range changes {
+		changesMap[change.Address+"-"+change.Collection] = change.Change
+	}
+	require.Equal(t, map[string]int64{
+		"alice-NFT-abcd": 2,
+		"bob-NFT-abcd":   -1,
+	}, changesMap)
+}
+
+func TestTokenHoldersProcessor_PrepareCollectionsHeldQuery(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+	changes := []*data.CollectionAccountChange{
+		{Address: "bob", Collection: "NFT-abcd", Change: 1},
+		{Address: "alice", Collection: "NFT-abcd", Change: 2},
+		{Address: "alice", Collection: "SFT-abcd", Change: -1},
+	}
+
+	query := thp.PrepareCollectionsHeldQuery(changes)
+	require.Equal(t, `{"size": 0,"query": {"bool": {"filter": [{"terms": {"address": ["alice","bob"]}},{"terms": {"token": ["NFT-abcd","SFT-abcd"]}}]}},`+
+		`"aggs": {"addresses": {"terms": {"field": "address","size": 2},"aggs": {"collections": {"terms": {"field": "token","size": 2}}}}}}`, string(query))
+}
+
+func TestTokenHoldersProcessor_PrepareCollectionsHolders(t *testing.T) {
+	t.Parallel()
+
+	thp := createTokenHoldersProcessor()
+	changes := []*data.CollectionAccountChange{
+		{Address: "alice", Collection: "NFT-abcd", Change: 2},
+		{Address: "bob", Collection: "NFT-abcd", Change: -1},
+		{Address: "carol", Collection: "NFT-abcd", Change: 1},
+		{Address: "dave", Collection: "SFT-abcd", Change: -1},
+	}
+	collectionsHeld := &data.ResponseCollectionsHeld{}
+	err := json.Unmarshal([]byte(`{"aggregations": {"addresses": {"buckets": [
+		{"key": "bob", "collections": {"buckets": [{"key": "NFT-abcd", "doc_count": 1}]}},
+		{"key": "carol", "collections": {"buckets": [{"key": "NFT-abcd", "doc_count": 2}]}},
+		{"key": "dave", "collections": {"buckets": [{"key": "SFT-abcd", "doc_count": 3}, {"key": "NFT-abcd", "doc_count": 1}]}}
+	]}}}`), collectionsHeld)
+	require.Nil(t, err)
+
+	collectionsHolders := thp.PrepareCollectionsHolders(changes, collectionsHeld, 5000, 1)
+	require.Equal(t, map[string]*data.CollectionStats{}, collectionsHolders)
+
+	changes[1].Change = 1
+	collectionsHolders = thp.PrepareCollectionsHolders(changes, collectionsHeld, 5000, 1)
+	require.Equal(t, map[string]*data.CollectionStats{
+		"NFT-abcd": {
+			Collection: "NFT-abcd",
+			Minted:     "0",
+			Burned:     "0",
+			Supply:     "0",
+			Holders:    1,
+			ShardID:    1,
+			Timestamp:  5000,
+		},
+	}, collectionsHolders)
+}
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core"
 	coreData "github.com/TerraDharitri/drt-go-chain-core/data"
 	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
 	"github.com/TerraDharitri/drt-go-chain-core/data/block"
 	"github.com/TerraDharitri/drt-go-chain-core/data/outport"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/tokeninfo"
 )
 
@@ -27,6 +31,7 @@ type DatabaseClientHandler interface {
 	DoMultiGet(ctx context.Context, ids []string, index string, withSource bool, res interface{}) error
 	DoScrollRequest(ctx context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
 	DoCountRequest(ctx context.Context, index string, body []byte) (uint64, error)
+	DoSearchRequest(ctx context.Context, index string, body []byte, res interface{}) error
 	UpdateByQuery(ctx context.Context, index string, buff *bytes.Buffer) error
 
 	PutMappings(indexName string, mappings *bytes.Buffer) error
@@ -60,6 +65,21 @@ type DBBlockHandler interface {
 
 	SerializeEpochInfoData(header coreData.HeaderHandler, buffSlice *data.BufferSlice, index string) error
 	SerializeBlock(elasticBlock *data.Block, buffSlice *data.BufferSlice, index string) error
+
+	PrepareBridgeOperations(elasticBlock *data.Block) []*data.BridgeOperation
+	SerializeBridgeOperations(bridgeOperations []*data.BridgeOperation, buffSlice *data.BufferSlice, index string) error
+}
+
+// BlockProcessorCreator defines the behavior of a component that creates the block handler of a chain flavour
+type BlockProcessorCreator interface {
+	CreateBlockProcessor(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (DBBlockHandler, error)
+	IsInterfaceNil() bool
+}
+
+// AccountsProcessorCreator defines the behavior of a component that creates the accounts handler of a chain flavour
+type AccountsProcessorCreator interface {
+	CreateAccountsProcessor(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (DBAccountHandler, error)
+	IsInterfaceNil() bool
 }
 
 // DBTransactionsHandler defines the actions that a transactions handler should do
@@ -109,12 +129,24 @@ type DBLogsAndEventsHandler interface {
 	) *data.PreparedLogsResults
 
 	SerializeEvents(events []*data.LogEvent, buffSlice *data.BufferSlice, index string) error
+	SerializeCustomEvents(customEvents []*data.CustomEvent, buffSlice *data.BufferSlice) error
 	SerializeLogs(logs []*data.Logs, buffSlice *data.BufferSlice, index string) error
 	SerializeSCDeploys(deploysInfo map[string]*data.ScDeployInfo, buffSlice *data.BufferSlice, index string) error
 	SerializeChangeOwnerOperations(changeOwnerOperations map[string]*data.OwnerData, buffSlice *data.BufferSlice, index string) error
 	SerializeTokens(tokens []*data.TokenInfo, updateNFTData []*data.NFTDataUpdate, buffSlice *data.BufferSlice, index string) error
 	SerializeDelegators(delegators map[string]*data.Delegator, buffSlice *data.BufferSlice, index string) error
+	SerializeProviders(providers map[string]*data.StakingProvider, buffSlice *data.BufferSlice, index string) error
+	SerializeNodes(nodes map[string]*data.StakingNode, buffSlice *data.BufferSlice, index string) error
 	SerializeSupplyData(tokensSupply data.TokensHandler, buffSlice *data.BufferSlice, index string) error
+	SerializeCollectionsStats(collectionsStats map[string]*data.CollectionStats, buffSlice *data.BufferSlice, index string) error
+	PrepareCollectionsStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	GetBurnedNFTsIdentifiers(tokensSupply data.TokensHandler) []string
+	AddBurnedNFTsInCollectionsStats(
+		burnedNFTs *data.ResponseTokenInfo,
+		collectionsStats map[string]*data.CollectionStats,
+		timestamp uint64,
+		shardID uint32,
+	)
 	SerializeRolesData(
 		tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties,
 		buffSlice *data.BufferSlice,
@@ -129,8 +161,140 @@ type OperationsHandler interface {
 	SerializeSCRs(scrs []*data.ScResult, buffSlice *data.BufferSlice, index string, shardID uint32) error
 }
 
+// TransfersHandler defines the actions that a transfers' handler should do
+type TransfersHandler interface {
+	PrepareTransfers(txs []*data.Transaction, scrs []*data.ScResult, events []*data.LogEvent, selfShardID uint32, numOfShards uint32) []*data.Transfer
+	SerializeTransfers(transfers []*data.Transfer, buffSlice *data.BufferSlice, index string) error
+}
+
+// ContractCallsHandler defines the actions that a contract calls' handler should do
+type ContractCallsHandler interface {
+	PrepareContractCalls(txs []*data.Transaction, scrs []*data.ScResult, events []*data.LogEvent, selfShardID uint32) []*data.ContractCall
+	PrepareContractCallsStats(calls []*data.ContractCall, timestamp uint64, shardID uint32) *data.ContractCallsStats
+	ComputeUniqueCallers(stats *data.ContractCallsStats, existingCallers *data.ResponseContractCallStats)
+	ComputeRemovedCallers(stats *data.ContractCallsStats, existingCallers *data.ResponseContractCallStats)
+
+	SerializeContractCalls(calls []*data.ContractCall, buffSlice *data.BufferSlice, index string) error
+	SerializeContractCallsStats(stats *data.ContractCallsStats, buffSlice *data.BufferSlice, index string) error
+	SerializeContractCallsStatsInCaseOfRevert(stats *data.ContractCallsStats, buffSlice *data.BufferSlice, index string) error
+}
+
+// TxTracesHandler defines the actions that a transaction traces' handler should do
+type TxTracesHandler interface {
+	PrepareTxTraces(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults, timestamp uint64, selfShardID uint32) map[string]*data.TxTrace
+	SerializeTxTraces(traces map[string]*data.TxTrace, buffSlice *data.BufferSlice, index string) error
+	PrepareTxTracesQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+}
+
+// TxStatusHistoryHandler defines the actions that a transaction status history handler should do
+type TxStatusHistoryHandler interface {
+	PrepareStatusTransitions(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults, headerHash string, timestamp uint64, selfShardID uint32) []*data.TxStatusTransition
+	PrepareRevertTransitions(txHashes []string, scrHashes []string, headerHash string, timestamp uint64, shardID uint32) []*data.TxStatusTransition
+	SerializeStatusTransitions(transitions []*data.TxStatusTransition, buffSlice *data.BufferSlice, index string) error
+}
+
+// UsernamesHandler defines the actions that a usernames registry handler should do
+type UsernamesHandler interface {
+	ExtractUsernames(preparedResults *data.PreparedResults, timestamp uint64, selfShardID uint32) []*data.Username
+	SerializeUsernames(usernames []*data.Username, buffSlice *data.BufferSlice, index string) error
+	PrepareSupersededUsernamesQuery(usernames []*data.Username) *bytes.Buffer
+	PrepareUsernamesQueryInCaseOfRevert(timestamp uint64, shardID uint32) []byte
+	RevertUsernames(usernames []*data.Username, timestamp uint64, shardID uint32) map[string]string
+	SerializeRevertedUsernames(usernames []*data.Username, buffSlice *data.BufferSlice, index string) error
+	SerializeAccountsUsernamesInCaseOfRevert(accountsUsernames map[string]string, buffSlice *data.BufferSlice, index string) error
+}
+
+// GuardiansHandler defines the actions that an accounts guardians handler should do
+type GuardiansHandler interface {
+	ExtractGuardianChanges(preparedResults *data.PreparedResults, events []*data.LogEvent, epoch uint32, selfShardID uint32) []*data.GuardianChange
+	SerializeGuardianChanges(changes []*data.GuardianChange, buffSlice *data.BufferSlice, index string) error
+	SerializeAccountsGuardians(changes []*data.GuardianChange, buffSlice *data.BufferSlice, index string) error
+	PreparePendingGuardiansQuery(epoch uint32, shardID uint32) *bytes.Buffer
+	PrepareAccountsPendingGuardiansQuery(epoch uint32, shardID uint32) *bytes.Buffer
+}
+
+// AddressActivityHandler defines the actions that an addresses activity handler should do
+type AddressActivityHandler interface {
+	PrepareAddressesActivity(
+		txs []*data.Transaction,
+		scrs []*data.ScResult,
+		coreAlteredAccounts map[string]*alteredAccount.AlteredAccount,
+		timestamp uint64,
+		selfShardID uint32,
+	) map[string]*data.AddressActivity
+	PrepareAddressesActivityQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+
+	SerializeAddressesActivity(activities map[string]*data.AddressActivity, buffSlice *data.BufferSlice, index string) error
+}
+
+// TokenHoldersHandler defines the actions that a token holders handler should do
+type TokenHoldersHandler interface {
+	GetAccountsDCDTIDs(accounts map[string]*data.AccountInfo) []string
+	PrepareTokensHolders(accounts map[string]*data.AccountInfo, indexedAccounts *data.ResponseAccountsDCDT) map[string]*data.TokenHolders
+	PrepareCollectionsAccountsChanges(accounts map[string]*data.AccountInfo, indexedAccounts *data.ResponseAccountsDCDT) []*data.CollectionAccountChange
+	PrepareCollectionsHeldQuery(changes []*data.CollectionAccountChange) []byte
+	PrepareCollectionsHolders(
+		changes []*data.CollectionAccountChange,
+		collectionsHeld *data.ResponseCollectionsHeld,
+		timestamp uint64,
+		shardID uint32,
+	) map[string]*data.CollectionStats
+	PrepareCollectionsHoldersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+
+	SerializeTokensHolders(tokensHolders map[string]*data.TokenHolders, buffSlice *data.BufferSlice, index string) error
+	SerializeCollectionsHolders(collectionsHolders map[string]*data.CollectionStats, buffSlice *data.BufferSlice, index string) error
+}
+
 // IndexTokensHandler defines what index tokens handler should be able to do
 type IndexTokensHandler interface {
 	IndexCrossChainTokens(handler DatabaseClientHandler, scrs []*data.ScResult, buffSlice *data.BufferSlice) error
 	IsInterfaceNil() bool
 }
+
+// BridgeTransfersHandler defines what a bridge transfers handler should be able to do
+type BridgeTransfersHandler interface {
+	PrepareBridgeTransfers(scrs []*data.ScResult, events []*data.LogEvent, timestamp uint64, shardID uint32) *data.PreparedBridgeTransfers
+	SerializeBridgeTransfers(prepared *data.PreparedBridgeTransfers, buffSlice *data.BufferSlice, index string) error
+	PrepareConfirmationQuery(confirmation *data.BridgeTransferConfirmation) (*bytes.Buffer, error)
+	PrepareConfirmationsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	IsInterfaceNil() bool
+}
+
+// GaugeMetricsHandler defines what a component that exposes the current value of a metric should be able to do
+type GaugeMetricsHandler interface {
+	SetGauge(name string, value uint64)
+	IsInterfaceNil() bool
+}
+
+// AbiDecoderHandler defines what a component that decodes the contracts data with their ABI should be able to do
+type AbiDecoderHandler interface {
+	DecodeData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults, alteredAccounts map[string]*alteredAccount.AlteredAccount)
+	IsInterfaceNil() bool
+}
+
+// SecondaryAddressEncoderHandler defines what a component that stores the addresses in a secondary encoding should be able to do
+type SecondaryAddressEncoderHandler interface {
+	EncodeTransactionsData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults)
+	EncodeAccounts(accounts map[string]*data.AccountInfo)
+	IsInterfaceNil() bool
+}
+
+// AddressesFilterHandler defines what a component that keeps only the data involving a set of addresses should be able to do
+type AddressesFilterHandler interface {
+	FilterTransactionsData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults)
+	FilterAlteredAccounts(alteredAccounts map[string]*alteredAccount.AlteredAccount) map[string]*alteredAccount.AlteredAccount
+	IsInterfaceNil() bool
+}
+
+// PayloadsOffloaderHandler defines what a component that moves the large data fields into a separate index should be able to do
+type PayloadsOffloaderHandler interface {
+	OffloadPayloads(preparedResults *data.PreparedResults, timestamp uint64) map[string]*data.Payload
+	SerializePayloads(payloads map[string]*data.Payload, buffSlice *data.BufferSlice, index string) error
+	IsInterfaceNil() bool
+}
+
+// DataPublisher defines what a component that forwards the documents of an indexed block should be able to do
+type DataPublisher interface {
+	Publish(indexedData *data.IndexedBlockData)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,127 @@
+package logsevents
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type collectionStatsBuilder struct {
+	minted       *big.Int
+	burned       *big.Int
+	nftsWithURIs int64
+	firstMint    uint64
+	lastMint     uint64
+}
+
+// collectionsStats accumulates the NFTs minted and burned in a block for every collection
+type collectionsStats struct {
+	builders map[string]*collectionStatsBuilder
+}
+
+func newCollectionsStats() *collectionsStats {
+	return &collectionsStats{
+		builders: make(map[string]*collectionStatsBuilder),
+	}
+}
+
+func (cs *collectionsStats) getBuilder(collection string) *collectionStatsBuilder {
+	builder, found := cs.builders[collection]
+	if found {
+		return builder
+	}
+
+	builder = &collectionStatsBuilder{
+		minted: big.NewInt(0),
+		burned: big.NewInt(0),
+	}
+	cs.builders[collection] = builder
+
+	return builder
+}
+
+func (cs *collectionsStats) addMint(collection string, quantity *big.Int, hasURIs bool, timestamp uint64) {
+	builder := cs.getBuilder(collection)
+	builder.minted.Add(builder.minted, quantity)
+	if hasURIs {
+		builder.nftsWithURIs++
+	}
+	if builder.firstMint == 0 || timestamp < builder.firstMint {
+		builder.firstMint = timestamp
+	}
+	if timestamp > builder.lastMint {
+		builder.lastMint = timestamp
+	}
+}
+
+func (cs *collectionsStats) addBurn(collection string, quantity *big.Int) {
+	builder := cs.getBuilder(collection)
+	builder.burned.Add(builder.burned, quantity)
+}
+
+func (cs *collectionsStats) getAll(timestamp uint64, shardID uint32) map[string]*data.CollectionStats {
+	stats := make(map[string]*data.CollectionStats, len(cs.builders))
+	for collection, builder := range cs.builders {
+		stats[collection] = &data.CollectionStats{
+			Collection:         collection,
+			Minted:             builder.minted.String(),
+			Burned:             builder.burned.String(),
+			Supply:             big.NewInt(0).Sub(builder.minted, builder.burned).String(),
+			NFTsWithURIs:       builder.nftsWithURIs,
+			FirstMintTimestamp: time.Duration(builder.firstMint),
+			LastMintTimestamp:  time.Duration(builder.lastMint),
+			ShardID:            shardID,
+			Timestamp:          time.Duration(timestamp),
+		}
+	}
+
+	return stats
+}
+
+// GetBurnedNFTsIdentifiers will return the identifiers of the burned NFTs whose documents are deleted from the tokens index
+func (lep *logsAndEventsProcessor) GetBurnedNFTsIdentifiers(tokensSupply data.TokensHandler) []string {
+	identifiers := make([]string, 0)
+	if check.IfNil(tokensSupply) {
+		return identifiers
+	}
+
+	for _, supplyData := range tokensSupply.GetAll() {
+		if isNFTDeletedOnBurn(supplyData.Type) {
+			identifiers = append(identifiers, supplyData.Identifier)
+		}
+	}
+
+	return identifiers
+}
+
+// AddBurnedNFTsInCollectionsStats will decrement the number of NFTs with non-empty URIs of the collections for the
+// provided burned NFTs
+func (lep *logsAndEventsProcessor) AddBurnedNFTsInCollectionsStats(
+	burnedNFTs *data.ResponseTokenInfo,
+	collectionsStats map[string]*data.CollectionStats,
+	timestamp uint64,
+	shardID uint32,
+) {
+	for _, doc := range burnedNFTs.Docs {
+		shouldSkip := !doc.Found || doc.Source.Token == "" || doc.Source.Data == nil || !doc.Source.Data.NonEmptyURIs
+		if shouldSkip {
+			continue
+		}
+
+		stats, found := collectionsStats[doc.Source.Token]
+		if !found {
+			stats = &data.CollectionStats{
+				Collection: doc.Source.Token,
+				Minted:     "0",
+				Burned:     "0",
+				Supply:     "0",
+				ShardID:    shardID,
+				Timestamp:  time.Duration(timestamp),
+			}
+			collectionsStats[doc.Source.Token] = stats
+		}
+		stats.NFTsWithURIs--
+	}
+}
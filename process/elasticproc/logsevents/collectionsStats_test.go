@@ -0,0 +1,93 @@
+package logsevents
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionsStats_GetAll(t *testing.T) {
+	t.Parallel()
+
+	stats := newCollectionsStats()
+	stats.addMint("NFT-abcd", big.NewInt(1), true, 2000)
+	stats.addMint("NFT-abcd", big.NewInt(1), false, 1000)
+	stats.addMint("SFT-abcd", big.NewInt(10), false, 1500)
+	stats.addBurn("NFT-abcd", big.NewInt(1))
+	stats.addBurn("SFT-abcd", big.NewInt(3))
+
+	all := stats.getAll(3000, 1)
+	require.Len(t, all, 2)
+	require.Equal(t, &data.CollectionStats{
+		Collection:         "NFT-abcd",
+		Minted:             "2",
+		Burned:             "1",
+		Supply:             "1",
+		NFTsWithURIs:       1,
+		FirstMintTimestamp: 1000,
+		LastMintTimestamp:  2000,
+		ShardID:            1,
+		Timestamp:          3000,
+	}, all["NFT-abcd"])
+	require.Equal(t, "7", all["SFT-abcd"].Supply)
+	require.Equal(t, time.Duration(1500), all["SFT-abcd"].FirstMintTimestamp)
+}
+
+func TestCollectionsStats_BurnWithoutMint(t *testing.T) {
+	t.Parallel()
+
+	stats := newCollectionsStats()
+	stats.addBurn("NFT-abcd", big.NewInt(1))
+
+	all := stats.getAll(3000, 0)
+	require.Equal(t, "-1", all["NFT-abcd"].Supply)
+	require.Equal(t, "0", all["NFT-abcd"].Minted)
+	require.Equal(t, time.Duration(0), all["NFT-abcd"].FirstMintTimestamp)
+}
+
+func TestLogsAndEventsProcessor_GetBurnedNFTsIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	lep, _ := NewLogsAndEventsProcessor(createMockArgs())
+
+	tokensSupply := data.NewTokensInfo()
+	tokensSupply.Add(&data.TokenInfo{Token: "NFT-abcd", Identifier: "NFT-abcd-01", Type: core.NonFungibleDCDT})
+	tokensSupply.Add(&data.TokenInfo{Token: "SFT-abcd", Identifier: "SFT-abcd-01", Type: core.SemiFungibleDCDT})
+
+	require.Equal(t, []string{"NFT-abcd-01"}, lep.GetBurnedNFTsIdentifiers(tokensSupply))
+	require.Empty(t, lep.GetBurnedNFTsIdentifiers(nil))
+}
+
+func TestLogsAndEventsProcessor_AddBurnedNFTsInCollectionsStats(t *testing.T) {
+	t.Parallel()
+
+	lep, _ := NewLogsAndEventsProcessor(createMockArgs())
+
+	burnedNFTs := &data.ResponseTokenInfo{
+		Docs: []data.ResponseTokenInfoDB{
+			{Found: true, ID: "NFT-abcd-01", Source: data.TokenInfo{Token: "NFT-abcd", Data: &data.TokenMetaData{NonEmptyURIs: true}}},
+			{Found: true, ID: "NFT-abcd-02", Source: data.TokenInfo{Token: "NFT-abcd", Data: &data.TokenMetaData{}}},
+			{Found: true, ID: "OTHER-abcd-01", Source: data.TokenInfo{Token: "OTHER-abcd", Data: &data.TokenMetaData{NonEmptyURIs: true}}},
+			{Found: false, ID: "NFT-abcd-03"},
+		},
+	}
+	collectionsStats := map[string]*data.CollectionStats{
+		"NFT-abcd": {Collection: "NFT-abcd", Minted: "0", Burned: "2", Supply: "-2", NFTsWithURIs: 0},
+	}
+
+	lep.AddBurnedNFTsInCollectionsStats(burnedNFTs, collectionsStats, 5000, 1)
+	require.Equal(t, int64(-1), collectionsStats["NFT-abcd"].NFTsWithURIs)
+	require.Equal(t, &data.CollectionStats{
+		Collection:   "OTHER-abcd",
+		Minted:       "0",
+		Burned:       "0",
+		Supply:       "0",
+		NFTsWithURIs: -1,
+		ShardID:      1,
+		Timestamp:    5000,
+	}, collectionsStats["OTHER-abcd"])
+}
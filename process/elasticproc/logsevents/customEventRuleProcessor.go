@@ -0,0 +1,206 @@
+package logsevents
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	coreData "github.com/TerraDharitri/drt-go-chain-core/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+const (
+	hexTopicDecoder     = "hex"
+	bigIntTopicDecoder  = "bigint"
+	addressTopicDecoder = "address"
+	stringTopicDecoder  = "string"
+	boolTopicDecoder    = "bool"
+
+	eventIDField        = "eventID"
+	txHashField         = "txHash"
+	originalTxHashField = "originalTxHash"
+	addressField        = "address"
+	identifierField     = "identifier"
+	timestampField      = "timestamp"
+	shardIDField        = "shardID"
+	orderField          = "order"
+
+	defaultIDTemplate = "{" + eventIDField + "}"
+)
+
+var (
+	placeholderRegex = regexp.MustCompile(`\{([a-zA-Z0-9_]+)\}`)
+	reservedFields   = map[string]struct{}{
+		eventIDField:        {},
+		txHashField:         {},
+		originalTxHashField: {},
+		addressField:        {},
+		identifierField:     {},
+		timestampField:      {},
+		shardIDField:        {},
+		orderField:          {},
+	}
+)
+
+// CustomEventTopic describes how a topic of a custom event is decoded. A topic with an empty name is skipped
+type CustomEventTopic struct {
+	Name    string
+	Decoder string
+}
+
+// CustomEventRule describes the events that are saved as documents in a custom index
+type CustomEventRule struct {
+	Name       string
+	Identifier string
+	Contract   string
+	Index      string
+	IDTemplate string
+	Topics     []CustomEventTopic
+}
+
+type customEventRuleProcessor struct {
+	rule            CustomEventRule
+	pubKeyConverter core.PubkeyConverter
+}
+
+func newCustomEventRuleProcessor(rule CustomEventRule, pubKeyConverter core.PubkeyConverter) (*customEventRuleProcessor, error) {
+	err := checkCustomEventRule(&rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customEventRuleProcessor{
+		rule:            rule,
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+func checkCustomEventRule(rule *CustomEventRule) error {
+	if rule.Name == "" {
+		return fmt.Errorf("%w: empty name", dataindexer.ErrInvalidCustomEventRule)
+	}
+	if rule.Identifier == "" {
+		return fmt.Errorf("%w: empty identifier for rule %s", dataindexer.ErrInvalidCustomEventRule, rule.Name)
+	}
+	if rule.Index == "" || strings.ToLower(rule.Index) != rule.Index {
+		return fmt.Errorf("%w: the index of rule %s should be a non-empty lowercase name", dataindexer.ErrInvalidCustomEventRule, rule.Name)
+	}
+
+	fields := make(map[string]struct{}, len(reservedFields)+len(rule.Topics))
+	for field := range reservedFields {
+		fields[field] = struct{}{}
+	}
+	for _, topic := range rule.Topics {
+		if topic.Name == "" {
+			continue
+		}
+		_, exists := fields[topic.Name]
+		if exists {
+			return fmt.Errorf("%w: duplicated or reserved topic name %s for rule %s", dataindexer.ErrInvalidCustomEventRule, topic.Name, rule.Name)
+		}
+		if !isKnownTopicDecoder(topic.Decoder) {
+			return fmt.Errorf("%w: unknown decoder %s for rule %s", dataindexer.ErrInvalidCustomEventRule, topic.Decoder, rule.Name)
+		}
+		fields[topic.Name] = struct{}{}
+	}
+
+	if rule.IDTemplate == "" {
+		rule.IDTemplate = defaultIDTemplate
+	}
+	for _, match := range placeholderRegex.FindAllStringSubmatch(rule.IDTemplate, -1) {
+		_, exists := fields[match[1]]
+		if !exists {
+			return fmt.Errorf("%w: unknown placeholder %s in the id template of rule %s", dataindexer.ErrInvalidCustomEventRule, match[0], rule.Name)
+		}
+	}
+
+	return nil
+}
+
+func isKnownTopicDecoder(decoder string) bool {
+	switch decoder {
+	case hexTopicDecoder, bigIntTopicDecoder, addressTopicDecoder, stringTopicDecoder, boolTopicDecoder:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProcessEvent will return a document with the decoded topics if the event matches the rule
+func (cerp *customEventRuleProcessor) ProcessEvent(event coreData.EventHandler, dbEvent *data.LogEvent) []*data.CustomEvent {
+	if dbEvent.Identifier != cerp.rule.Identifier {
+		return nil
+	}
+	if cerp.rule.Contract != "" && dbEvent.Address != cerp.rule.Contract {
+		return nil
+	}
+
+	fields := map[string]interface{}{
+		eventIDField:    dbEvent.ID,
+		txHashField:     dbEvent.TxHash,
+		addressField:    dbEvent.Address,
+		identifierField: dbEvent.Identifier,
+		timestampField:  uint64(dbEvent.Timestamp),
+		shardIDField:    dbEvent.ShardID,
+		orderField:      dbEvent.Order,
+	}
+	if dbEvent.OriginalTxHash != "" {
+		fields[originalTxHashField] = dbEvent.OriginalTxHash
+	}
+
+	topics := event.GetTopics()
+	for idx, topic := range cerp.rule.Topics {
+		if topic.Name == "" || idx >= len(topics) {
+			continue
+		}
+		fields[topic.Name] = cerp.decodeTopic(topic.Decoder, topics[idx])
+	}
+
+	return []*data.CustomEvent{
+		{
+			Index:  cerp.rule.Index,
+			ID:     createDocumentID(cerp.rule.IDTemplate, fields),
+			Fields: fields,
+		},
+	}
+}
+
+// GetIndices returns the index where the documents of the rule are saved
+func (cerp *customEventRuleProcessor) GetIndices() []string {
+	return []string{cerp.rule.Index}
+}
+
+func (cerp *customEventRuleProcessor) decodeTopic(decoder string, topic []byte) interface{} {
+	switch decoder {
+	case bigIntTopicDecoder:
+		return big.NewInt(0).SetBytes(topic).String()
+	case addressTopicDecoder:
+		return cerp.pubKeyConverter.SilentEncode(topic, log)
+	case stringTopicDecoder:
+		return string(topic)
+	case boolTopicDecoder:
+		return big.NewInt(0).SetBytes(topic).Sign() != 0
+	default:
+		return hex.EncodeToString(topic)
+	}
+}
+
+func createDocumentID(template string, fields map[string]interface{}) string {
+	return placeholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		value, exists := fields[placeholder[1:len(placeholder)-1]]
+		if !exists {
+			return ""
+		}
+
+		return fmt.Sprint(value)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cerp *customEventRuleProcessor) IsInterfaceNil() bool {
+	return cerp == nil
+}
@@ -0,0 +1,148 @@
+package logsevents
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/stretchr/testify/require"
+)
+
+func createCustomEventRule() CustomEventRule {
+	return CustomEventRule{
+		Name:       "deposits",
+		Identifier: "deposit",
+		Contract:   "627269646765",
+		Index:      "bridge-deposits",
+		IDTemplate: "{txHash}-{depositNonce}",
+		Topics: []CustomEventTopic{
+			{Name: "", Decoder: hexTopicDecoder},
+			{Name: "depositNonce", Decoder: bigIntTopicDecoder},
+			{Name: "recipient", Decoder: addressTopicDecoder},
+			{Name: "token", Decoder: stringTopicDecoder},
+			{Name: "isRefund", Decoder: boolTopicDecoder},
+			{Name: "payload", Decoder: hexTopicDecoder},
+		},
+	}
+}
+
+func TestNewCustomEventRuleProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name", func(t *testing.T) {
+		rule := createCustomEventRule()
+		rule.Name = ""
+
+		proc, err := newCustomEventRuleProcessor(rule, &mock.PubkeyConverterMock{})
+		require.Nil(t, proc)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("empty identifier", func(t *testing.T) {
+		rule := createCustomEventRule()
+		rule.Identifier = ""
+
+		proc, err := newCustomEventRuleProcessor(rule, &mock.PubkeyConverterMock{})
+		require.Nil(t, proc)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		rule := createCustomEventRule()
+		rule.Index = "Deposits"
+
+		proc, err := newCustomEventRuleProcessor(rule, &mock.PubkeyConverterMock{})
+		require.Nil(t, proc)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("reserved topic name", func(t *testing.T) {
+		rule := createCustomEventRule()
+		rule.Topics[1].Name = txHashField
+
+		proc, err := newCustomEventRuleProcessor(rule, &mock.PubkeyConverterMock{})
+		require.Nil(t, proc)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("unknown decoder", func(t *testing.T) {
+		rule := createCustomEventRule()
+		rule.Topics[1].Decoder = "base64"
+
+		proc, err := newCustomEventRuleProcessor(rule, &mock.PubkeyConverterMock{})
+		require.Nil(t, proc)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("unknown placeholder", func(t *testing.T) {
+		rule := createCustomEventRule()
+		rule.IDTemplate = "{txHash}-{nonce}"
+
+		proc, err := newCustomEventRuleProcessor(rule, &mock.PubkeyConverterMock{})
+		require.Nil(t, proc)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("empty id template should use the event ID", func(t *testing.T) {
+		rule := createCustomEventRule()
+		rule.IDTemplate = ""
+
+		proc, err := newCustomEventRuleProcessor(rule, &mock.PubkeyConverterMock{})
+		require.Nil(t, err)
+		require.Equal(t, defaultIDTemplate, proc.rule.IDTemplate)
+		require.False(t, proc.IsInterfaceNil())
+	})
+}
+
+func TestCustomEventRuleProcessor_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	proc, _ := newCustomEventRuleProcessor(createCustomEventRule(), &mock.PubkeyConverterMock{})
+
+	event := &transaction.Event{
+		Identifier: []byte("deposit"),
+		Topics: [][]byte{
+			[]byte("deposit"),
+			big.NewInt(15).Bytes(),
+			[]byte("receiver"),
+			[]byte("WREWA-abcd"),
+			{1},
+		},
+	}
+	dbEvent := &data.LogEvent{
+		ID:         "hash-0-1",
+		TxHash:     "hash",
+		Address:    "627269646765",
+		Identifier: "deposit",
+		Timestamp:  5040,
+		ShardID:    0,
+		Order:      1,
+	}
+
+	customEvents := proc.ProcessEvent(event, dbEvent)
+	require.Equal(t, []*data.CustomEvent{
+		{
+			Index: "bridge-deposits",
+			ID:    "hash-15",
+			Fields: map[string]interface{}{
+				eventIDField:    "hash-0-1",
+				txHashField:     "hash",
+				addressField:    "627269646765",
+				identifierField: "deposit",
+				timestampField:  uint64(5040),
+				shardIDField:    uint32(0),
+				orderField:      1,
+				"depositNonce":  "15",
+				"recipient":     "7265636569766572",
+				"token":         "WREWA-abcd",
+				"isRefund":      true,
+			},
+		},
+	}, customEvents)
+
+	otherContractEvent := *dbEvent
+	otherContractEvent.Address = "6f74686572"
+	require.Nil(t, proc.ProcessEvent(event, &otherContractEvent))
+
+	otherIdentifierEvent := *dbEvent
+	otherIdentifierEvent.Identifier = "withdraw"
+	require.Nil(t, proc.ProcessEvent(event, &otherIdentifierEvent))
+}
@@ -0,0 +1,155 @@
+package logsevents
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+// CustomEventsProcessorCreator defines the function that creates a compiled-in custom events processor
+type CustomEventsProcessorCreator func(pubKeyConverter core.PubkeyConverter) (CustomEventsProcessor, error)
+
+var (
+	mutCreators sync.RWMutex
+	creators    = make(map[string]CustomEventsProcessorCreator)
+)
+
+// RegisterCustomEventsProcessorCreator will register a compiled-in custom events processor under the provided name. It
+// should be called from the init function of the package that implements the processor, which is enabled by adding its
+// name in the custom events plugins list from the config
+func RegisterCustomEventsProcessorCreator(name string, creator CustomEventsProcessorCreator) error {
+	if creator == nil {
+		return dataindexer.ErrNilCustomEventsProcessor
+	}
+
+	mutCreators.Lock()
+	defer mutCreators.Unlock()
+
+	_, found := creators[name]
+	if found {
+		return fmt.Errorf("%w: %s", dataindexer.ErrCustomEventsProcessorAlreadyRegistered, name)
+	}
+	creators[name] = creator
+
+	return nil
+}
+
+func getCustomEventsProcessorCreator(name string) (CustomEventsProcessorCreator, bool) {
+	mutCreators.RLock()
+	defer mutCreators.RUnlock()
+
+	creator, found := creators[name]
+	return creator, found
+}
+
+// ArgsCustomEventsRegistry holds all dependencies required to create a new instance of customEventsRegistry. The
+// reserved indices are the built-in indexes of the indexer, which cannot be used by the custom events processors
+type ArgsCustomEventsRegistry struct {
+	PubKeyConverter core.PubkeyConverter
+	EnabledPlugins  []string
+	Rules           []CustomEventRule
+	ReservedIndices []string
+}
+
+type customEventsRegistry struct {
+	processors []CustomEventsProcessor
+}
+
+// NewCustomEventsRegistry will create a registry with the enabled compiled-in processors and a processor for every rule.
+// The custom indices cannot be built-in indexes, as their documents are removed by timestamp on revert, and every rule
+// has its own index
+func NewCustomEventsRegistry(args ArgsCustomEventsRegistry) (*customEventsRegistry, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+
+	reservedIndices := make(map[string]string, len(args.ReservedIndices)+len(args.Rules))
+	for _, index := range args.ReservedIndices {
+		reservedIndices[index] = "the built-in indexes"
+	}
+
+	processors := make([]CustomEventsProcessor, 0, len(args.EnabledPlugins)+len(args.Rules))
+	for _, pluginName := range args.EnabledPlugins {
+		creator, found := getCustomEventsProcessorCreator(pluginName)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", dataindexer.ErrUnknownCustomEventsProcessor, pluginName)
+		}
+
+		proc, err := creator(args.PubKeyConverter)
+		if err != nil {
+			return nil, fmt.Errorf("%w while creating the custom events processor %s", err, pluginName)
+		}
+		if check.IfNil(proc) {
+			return nil, fmt.Errorf("%w: %s", dataindexer.ErrNilCustomEventsProcessor, pluginName)
+		}
+		err = checkIndicesNotReserved(reservedIndices, proc.GetIndices(), "plugin "+pluginName)
+		if err != nil {
+			return nil, err
+		}
+
+		processors = append(processors, proc)
+	}
+
+	for _, rule := range args.Rules {
+		proc, err := newCustomEventRuleProcessor(rule, args.PubKeyConverter)
+		if err != nil {
+			return nil, err
+		}
+		err = checkIndicesNotReserved(reservedIndices, proc.GetIndices(), "rule "+rule.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, index := range proc.GetIndices() {
+			reservedIndices[index] = "rule " + rule.Name
+		}
+
+		processors = append(processors, proc)
+	}
+
+	return &customEventsRegistry{
+		processors: processors,
+	}, nil
+}
+
+func checkIndicesNotReserved(reservedIndices map[string]string, indices []string, owner string) error {
+	for _, index := range indices {
+		reservedBy, found := reservedIndices[index]
+		if found {
+			return fmt.Errorf("%w: the index %s of %s is already used by %s", dataindexer.ErrInvalidCustomEventRule, index, owner, reservedBy)
+		}
+	}
+
+	return nil
+}
+
+// GetProcessors returns all the custom events processors
+func (cer *customEventsRegistry) GetProcessors() []CustomEventsProcessor {
+	return cer.processors
+}
+
+// GetIndices returns the sorted list of the custom indices where the processors save documents
+func (cer *customEventsRegistry) GetIndices() []string {
+	indicesMap := make(map[string]struct{})
+	for _, proc := range cer.processors {
+		for _, index := range proc.GetIndices() {
+			indicesMap[index] = struct{}{}
+		}
+	}
+
+	indices := make([]string, 0, len(indicesMap))
+	for index := range indicesMap {
+		indices = append(indices, index)
+	}
+	sort.Strings(indices)
+
+	return indices
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cer *customEventsRegistry) IsInterfaceNil() bool {
+	return cer == nil
+}
@@ -0,0 +1,155 @@
+package logsevents
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	coreData "github.com/TerraDharitri/drt-go-chain-core/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/stretchr/testify/require"
+)
+
+type customEventsProcessorStub struct {
+	processEventCalled func(event coreData.EventHandler, dbEvent *data.LogEvent) []*data.CustomEvent
+	indices            []string
+}
+
+func (ceps *customEventsProcessorStub) ProcessEvent(event coreData.EventHandler, dbEvent *data.LogEvent) []*data.CustomEvent {
+	if ceps.processEventCalled != nil {
+		return ceps.processEventCalled(event, dbEvent)
+	}
+
+	return nil
+}
+
+func (ceps *customEventsProcessorStub) GetIndices() []string {
+	return ceps.indices
+}
+
+func (ceps *customEventsProcessorStub) IsInterfaceNil() bool {
+	return ceps == nil
+}
+
+func TestRegisterCustomEventsProcessorCreator(t *testing.T) {
+	t.Parallel()
+
+	err := RegisterCustomEventsProcessorCreator("nil-creator", nil)
+	require.Equal(t, dataindexer.ErrNilCustomEventsProcessor, err)
+
+	creator := func(_ core.PubkeyConverter) (CustomEventsProcessor, error) {
+		return &customEventsProcessorStub{}, nil
+	}
+	err = RegisterCustomEventsProcessorCreator("test-register", creator)
+	require.Nil(t, err)
+
+	err = RegisterCustomEventsProcessorCreator("test-register", creator)
+	require.True(t, errors.Is(err, dataindexer.ErrCustomEventsProcessorAlreadyRegistered))
+}
+
+func TestNewCustomEventsRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter", func(t *testing.T) {
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{})
+		require.Nil(t, registry)
+		require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+	})
+	t.Run("unknown plugin", func(t *testing.T) {
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			EnabledPlugins:  []string{"unknown"},
+		})
+		require.Nil(t, registry)
+		require.True(t, errors.Is(err, dataindexer.ErrUnknownCustomEventsProcessor))
+	})
+	t.Run("plugin creator error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		_ = RegisterCustomEventsProcessorCreator("test-creator-error", func(_ core.PubkeyConverter) (CustomEventsProcessor, error) {
+			return nil, expectedErr
+		})
+
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			EnabledPlugins:  []string{"test-creator-error"},
+		})
+		require.Nil(t, registry)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("invalid rule", func(t *testing.T) {
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			Rules:           []CustomEventRule{{Name: "swaps"}},
+		})
+		require.Nil(t, registry)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("rule on a built-in index", func(t *testing.T) {
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			Rules:           []CustomEventRule{{Name: "swaps", Identifier: "swap", Index: "transactions"}},
+			ReservedIndices: []string{"transactions", "tokens"},
+		})
+		require.Nil(t, registry)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("plugin on a built-in index", func(t *testing.T) {
+		_ = RegisterCustomEventsProcessorCreator("test-plugin-built-in-index", func(_ core.PubkeyConverter) (CustomEventsProcessor, error) {
+			return &customEventsProcessorStub{indices: []string{"tokens"}}, nil
+		})
+
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			EnabledPlugins:  []string{"test-plugin-built-in-index"},
+			ReservedIndices: []string{"transactions", "tokens"},
+		})
+		require.Nil(t, registry)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("rules on the same index", func(t *testing.T) {
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			Rules: []CustomEventRule{
+				{Name: "swaps", Identifier: "swap", Index: "swaps"},
+				{Name: "other-swaps", Identifier: "swapTokens", Index: "swaps"},
+			},
+		})
+		require.Nil(t, registry)
+		require.True(t, errors.Is(err, dataindexer.ErrInvalidCustomEventRule))
+	})
+	t.Run("should work", func(t *testing.T) {
+		_ = RegisterCustomEventsProcessorCreator("test-plugin", func(_ core.PubkeyConverter) (CustomEventsProcessor, error) {
+			return &customEventsProcessorStub{}, nil
+		})
+
+		registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+			PubKeyConverter: &mock.PubkeyConverterMock{},
+			EnabledPlugins:  []string{"test-plugin"},
+			Rules:           []CustomEventRule{{Name: "swaps", Identifier: "swap", Index: "swaps"}},
+		})
+		require.Nil(t, err)
+		require.False(t, registry.IsInterfaceNil())
+		require.Len(t, registry.GetProcessors(), 2)
+	})
+}
+
+func TestCustomEventsRegistry_GetIndices(t *testing.T) {
+	t.Parallel()
+
+	_ = RegisterCustomEventsProcessorCreator("test-plugin-indices", func(_ core.PubkeyConverter) (CustomEventsProcessor, error) {
+		return &customEventsProcessorStub{indices: []string{"swaps", "auctions"}}, nil
+	})
+
+	registry, err := NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+		PubKeyConverter: &mock.PubkeyConverterMock{},
+		EnabledPlugins:  []string{"test-plugin-indices"},
+		Rules: []CustomEventRule{
+			{Name: "swaps", Identifier: "swap", Index: "swaps"},
+			{Name: "deposits", Identifier: "deposit", Index: "deposits"},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"auctions", "deposits", "swaps"}, registry.GetIndices())
+}
@@ -46,17 +46,17 @@ func newDCDTIssueProcessor(pubkeyConverter core.PubkeyConverter) *dcdtIssueProce
 	}
 }
 
-func (eip *dcdtIssueProcessor) processEvent(args *argsProcessEvent) argOutputProcessEvent {
-	identifierStr := string(args.event.GetIdentifier())
+func (eip *dcdtIssueProcessor) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	identifierStr := string(args.Event.GetIdentifier())
 	_, ok := eip.issueOperationsIdentifiers[identifierStr]
 	if !ok {
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
-	topics := args.event.GetTopics()
+	topics := args.Event.GetTopics()
 	if len(topics) < numIssueLogTopics {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
@@ -67,8 +67,8 @@ func (eip *dcdtIssueProcessor) processEvent(args *argsProcessEvent) argOutputPro
 	// topics[3] -- token type
 	// topics[4] -- num decimals / new owner address in case of transferOwnershipFunc
 	if len(topics[0]) == 0 {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
@@ -77,7 +77,7 @@ func (eip *dcdtIssueProcessor) processEvent(args *argsProcessEvent) argOutputPro
 		numDecimals = big.NewInt(0).SetBytes(topics[4]).Uint64()
 	}
 
-	encodedAddr := eip.pubkeyConverter.SilentEncode(args.event.GetAddress(), log)
+	encodedAddr := eip.pubkeyConverter.SilentEncode(args.Event.GetAddress(), log)
 
 	tokenInfo := &data.TokenInfo{
 		Token:        string(topics[0]),
@@ -87,11 +87,11 @@ func (eip *dcdtIssueProcessor) processEvent(args *argsProcessEvent) argOutputPro
 		NumDecimals:  numDecimals,
 		Issuer:       encodedAddr,
 		CurrentOwner: encodedAddr,
-		Timestamp:    time.Duration(args.timestamp),
+		Timestamp:    time.Duration(args.Timestamp),
 		OwnersHistory: []*data.OwnerData{
 			{
 				Address:   encodedAddr,
-				Timestamp: time.Duration(args.timestamp),
+				Timestamp: time.Duration(args.Timestamp),
 			},
 		},
 		Properties: &data.TokenProperties{},
@@ -108,8 +108,8 @@ func (eip *dcdtIssueProcessor) processEvent(args *argsProcessEvent) argOutputPro
 		tokenInfo.OwnersHistory[0].Address = newOwner
 	}
 
-	return argOutputProcessEvent{
-		tokenInfo: tokenInfo,
-		processed: true,
+	return ArgOutputProcessEvent{
+		TokenInfo: tokenInfo,
+		Processed: true,
 	}
 }
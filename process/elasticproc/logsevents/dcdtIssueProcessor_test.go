@@ -21,13 +21,13 @@ func TestIssueDCDTProcessor(t *testing.T) {
 		Identifier: []byte(issueNonFungibleDCDTFunc),
 		Topics:     [][]byte{[]byte("MYTOKEN-abcd"), []byte("my-token"), []byte("MYTOKEN"), []byte(core.NonFungibleDCDT)},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		SelfShardID: core.MetachainShardId,
 	}
 
-	res := dcdtIssueProc.processEvent(args)
+	res := dcdtIssueProc.ProcessEvent(args)
 
 	require.Equal(t, &data.TokenInfo{
 		Token:        "MYTOKEN-abcd",
@@ -44,7 +44,7 @@ func TestIssueDCDTProcessor(t *testing.T) {
 			},
 		},
 		Properties: &data.TokenProperties{},
-	}, res.tokenInfo)
+	}, res.TokenInfo)
 }
 
 func TestIssueDCDTProcessor_TransferOwnership(t *testing.T) {
@@ -57,13 +57,13 @@ func TestIssueDCDTProcessor_TransferOwnership(t *testing.T) {
 		Identifier: []byte(transferOwnershipFunc),
 		Topics:     [][]byte{[]byte("MYTOKEN-abcd"), []byte("my-token"), []byte("MYTOKEN"), []byte(core.NonFungibleDCDT), []byte("newOwner")},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		SelfShardID: core.MetachainShardId,
 	}
 
-	res := dcdtIssueProc.processEvent(args)
+	res := dcdtIssueProc.ProcessEvent(args)
 
 	require.Equal(t, &data.TokenInfo{
 		Token:        "MYTOKEN-abcd",
@@ -81,5 +81,5 @@ func TestIssueDCDTProcessor_TransferOwnership(t *testing.T) {
 		},
 		TransferOwnership: true,
 		Properties:        &data.TokenProperties{},
-	}, res.tokenInfo)
+	}, res.TokenInfo)
 }
@@ -34,17 +34,17 @@ func newDcdtPropertiesProcessor(pubKeyConverter core.PubkeyConverter) *dcdtPrope
 	}
 }
 
-func (epp *dcdtPropertiesProc) processEvent(args *argsProcessEvent) argOutputProcessEvent {
-	identifier := string(args.event.GetIdentifier())
+func (epp *dcdtPropertiesProc) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	identifier := string(args.Event.GetIdentifier())
 	_, ok := epp.rolesOperationsIdentifiers[identifier]
 	if !ok {
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
-	topics := args.event.GetTopics()
+	topics := args.Event.GetTopics()
 	if len(topics) < minTopicsPropertiesAndRoles {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
@@ -65,42 +65,42 @@ func (epp *dcdtPropertiesProc) processEvent(args *argsProcessEvent) argOutputPro
 	rolesBytes := topics[3:]
 	ok = checkRolesBytes(rolesBytes)
 	if !ok {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
 	shouldAddRole := identifier == core.BuiltInFunctionSetDCDTRole || identifier == vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll
 
-	addrBech := epp.pubKeyConverter.SilentEncode(args.event.GetAddress(), log)
+	addrBech := epp.pubKeyConverter.SilentEncode(args.Event.GetAddress(), log)
 	for _, roleBytes := range rolesBytes {
 		addr := addrBech
 		if string(roleBytes) == vmcommon.DCDTRoleBurnForAll {
 			addr = ""
 		}
 
-		args.tokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addr, string(roleBytes), shouldAddRole)
+		args.TokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addr, string(roleBytes), shouldAddRole)
 	}
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
 
-func (epp *dcdtPropertiesProc) extractDataNFTCreateRoleTransfer(args *argsProcessEvent) argOutputProcessEvent {
-	topics := args.event.GetTopics()
+func (epp *dcdtPropertiesProc) extractDataNFTCreateRoleTransfer(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	topics := args.Event.GetTopics()
 
-	addrBech := epp.pubKeyConverter.SilentEncode(args.event.GetAddress(), log)
+	addrBech := epp.pubKeyConverter.SilentEncode(args.Event.GetAddress(), log)
 	shouldAddCreateRole := bytesToBool(topics[3])
-	args.tokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addrBech, core.DCDTRoleNFTCreate, shouldAddCreateRole)
+	args.TokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addrBech, core.DCDTRoleNFTCreate, shouldAddCreateRole)
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
 
-func (epp *dcdtPropertiesProc) extractTokenProperties(args *argsProcessEvent) argOutputProcessEvent {
-	topics := args.event.GetTopics()
+func (epp *dcdtPropertiesProc) extractTokenProperties(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	topics := args.Event.GetTopics()
 	properties := topics[dcdtPropertiesStartIndex:]
 	propertiesMap := make(map[string]bool)
 	for i := 0; i < len(properties); i += propertyPairStep {
@@ -109,10 +109,10 @@ func (epp *dcdtPropertiesProc) extractTokenProperties(args *argsProcessEvent) ar
 		propertiesMap[property] = val
 	}
 
-	args.tokenRolesAndProperties.AddProperties(string(topics[tokenTopicsIndex]), propertiesMap)
+	args.TokenRolesAndProperties.AddProperties(string(topics[tokenTopicsIndex]), propertiesMap)
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
 
@@ -25,9 +25,9 @@ func TestDcdtPropertiesProcCreateRoleShouldWork(t *testing.T) {
 	}
 
 	tokenRolesAndProperties := tokeninfo.NewTokenRolesAndProperties()
-	dcdtPropProc.processEvent(&argsProcessEvent{
-		event:                   event,
-		tokenRolesAndProperties: tokenRolesAndProperties,
+	dcdtPropProc.ProcessEvent(&ArgsProcessEvent{
+		Event:                   event,
+		TokenRolesAndProperties: tokenRolesAndProperties,
 	})
 
 	expected := map[string][]*tokeninfo.RoleData{
@@ -54,9 +54,9 @@ func TestDcdtPropertiesProcTransferCreateRole(t *testing.T) {
 	}
 
 	tokenRolesAndProperties := tokeninfo.NewTokenRolesAndProperties()
-	dcdtPropProc.processEvent(&argsProcessEvent{
-		event:                   event,
-		tokenRolesAndProperties: tokenRolesAndProperties,
+	dcdtPropProc.ProcessEvent(&ArgsProcessEvent{
+		Event:                   event,
+		TokenRolesAndProperties: tokenRolesAndProperties,
 	})
 
 	expected := map[string][]*tokeninfo.RoleData{
@@ -83,9 +83,9 @@ func TestDcdtPropertiesProcUpgradeProperties(t *testing.T) {
 	}
 
 	tokenRolesAndProperties := tokeninfo.NewTokenRolesAndProperties()
-	dcdtPropProc.processEvent(&argsProcessEvent{
-		event:                   event,
-		tokenRolesAndProperties: tokenRolesAndProperties,
+	dcdtPropProc.ProcessEvent(&ArgsProcessEvent{
+		Event:                   event,
+		TokenRolesAndProperties: tokenRolesAndProperties,
 	})
 
 	expected := []*tokeninfo.PropertiesData{
@@ -46,24 +46,24 @@ func newDelegatorsProcessor(
 	}
 }
 
-func (dp *delegatorsProc) processEvent(args *argsProcessEvent) argOutputProcessEvent {
-	eventIdentifierStr := string(args.event.GetIdentifier())
+func (dp *delegatorsProc) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	eventIdentifierStr := string(args.Event.GetIdentifier())
 	_, ok := dp.delegatorsOperations[eventIdentifierStr]
 	if !ok {
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
 	if eventIdentifierStr == claimRewardsFunc {
-		return argOutputProcessEvent{
-			delegator: dp.getDelegatorFromClaimRewardsEvent(args),
-			processed: true,
+		return ArgOutputProcessEvent{
+			Delegator: dp.getDelegatorFromClaimRewardsEvent(args),
+			Processed: true,
 		}
 	}
 
-	topics := args.event.GetTopics()
+	topics := args.Event.GetTopics()
 	if len(topics) < minNumTopicsDelegators {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
@@ -79,17 +79,17 @@ func (dp *delegatorsProc) processEvent(args *argsProcessEvent) argOutputProcessE
 	// topics[5:] = unDelegate fund keys in case of withdrawal
 	activeStake := big.NewInt(0).SetBytes(topics[1])
 
-	contractAddr := dp.pubkeyConverter.SilentEncode(args.logAddress, log)
+	contractAddr := dp.pubkeyConverter.SilentEncode(args.LogAddress, log)
 	if len(topics) >= minNumTopicsDelegators+1 && eventIdentifierStr == delegateFunc {
 		contractAddr = dp.pubkeyConverter.SilentEncode(topics[4], log)
 	}
 
-	encodedAddr := dp.pubkeyConverter.SilentEncode(args.event.GetAddress(), log)
+	encodedAddr := dp.pubkeyConverter.SilentEncode(args.Event.GetAddress(), log)
 
 	activeStakeNum, err := dp.balanceConverter.ComputeBalanceAsFloat(activeStake)
 	if err != nil {
-		log.Warn("delegatorsProc.processEvent cannot compute active stake as num", "active stake", activeStake,
-			"hash", args.txHashHexEncoded, "error", err)
+		log.Warn("delegatorsProc.ProcessEvent cannot compute active stake as num", "active stake", activeStake,
+			"hash", args.TxHashHexEncoded, "error", err)
 	}
 
 	delegator := &data.Delegator{
@@ -97,7 +97,7 @@ func (dp *delegatorsProc) processEvent(args *argsProcessEvent) argOutputProcessE
 		Contract:       contractAddr,
 		ActiveStake:    activeStake.String(),
 		ActiveStakeNum: activeStakeNum,
-		Timestamp:      time.Duration(args.timestamp),
+		Timestamp:      time.Duration(args.Timestamp),
 	}
 
 	if eventIdentifierStr == withdrawFunc && len(topics) >= minNumTopicsDelegators+1 {
@@ -112,26 +112,26 @@ func (dp *delegatorsProc) processEvent(args *argsProcessEvent) argOutputProcessE
 		unDelegateValue := big.NewInt(0).SetBytes(topics[0])
 		unDelegatedValueNum, errUn := dp.balanceConverter.ComputeBalanceAsFloat(unDelegateValue)
 		if errUn != nil {
-			log.Warn("delegatorsProc.processEvent cannot compute undelegated value as num",
-				"undelegated value", unDelegateValue, "hash", args.txHashHexEncoded, "error", errUn)
+			log.Warn("delegatorsProc.ProcessEvent cannot compute undelegated value as num",
+				"undelegated value", unDelegateValue, "hash", args.TxHashHexEncoded, "error", errUn)
 		}
 
 		delegator.UnDelegateInfo = &data.UnDelegate{
-			Timestamp: time.Duration(args.timestamp),
+			Timestamp: time.Duration(args.Timestamp),
 			Value:     unDelegateValue.String(),
 			ValueNum:  unDelegatedValueNum,
 			ID:        hex.EncodeToString(topics[4]),
 		}
 	}
 
-	return argOutputProcessEvent{
-		delegator: delegator,
-		processed: true,
+	return ArgOutputProcessEvent{
+		Delegator: delegator,
+		Processed: true,
 	}
 }
 
-func (dp *delegatorsProc) getDelegatorFromClaimRewardsEvent(args *argsProcessEvent) *data.Delegator {
-	topics := args.event.GetTopics()
+func (dp *delegatorsProc) getDelegatorFromClaimRewardsEvent(args *ArgsProcessEvent) *data.Delegator {
+	topics := args.Event.GetTopics()
 	// for claimRewards
 	// topics slice contains:
 	// topics[0] -- claimed rewards
@@ -147,9 +147,9 @@ func (dp *delegatorsProc) getDelegatorFromClaimRewardsEvent(args *argsProcessEve
 		return nil
 	}
 
-	encodedAddr := dp.pubkeyConverter.SilentEncode(args.event.GetAddress(), log)
+	encodedAddr := dp.pubkeyConverter.SilentEncode(args.Event.GetAddress(), log)
 
-	encodedContractAddr := dp.pubkeyConverter.SilentEncode(args.logAddress, log)
+	encodedContractAddr := dp.pubkeyConverter.SilentEncode(args.LogAddress, log)
 	if len(topics) == numTopicsClaimRewardsWithContractAddress {
 		encodedContractAddr = dp.pubkeyConverter.SilentEncode(topics[numTopicsClaimRewardsWithContractAddress-1], log)
 	}
@@ -22,25 +22,25 @@ func TestDelegatorsProcessor_ProcessEvent(t *testing.T) {
 		Identifier: []byte(delegateFunc),
 		Topics:     [][]byte{big.NewInt(1000).Bytes(), big.NewInt(1000000000).Bytes(), big.NewInt(10).Bytes(), big.NewInt(1000000000).Bytes()},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		logAddress:  []byte("contract"),
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		LogAddress:  []byte("contract"),
+		SelfShardID: core.MetachainShardId,
 	}
 
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 	delegatorsProcessor := newDelegatorsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
 
-	res := delegatorsProcessor.processEvent(args)
-	require.True(t, res.processed)
+	res := delegatorsProcessor.ProcessEvent(args)
+	require.True(t, res.Processed)
 	require.Equal(t, &data.Delegator{
 		Address:        "61646472",
 		Contract:       "636f6e7472616374",
 		ActiveStakeNum: 0.1,
 		ActiveStake:    "1000000000",
 		Timestamp:      1234,
-	}, res.delegator)
+	}, res.Delegator)
 }
 
 func TestDelegatorProcessor_WithdrawWithDelete(t *testing.T) {
@@ -51,18 +51,18 @@ func TestDelegatorProcessor_WithdrawWithDelete(t *testing.T) {
 		Identifier: []byte(withdrawFunc),
 		Topics:     [][]byte{big.NewInt(1000).Bytes(), big.NewInt(0).Bytes(), big.NewInt(10).Bytes(), big.NewInt(1000000000).Bytes(), []byte(strconv.FormatBool(true)), []byte("a")},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		logAddress:  []byte("contract"),
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		LogAddress:  []byte("contract"),
+		SelfShardID: core.MetachainShardId,
 	}
 
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 	delegatorsProcessor := newDelegatorsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
 
-	res := delegatorsProcessor.processEvent(args)
-	require.True(t, res.processed)
+	res := delegatorsProcessor.ProcessEvent(args)
+	require.True(t, res.Processed)
 	require.Equal(t, &data.Delegator{
 		Address:         "61646472",
 		Contract:        "636f6e7472616374",
@@ -71,7 +71,7 @@ func TestDelegatorProcessor_WithdrawWithDelete(t *testing.T) {
 		ShouldDelete:    true,
 		Timestamp:       1234,
 		WithdrawFundIDs: []string{"61"},
-	}, res.delegator)
+	}, res.Delegator)
 }
 
 func TestDelegatorProcessor_ClaimRewardsWithDelete(t *testing.T) {
@@ -82,23 +82,23 @@ func TestDelegatorProcessor_ClaimRewardsWithDelete(t *testing.T) {
 		Identifier: []byte(claimRewardsFunc),
 		Topics:     [][]byte{big.NewInt(1000).Bytes(), []byte(strconv.FormatBool(true))},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		logAddress:  []byte("contract"),
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		LogAddress:  []byte("contract"),
+		SelfShardID: core.MetachainShardId,
 	}
 
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 	delegatorsProcessor := newDelegatorsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
 
-	res := delegatorsProcessor.processEvent(args)
-	require.True(t, res.processed)
+	res := delegatorsProcessor.ProcessEvent(args)
+	require.True(t, res.Processed)
 	require.Equal(t, &data.Delegator{
 		Address:      "61646472",
 		Contract:     "636f6e7472616374",
 		ShouldDelete: true,
-	}, res.delegator)
+	}, res.Delegator)
 }
 
 func TestDelegatorProcessor_ClaimRewardsContractAddressInTopics(t *testing.T) {
@@ -110,23 +110,23 @@ func TestDelegatorProcessor_ClaimRewardsContractAddressInTopics(t *testing.T) {
 		Identifier: []byte(claimRewardsFunc),
 		Topics:     [][]byte{big.NewInt(1000).Bytes(), []byte(strconv.FormatBool(true)), contractAddress},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		logAddress:  []byte("contract1"),
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		LogAddress:  []byte("contract1"),
+		SelfShardID: core.MetachainShardId,
 	}
 
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 	delegatorsProcessor := newDelegatorsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
 
-	res := delegatorsProcessor.processEvent(args)
-	require.True(t, res.processed)
+	res := delegatorsProcessor.ProcessEvent(args)
+	require.True(t, res.Processed)
 	require.Equal(t, &data.Delegator{
 		Address:      "61646472",
 		Contract:     hex.EncodeToString(contractAddress),
 		ShouldDelete: true,
-	}, res.delegator)
+	}, res.Delegator)
 }
 
 func TestDelegatorProcessor_ClaimRewardsNoDelete(t *testing.T) {
@@ -137,19 +137,19 @@ func TestDelegatorProcessor_ClaimRewardsNoDelete(t *testing.T) {
 		Identifier: []byte(claimRewardsFunc),
 		Topics:     [][]byte{big.NewInt(1000).Bytes(), []byte(strconv.FormatBool(false))},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		logAddress:  []byte("contract"),
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		LogAddress:  []byte("contract"),
+		SelfShardID: core.MetachainShardId,
 	}
 
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 	delegatorsProcessor := newDelegatorsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
 
-	res := delegatorsProcessor.processEvent(args)
-	require.True(t, res.processed)
-	require.Nil(t, res.delegator)
+	res := delegatorsProcessor.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.Nil(t, res.Delegator)
 }
 
 func TestDelegatorsProcessor_WithdrawalShouldWorkWith5Topics(t *testing.T) {
@@ -160,20 +160,20 @@ func TestDelegatorsProcessor_WithdrawalShouldWorkWith5Topics(t *testing.T) {
 		Identifier: []byte(withdrawFunc),
 		Topics:     [][]byte{big.NewInt(1000).Bytes(), big.NewInt(0).Bytes(), big.NewInt(10).Bytes(), big.NewInt(1000000000).Bytes(), []byte(strconv.FormatBool(true))},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		logAddress:  []byte("contract"),
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		LogAddress:  []byte("contract"),
+		SelfShardID: core.MetachainShardId,
 	}
 
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 	delegatorsProcessor := newDelegatorsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
 
-	res := delegatorsProcessor.processEvent(args)
-	require.True(t, res.processed)
-	require.True(t, res.delegator.ShouldDelete)
-	require.Equal(t, 0, len(res.delegator.WithdrawFundIDs))
+	res := delegatorsProcessor.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.True(t, res.Delegator.ShouldDelete)
+	require.Equal(t, 0, len(res.Delegator.WithdrawFundIDs))
 }
 
 func TestDelegatorsProcessor_WithdrawalShouldWorkWithNewTopics(t *testing.T) {
@@ -184,18 +184,18 @@ func TestDelegatorsProcessor_WithdrawalShouldWorkWithNewTopics(t *testing.T) {
 		Identifier: []byte(withdrawFunc),
 		Topics:     [][]byte{big.NewInt(1000).Bytes(), big.NewInt(0).Bytes(), big.NewInt(10).Bytes(), big.NewInt(1000000000).Bytes(), []byte(strconv.FormatBool(true)), []byte("id1"), []byte("id2")},
 	}
-	args := &argsProcessEvent{
-		timestamp:   1234,
-		event:       event,
-		logAddress:  []byte("contract"),
-		selfShardID: core.MetachainShardId,
+	args := &ArgsProcessEvent{
+		Timestamp:   1234,
+		Event:       event,
+		LogAddress:  []byte("contract"),
+		SelfShardID: core.MetachainShardId,
 	}
 
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 	delegatorsProcessor := newDelegatorsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
 
-	res := delegatorsProcessor.processEvent(args)
-	require.True(t, res.processed)
-	require.True(t, res.delegator.ShouldDelete)
-	require.Equal(t, []string{"696431", "696432"}, res.delegator.WithdrawFundIDs)
+	res := delegatorsProcessor.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.True(t, res.Delegator.ShouldDelete)
+	require.Equal(t, []string{"696431", "696432"}, res.Delegator.WithdrawFundIDs)
 }
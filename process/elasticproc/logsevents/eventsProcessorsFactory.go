@@ -0,0 +1,36 @@
+package logsevents
+
+type eventsProcessorsFactory struct{}
+
+// NewEventsProcessorsFactory will create the factory of the events processors used by the regular and sovereign chains
+func NewEventsProcessorsFactory() *eventsProcessorsFactory {
+	return &eventsProcessorsFactory{}
+}
+
+// CreateEventsProcessors will create the events processors in the order in which they are applied on every event
+func (epf *eventsProcessorsFactory) CreateEventsProcessors(args ArgsLogsAndEventsProcessor) []EventsProcessor {
+	nftsProc := newNFTsProcessor(args.PubKeyConverter, args.Marshalizer)
+	scDeploysProc := newSCDeploysProcessor(args.PubKeyConverter)
+	informativeProc := newInformativeLogsProcessor()
+	updateNFTProc := newNFTsPropertiesProcessor(args.PubKeyConverter, args.Marshalizer)
+	dcdtPropProc := newDcdtPropertiesProcessor(args.PubKeyConverter)
+	dcdtIssueProc := newDCDTIssueProcessor(args.PubKeyConverter)
+	delegatorsProcessor := newDelegatorsProcessor(args.PubKeyConverter, args.BalanceConverter)
+	stakingProvidersProc := newStakingProvidersProcessor(args.PubKeyConverter, args.ValidatorPubKeyConverter)
+
+	return []EventsProcessor{
+		scDeploysProc,
+		informativeProc,
+		updateNFTProc,
+		dcdtPropProc,
+		dcdtIssueProc,
+		delegatorsProcessor,
+		stakingProvidersProc,
+		nftsProc,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (epf *eventsProcessorsFactory) IsInterfaceNil() bool {
+	return epf == nil
+}
@@ -0,0 +1,20 @@
+package logsevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsProcessorsFactory_CreateEventsProcessors(t *testing.T) {
+	t.Parallel()
+
+	epf := NewEventsProcessorsFactory()
+	require.False(t, epf.IsInterfaceNil())
+
+	eventsProcessors := epf.CreateEventsProcessors(createMockArgs())
+	require.Len(t, eventsProcessors, 8)
+	for _, proc := range eventsProcessors {
+		require.NotNil(t, proc)
+	}
+}
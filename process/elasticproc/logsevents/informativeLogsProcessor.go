@@ -21,14 +21,14 @@ func newInformativeLogsProcessor() *informativeLogsProcessor {
 	}
 }
 
-func (ilp *informativeLogsProcessor) processEvent(args *argsProcessEvent) argOutputProcessEvent {
-	identifier := string(args.event.GetIdentifier())
+func (ilp *informativeLogsProcessor) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	identifier := string(args.Event.GetIdentifier())
 	_, ok := ilp.operations[identifier]
 	if !ok {
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
-	tx, ok := args.txs[args.txHashHexEncoded]
+	tx, ok := args.Txs[args.TxHashHexEncoded]
 	if !ok {
 		return processEventNoTx(args)
 	}
@@ -49,40 +49,40 @@ func (ilp *informativeLogsProcessor) processEvent(args *argsProcessEvent) argOut
 		}
 	}
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
 
-func processEventNoTx(args *argsProcessEvent) argOutputProcessEvent {
-	scr, ok := args.scrs[args.txHashHexEncoded]
+func processEventNoTx(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	scr, ok := args.Scrs[args.TxHashHexEncoded]
 	if !ok {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 	if scr.OriginalTxHash == "" {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
 	record := &outport.StatusInfo{}
-	switch string(args.event.GetIdentifier()) {
+	switch string(args.Event.GetIdentifier()) {
 	case core.CompletedTxEventIdentifier:
 		{
 			record.CompletedEvent = true
-			args.txHashStatusInfoProc.addRecord(scr.OriginalTxHash, record)
+			args.TxHashStatusInfoProc.addRecord(scr.OriginalTxHash, record)
 		}
 	case core.SignalErrorOperation, core.InternalVMErrorsOperation:
 		{
 			record.Status = transaction.TxStatusFail.String()
 			record.ErrorEvent = true
-			args.txHashStatusInfoProc.addRecord(scr.OriginalTxHash, record)
+			args.TxHashStatusInfoProc.addRecord(scr.OriginalTxHash, record)
 		}
 	}
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
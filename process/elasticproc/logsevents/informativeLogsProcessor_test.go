@@ -17,14 +17,14 @@ func TestInformativeShouldIgnoreLog(t *testing.T) {
 		Address:    []byte("addr"),
 		Identifier: []byte("doSomething"),
 	}
-	args := &argsProcessEvent{
-		timestamp:  1234,
-		event:      event,
-		logAddress: []byte("contract"),
+	args := &ArgsProcessEvent{
+		Timestamp:  1234,
+		Event:      event,
+		LogAddress: []byte("contract"),
 	}
 
-	res := informativeLogsProc.processEvent(args)
-	require.False(t, res.processed)
+	res := informativeLogsProc.ProcessEvent(args)
+	require.False(t, res.Processed)
 }
 
 func TestInformativeLogsProcessorWriteLog(t *testing.T) {
@@ -44,20 +44,20 @@ func TestInformativeLogsProcessorWriteLog(t *testing.T) {
 		Address:    []byte("addr"),
 		Identifier: []byte(core.WriteLogIdentifier),
 	}
-	args := &argsProcessEvent{
-		timestamp:        1234,
-		event:            event,
-		logAddress:       []byte("contract"),
-		txs:              txs,
-		txHashHexEncoded: hexEncodedTxHash,
+	args := &ArgsProcessEvent{
+		Timestamp:        1234,
+		Event:            event,
+		LogAddress:       []byte("contract"),
+		Txs:              txs,
+		TxHashHexEncoded: hexEncodedTxHash,
 	}
 
 	informativeLogsProc := newInformativeLogsProcessor()
 
-	res := informativeLogsProc.processEvent(args)
+	res := informativeLogsProc.ProcessEvent(args)
 
 	require.Equal(t, transaction.TxStatusSuccess.String(), tx.Status)
-	require.True(t, res.processed)
+	require.True(t, res.Processed)
 }
 
 func TestInformativeLogsProcessorSignalError(t *testing.T) {
@@ -77,21 +77,21 @@ func TestInformativeLogsProcessorSignalError(t *testing.T) {
 		Address:    []byte("addr"),
 		Identifier: []byte(core.SignalErrorOperation),
 	}
-	args := &argsProcessEvent{
-		timestamp:        1234,
-		event:            event,
-		logAddress:       []byte("contract"),
-		txs:              txs,
-		txHashHexEncoded: hexEncodedTxHash,
+	args := &ArgsProcessEvent{
+		Timestamp:        1234,
+		Event:            event,
+		LogAddress:       []byte("contract"),
+		Txs:              txs,
+		TxHashHexEncoded: hexEncodedTxHash,
 	}
 
 	informativeLogsProc := newInformativeLogsProcessor()
 
-	res := informativeLogsProc.processEvent(args)
+	res := informativeLogsProc.ProcessEvent(args)
 
 	require.Equal(t, transaction.TxStatusFail.String(), tx.Status)
 	require.True(t, tx.ErrorEvent)
-	require.Equal(t, true, res.processed)
+	require.Equal(t, true, res.Processed)
 }
 
 func TestInformativeLogsProcessorCompletedEvent(t *testing.T) {
@@ -111,20 +111,20 @@ func TestInformativeLogsProcessorCompletedEvent(t *testing.T) {
 		Address:    []byte("addr"),
 		Identifier: []byte(core.CompletedTxEventIdentifier),
 	}
-	args := &argsProcessEvent{
-		timestamp:        1234,
-		event:            event,
-		logAddress:       []byte("contract"),
-		txs:              txs,
-		txHashHexEncoded: hexEncodedTxHash,
+	args := &ArgsProcessEvent{
+		Timestamp:        1234,
+		Event:            event,
+		LogAddress:       []byte("contract"),
+		Txs:              txs,
+		TxHashHexEncoded: hexEncodedTxHash,
 	}
 
 	informativeLogsProc := newInformativeLogsProcessor()
 
-	res := informativeLogsProc.processEvent(args)
+	res := informativeLogsProc.ProcessEvent(args)
 
 	require.True(t, tx.CompletedEvent)
-	require.Equal(t, true, res.processed)
+	require.Equal(t, true, res.Processed)
 }
 
 func TestInformativeLogsProcessorLogsGeneratedByScrsSignalError(t *testing.T) {
@@ -144,18 +144,18 @@ func TestInformativeLogsProcessorLogsGeneratedByScrsSignalError(t *testing.T) {
 	}
 
 	txStatusProc := newTxHashStatusInfoProcessor()
-	args := &argsProcessEvent{
-		timestamp:            1234,
-		event:                event,
-		logAddress:           []byte("contract"),
-		scrs:                 scrs,
-		txHashHexEncoded:     scrHash,
-		txHashStatusInfoProc: txStatusProc,
+	args := &ArgsProcessEvent{
+		Timestamp:            1234,
+		Event:                event,
+		LogAddress:           []byte("contract"),
+		Scrs:                 scrs,
+		TxHashHexEncoded:     scrHash,
+		TxHashStatusInfoProc: txStatusProc,
 	}
 
 	informativeLogsProc := newInformativeLogsProcessor()
-	res := informativeLogsProc.processEvent(args)
-	require.True(t, res.processed)
+	res := informativeLogsProc.ProcessEvent(args)
+	require.True(t, res.Processed)
 
 	require.Equal(t, &outport.StatusInfo{
 		Status:     transaction.TxStatusFail.String(),
@@ -180,18 +180,18 @@ func TestInformativeLogsProcessorLogsGeneratedByScrsCompletedEvent(t *testing.T)
 	}
 
 	txStatusProc := newTxHashStatusInfoProcessor()
-	args := &argsProcessEvent{
-		timestamp:            1234,
-		event:                event,
-		logAddress:           []byte("contract"),
-		scrs:                 scrs,
-		txHashHexEncoded:     scrHash,
-		txHashStatusInfoProc: txStatusProc,
+	args := &ArgsProcessEvent{
+		Timestamp:            1234,
+		Event:                event,
+		LogAddress:           []byte("contract"),
+		Scrs:                 scrs,
+		TxHashHexEncoded:     scrHash,
+		TxHashStatusInfoProc: txStatusProc,
 	}
 
 	informativeLogsProc := newInformativeLogsProcessor()
-	res := informativeLogsProc.processEvent(args)
-	require.True(t, res.processed)
+	res := informativeLogsProc.ProcessEvent(args)
+	require.True(t, res.Processed)
 
 	require.Equal(t, &outport.StatusInfo{
 		CompletedEvent: true,
@@ -209,15 +209,15 @@ func TestInformativeLogsProcessorLogsGeneratedByScrNotFoundInMap(t *testing.T) {
 	}
 
 	txStatusProc := newTxHashStatusInfoProcessor()
-	args := &argsProcessEvent{
-		timestamp:            1234,
-		event:                event,
-		logAddress:           []byte("contract"),
-		txHashHexEncoded:     scrHash,
-		txHashStatusInfoProc: txStatusProc,
+	args := &ArgsProcessEvent{
+		Timestamp:            1234,
+		Event:                event,
+		LogAddress:           []byte("contract"),
+		TxHashHexEncoded:     scrHash,
+		TxHashStatusInfoProc: txStatusProc,
 	}
 
 	informativeLogsProc := newInformativeLogsProcessor()
-	res := informativeLogsProc.processEvent(args)
-	require.True(t, res.processed)
+	res := informativeLogsProc.ProcessEvent(args)
+	require.True(t, res.Processed)
 }
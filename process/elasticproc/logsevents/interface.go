@@ -7,35 +7,64 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/tokeninfo"
 )
 
-type argsProcessEvent struct {
-	txHashHexEncoded        string
-	scDeploys               map[string]*data.ScDeployInfo
-	changeOwnerOperations   map[string]*data.OwnerData
-	txs                     map[string]*data.Transaction
-	scrs                    map[string]*data.ScResult
-	event                   coreData.EventHandler
-	tokens                  data.TokensHandler
-	tokensSupply            data.TokensHandler
-	tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties
-	txHashStatusInfoProc    txHashStatusInfoHandler
-	timestamp               uint64
-	logAddress              []byte
-	selfShardID             uint32
-	numOfShards             uint32
+// ArgsProcessEvent holds the event to be processed and the block data that the events processors can read or update
+type ArgsProcessEvent struct {
+	TxHashHexEncoded        string
+	ScDeploys               map[string]*data.ScDeployInfo
+	ChangeOwnerOperations   map[string]*data.OwnerData
+	Txs                     map[string]*data.Transaction
+	Scrs                    map[string]*data.ScResult
+	Event                   coreData.EventHandler
+	Tokens                  data.TokensHandler
+	TokensSupply            data.TokensHandler
+	CollectionsStats        *collectionsStats
+	TokenRolesAndProperties *tokeninfo.TokenRolesAndProperties
+	TxHashStatusInfoProc    txHashStatusInfoHandler
+	Timestamp               uint64
+	LogAddress              []byte
+	SelfShardID             uint32
+	NumOfShards             uint32
 }
 
-type argOutputProcessEvent struct {
-	tokenInfo     *data.TokenInfo
-	delegator     *data.Delegator
-	updatePropNFT *data.NFTDataUpdate
-	processed     bool
+// ArgOutputProcessEvent holds the documents extracted by an events processor. Processed marks that the event was
+// handled and that it should not be passed to the next events processors
+type ArgOutputProcessEvent struct {
+	TokenInfo     *data.TokenInfo
+	Delegator     *data.Delegator
+	Provider      *data.StakingProvider
+	Nodes         []*data.StakingNode
+	UpdatePropNFT *data.NFTDataUpdate
+	Processed     bool
 }
 
-type eventsProcessor interface {
-	processEvent(args *argsProcessEvent) argOutputProcessEvent
+// EventsProcessor defines what an events processor should be able to do
+type EventsProcessor interface {
+	ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent
+}
+
+// EventsProcessorsCreator defines what a component that creates the set of events processors of a chain flavour should do
+type EventsProcessorsCreator interface {
+	CreateEventsProcessors(args ArgsLogsAndEventsProcessor) []EventsProcessor
+	IsInterfaceNil() bool
 }
 
 type txHashStatusInfoHandler interface {
 	addRecord(hash string, statusInfo *outport.StatusInfo)
 	getAllRecords() map[string]*outport.StatusInfo
 }
+
+// CustomEventsProcessor defines what a custom events processor should be able to do. It receives the raw event and the
+// event document that is saved in the events index and returns the documents that should be saved in custom indices. The
+// returned documents have to hold the timestamp and shardID fields, they are used to remove the documents on revert
+type CustomEventsProcessor interface {
+	ProcessEvent(event coreData.EventHandler, dbEvent *data.LogEvent) []*data.CustomEvent
+	GetIndices() []string
+	IsInterfaceNil() bool
+}
+
+// CustomEventsRegistryHandler defines what a custom events registry should be able to do
+type CustomEventsRegistryHandler interface {
+	GetProcessors() []CustomEventsProcessor
+	GetIndices() []string
+	IsInterfaceNil() bool
+}
@@ -21,16 +21,20 @@ const eventIDFormat = "%s-%d-%d"
 
 // ArgsLogsAndEventsProcessor  holds all dependencies required to create new instances of logsAndEventsProcessor
 type ArgsLogsAndEventsProcessor struct {
-	PubKeyConverter  core.PubkeyConverter
-	Marshalizer      marshal.Marshalizer
-	BalanceConverter dataindexer.BalanceConverter
-	Hasher           hashing.Hasher
+	PubKeyConverter          core.PubkeyConverter
+	ValidatorPubKeyConverter core.PubkeyConverter
+	Marshalizer              marshal.Marshalizer
+	BalanceConverter         dataindexer.BalanceConverter
+	Hasher                   hashing.Hasher
+	CustomEventsRegistry     CustomEventsRegistryHandler
+	EventsProcessorsCreator  EventsProcessorsCreator
 }
 
 type logsAndEventsProcessor struct {
-	hasher           hashing.Hasher
-	pubKeyConverter  core.PubkeyConverter
-	eventsProcessors []eventsProcessor
+	hasher                 hashing.Hasher
+	pubKeyConverter        core.PubkeyConverter
+	eventsProcessors       []EventsProcessor
+	customEventsProcessors []CustomEventsProcessor
 }
 
 // NewLogsAndEventsProcessor will create a new instance for the logsAndEventsProcessor
@@ -40,12 +44,13 @@ func NewLogsAndEventsProcessor(args ArgsLogsAndEventsProcessor) (*logsAndEventsP
 		return nil, err
 	}
 
-	eventsProcessors := createEventsProcessors(args)
+	eventsProcessors := args.EventsProcessorsCreator.CreateEventsProcessors(args)
 
 	return &logsAndEventsProcessor{
-		pubKeyConverter:  args.PubKeyConverter,
-		eventsProcessors: eventsProcessors,
-		hasher:           args.Hasher,
+		pubKeyConverter:        args.PubKeyConverter,
+		eventsProcessors:       eventsProcessors,
+		customEventsProcessors: args.CustomEventsRegistry.GetProcessors(),
+		hasher:                 args.Hasher,
 	}, nil
 }
 
@@ -53,6 +58,9 @@ func checkArgsLogsAndEventsProcessor(args ArgsLogsAndEventsProcessor) error {
 	if check.IfNil(args.PubKeyConverter) {
 		return dataindexer.ErrNilPubkeyConverter
 	}
+	if check.IfNil(args.ValidatorPubKeyConverter) {
+		return dataindexer.ErrNilValidatorPubkeyConverter
+	}
 	if check.IfNil(args.Marshalizer) {
 		return dataindexer.ErrNilMarshalizer
 	}
@@ -62,30 +70,14 @@ func checkArgsLogsAndEventsProcessor(args ArgsLogsAndEventsProcessor) error {
 	if check.IfNil(args.Hasher) {
 		return dataindexer.ErrNilHasher
 	}
-
-	return nil
-}
-
-func createEventsProcessors(args ArgsLogsAndEventsProcessor) []eventsProcessor {
-	nftsProc := newNFTsProcessor(args.PubKeyConverter, args.Marshalizer)
-	scDeploysProc := newSCDeploysProcessor(args.PubKeyConverter)
-	informativeProc := newInformativeLogsProcessor()
-	updateNFTProc := newNFTsPropertiesProcessor(args.PubKeyConverter, args.Marshalizer)
-	dcdtPropProc := newDcdtPropertiesProcessor(args.PubKeyConverter)
-	dcdtIssueProc := newDCDTIssueProcessor(args.PubKeyConverter)
-	delegatorsProcessor := newDelegatorsProcessor(args.PubKeyConverter, args.BalanceConverter)
-
-	eventsProcs := []eventsProcessor{
-		scDeploysProc,
-		informativeProc,
-		updateNFTProc,
-		dcdtPropProc,
-		dcdtIssueProc,
-		delegatorsProcessor,
-		nftsProc,
+	if check.IfNil(args.CustomEventsRegistry) {
+		return dataindexer.ErrNilCustomEventsRegistry
+	}
+	if check.IfNil(args.EventsProcessorsCreator) {
+		return dataindexer.ErrNilEventsProcessorsCreator
 	}
 
-	return eventsProcs
+	return nil
 }
 
 // ExtractDataFromLogs will extract data from the provided logs and events and put in altered addresses
@@ -124,13 +116,17 @@ func (lep *logsAndEventsProcessor) ExtractDataFromLogs(
 		ScDeploys:               lgData.scDeploys,
 		TokensInfo:              lgData.tokensInfo,
 		TokensSupply:            lgData.tokensSupply,
+		CollectionsStats:        lgData.collectionsStats.getAll(timestamp, shardID),
 		Delegators:              lgData.delegators,
+		Providers:               lgData.providers,
+		Nodes:                   lgData.nodes,
 		NFTsDataUpdates:         lgData.nftsDataUpdates,
 		TokenRolesAndProperties: lgData.tokenRolesAndProperties,
 		TxHashStatusInfo:        lgData.txHashStatusInfoProc.getAllRecords(),
 		ChangeOwnerOperations:   lgData.changeOwnerOperations,
 		DBLogs:                  dbLogs,
 		DBEvents:                dbEvents,
+		CustomEvents:            lgData.customEvents,
 	}
 }
 
@@ -146,30 +142,37 @@ func (lep *logsAndEventsProcessor) processEvents(lgData *logsData, logHashHexEnc
 
 func (lep *logsAndEventsProcessor) processEvent(lgData *logsData, logHashHexEncoded string, logAddress []byte, event coreData.EventHandler, shardID uint32, numOfShards uint32) {
 	for _, proc := range lep.eventsProcessors {
-		res := proc.processEvent(&argsProcessEvent{
-			event:                   event,
-			txHashHexEncoded:        logHashHexEncoded,
-			logAddress:              logAddress,
-			tokens:                  lgData.tokens,
-			tokensSupply:            lgData.tokensSupply,
-			timestamp:               lgData.timestamp,
-			scDeploys:               lgData.scDeploys,
-			txs:                     lgData.txsMap,
-			scrs:                    lgData.scrsMap,
-			tokenRolesAndProperties: lgData.tokenRolesAndProperties,
-			txHashStatusInfoProc:    lgData.txHashStatusInfoProc,
-			changeOwnerOperations:   lgData.changeOwnerOperations,
-			selfShardID:             shardID,
-			numOfShards:             numOfShards,
+		res := proc.ProcessEvent(&ArgsProcessEvent{
+			Event:                   event,
+			TxHashHexEncoded:        logHashHexEncoded,
+			LogAddress:              logAddress,
+			Tokens:                  lgData.tokens,
+			TokensSupply:            lgData.tokensSupply,
+			CollectionsStats:        lgData.collectionsStats,
+			Timestamp:               lgData.timestamp,
+			ScDeploys:               lgData.scDeploys,
+			Txs:                     lgData.txsMap,
+			Scrs:                    lgData.scrsMap,
+			TokenRolesAndProperties: lgData.tokenRolesAndProperties,
+			TxHashStatusInfoProc:    lgData.txHashStatusInfoProc,
+			ChangeOwnerOperations:   lgData.changeOwnerOperations,
+			SelfShardID:             shardID,
+			NumOfShards:             numOfShards,
 		})
-		if res.tokenInfo != nil {
-			lgData.tokensInfo = append(lgData.tokensInfo, res.tokenInfo)
+		if res.TokenInfo != nil {
+			lgData.tokensInfo = append(lgData.tokensInfo, res.TokenInfo)
 		}
-		if res.delegator != nil {
-			lgData.delegators[res.delegator.Address+res.delegator.Contract] = res.delegator
+		if res.Delegator != nil {
+			lgData.delegators[res.Delegator.Address+res.Delegator.Contract] = res.Delegator
 		}
-		if res.updatePropNFT != nil {
-			lgData.nftsDataUpdates = append(lgData.nftsDataUpdates, res.updatePropNFT)
+		if res.Provider != nil {
+			lgData.addProvider(res.Provider)
+		}
+		for _, node := range res.Nodes {
+			lgData.nodes[node.BLSKey] = node
+		}
+		if res.UpdatePropNFT != nil {
+			lgData.nftsDataUpdates = append(lgData.nftsDataUpdates, res.UpdatePropNFT)
 		}
 
 		tx, ok := lgData.txsMap[logHashHexEncoded]
@@ -183,7 +186,7 @@ func (lep *logsAndEventsProcessor) processEvent(lgData *logsData, logHashHexEnco
 			continue
 		}
 
-		if res.processed {
+		if res.Processed {
 			return
 		}
 	}
@@ -247,12 +250,24 @@ func (lep *logsAndEventsProcessor) prepareLog(
 		logsDB.Events = append(logsDB.Events, logEvent)
 
 		executionOrder := lep.getExecutionOrder(lgData, logHashHex)
-		dbEvents = append(dbEvents, lep.prepareLogEvent(logsDB, logEvent, shardID, executionOrder))
+		dbEvent := lep.prepareLogEvent(logsDB, logEvent, shardID, executionOrder)
+		dbEvents = append(dbEvents, dbEvent)
+
+		lgData.customEvents = append(lgData.customEvents, lep.processCustomEvents(event, dbEvent)...)
 	}
 
 	return logsDB, dbEvents
 }
 
+func (lep *logsAndEventsProcessor) processCustomEvents(event coreData.EventHandler, dbEvent *data.LogEvent) []*data.CustomEvent {
+	customEvents := make([]*data.CustomEvent, 0)
+	for _, proc := range lep.customEventsProcessors {
+		customEvents = append(customEvents, proc.ProcessEvent(event, dbEvent)...)
+	}
+
+	return customEvents
+}
+
 func (lep *logsAndEventsProcessor) prepareLogEvent(dbLog *data.Logs, event *data.Event, shardID uint32, execOrder int) *data.LogEvent {
 	dbEvent := &data.LogEvent{
 		UUID:           converters.GenerateBase64UUID(),
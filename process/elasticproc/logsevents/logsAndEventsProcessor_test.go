@@ -18,11 +18,15 @@ import (
 
 func createMockArgs() ArgsLogsAndEventsProcessor {
 	balanceConverter, _ := converters.NewBalanceConverter(10)
+	customEventsRegistry, _ := NewCustomEventsRegistry(ArgsCustomEventsRegistry{PubKeyConverter: &mock.PubkeyConverterMock{}})
 	return ArgsLogsAndEventsProcessor{
-		PubKeyConverter:  &mock.PubkeyConverterMock{},
-		Marshalizer:      &mock.MarshalizerMock{},
-		BalanceConverter: balanceConverter,
-		Hasher:           &mock.HasherMock{},
+		PubKeyConverter:          &mock.PubkeyConverterMock{},
+		ValidatorPubKeyConverter: &mock.PubkeyConverterMock{},
+		Marshalizer:              &mock.MarshalizerMock{},
+		BalanceConverter:         balanceConverter,
+		Hasher:                   &mock.HasherMock{},
+		CustomEventsRegistry:     customEventsRegistry,
+		EventsProcessorsCreator:  NewEventsProcessorsFactory(),
 	}
 }
 
@@ -34,6 +38,11 @@ func TestNewLogsAndEventsProcessor(t *testing.T) {
 	_, err := NewLogsAndEventsProcessor(args)
 	require.Equal(t, elasticIndexer.ErrNilPubkeyConverter, err)
 
+	args = createMockArgs()
+	args.ValidatorPubKeyConverter = nil
+	_, err = NewLogsAndEventsProcessor(args)
+	require.Equal(t, elasticIndexer.ErrNilValidatorPubkeyConverter, err)
+
 	args = createMockArgs()
 	args.Marshalizer = nil
 	_, err = NewLogsAndEventsProcessor(args)
@@ -49,6 +58,16 @@ func TestNewLogsAndEventsProcessor(t *testing.T) {
 	_, err = NewLogsAndEventsProcessor(args)
 	require.Equal(t, elasticIndexer.ErrNilHasher, err)
 
+	args = createMockArgs()
+	args.CustomEventsRegistry = nil
+	_, err = NewLogsAndEventsProcessor(args)
+	require.Equal(t, elasticIndexer.ErrNilCustomEventsRegistry, err)
+
+	args = createMockArgs()
+	args.EventsProcessorsCreator = nil
+	_, err = NewLogsAndEventsProcessor(args)
+	require.Equal(t, elasticIndexer.ErrNilEventsProcessorsCreator, err)
+
 	args = createMockArgs()
 	proc, err := NewLogsAndEventsProcessor(args)
 	require.NotNil(t, proc)
@@ -371,6 +390,52 @@ func TestPrepareLogsAndEvents_LogEvents(t *testing.T) {
 	}, results.DBEvents)
 }
 
+func TestLogsAndEventsProcessor_ExtractDataFromLogsCustomEvents(t *testing.T) {
+	t.Parallel()
+
+	logsAndEvents := []*outport.LogData{
+		{
+			TxHash: hex.EncodeToString([]byte("txHash")),
+			Log: &transaction.Log{
+				Address: []byte("pair"),
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("pair"),
+						Identifier: []byte("swap"),
+						Topics:     [][]byte{[]byte("WREWA-abcd"), big.NewInt(1000).Bytes()},
+					},
+					{
+						Address:    []byte("pair"),
+						Identifier: []byte("addLiquidity"),
+					},
+				},
+			},
+		},
+	}
+
+	args := createMockArgs()
+	args.CustomEventsRegistry, _ = NewCustomEventsRegistry(ArgsCustomEventsRegistry{
+		PubKeyConverter: &mock.PubkeyConverterMock{},
+		Rules: []CustomEventRule{
+			{
+				Name:       "swaps",
+				Identifier: "swap",
+				Index:      "swaps",
+				Topics:     []CustomEventTopic{{Name: "token", Decoder: "string"}, {Name: "amount", Decoder: "bigint"}},
+			},
+		},
+	})
+	proc, _ := NewLogsAndEventsProcessor(args)
+
+	results := proc.ExtractDataFromLogs(logsAndEvents, &data.PreparedResults{}, 1234, 1, 3)
+	require.Len(t, results.DBEvents, 2)
+	require.Len(t, results.CustomEvents, 1)
+	require.Equal(t, "swaps", results.CustomEvents[0].Index)
+	require.Equal(t, "747848617368-1-0", results.CustomEvents[0].ID)
+	require.Equal(t, "WREWA-abcd", results.CustomEvents[0].Fields["token"])
+	require.Equal(t, "1000", results.CustomEvents[0].Fields["amount"])
+}
+
 func TestHexEncodeSlice(t *testing.T) {
 	t.Parallel()
 
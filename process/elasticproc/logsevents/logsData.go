@@ -11,14 +11,18 @@ type logsData struct {
 	txHashStatusInfoProc    txHashStatusInfoHandler
 	tokens                  data.TokensHandler
 	tokensSupply            data.TokensHandler
+	collectionsStats        *collectionsStats
 	txsMap                  map[string]*data.Transaction
 	scrsMap                 map[string]*data.ScResult
 	scDeploys               map[string]*data.ScDeployInfo
 	changeOwnerOperations   map[string]*data.OwnerData
 	delegators              map[string]*data.Delegator
+	providers               map[string]*data.StakingProvider
+	nodes                   map[string]*data.StakingNode
 	tokensInfo              []*data.TokenInfo
 	nftsDataUpdates         []*data.NFTDataUpdate
 	tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties
+	customEvents            []*data.CustomEvent
 }
 
 func newLogsData(
@@ -32,14 +36,43 @@ func newLogsData(
 	ld.scrsMap = converters.ConvertScrsSliceIntoMap(scrs)
 	ld.tokens = data.NewTokensInfo()
 	ld.tokensSupply = data.NewTokensInfo()
+	ld.collectionsStats = newCollectionsStats()
 	ld.timestamp = timestamp
 	ld.scDeploys = make(map[string]*data.ScDeployInfo)
 	ld.tokensInfo = make([]*data.TokenInfo, 0)
 	ld.delegators = make(map[string]*data.Delegator)
+	ld.providers = make(map[string]*data.StakingProvider)
+	ld.nodes = make(map[string]*data.StakingNode)
 	ld.changeOwnerOperations = make(map[string]*data.OwnerData)
 	ld.nftsDataUpdates = make([]*data.NFTDataUpdate, 0)
 	ld.tokenRolesAndProperties = tokeninfo.NewTokenRolesAndProperties()
 	ld.txHashStatusInfoProc = newTxHashStatusInfoProcessor()
+	ld.customEvents = make([]*data.CustomEvent, 0)
 
 	return ld
 }
+
+// addProvider merges the fields changed by an event into the staking provider changed by the previous events of the block
+func (ld *logsData) addProvider(provider *data.StakingProvider) {
+	existing, found := ld.providers[provider.Address]
+	if !found {
+		ld.providers[provider.Address] = provider
+		return
+	}
+
+	if provider.Owner != "" {
+		existing.Owner = provider.Owner
+	}
+	if provider.ServiceFee != nil {
+		existing.ServiceFee = provider.ServiceFee
+	}
+	if provider.TotalDelegationCap != "" {
+		existing.TotalDelegationCap = provider.TotalDelegationCap
+	}
+	if provider.Name != "" || provider.Website != "" || provider.Identity != "" {
+		existing.Name = provider.Name
+		existing.Website = provider.Website
+		existing.Identity = provider.Identity
+	}
+	existing.Timestamp = provider.Timestamp
+}
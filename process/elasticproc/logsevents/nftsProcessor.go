@@ -35,18 +35,19 @@ func newNFTsProcessor(
 		pubKeyConverter: pubKeyConverter,
 		marshalizer:     marshalizer,
 		nftOperationsIdentifiers: map[string]struct{}{
-			core.BuiltInFunctionDCDTNFTBurn:   {},
-			core.BuiltInFunctionDCDTNFTCreate: {},
-			core.BuiltInFunctionDCDTWipe:      {},
+			core.BuiltInFunctionDCDTNFTBurn:        {},
+			core.BuiltInFunctionDCDTNFTCreate:      {},
+			core.BuiltInFunctionDCDTWipe:           {},
+			core.BuiltInFunctionDCDTNFTAddQuantity: {},
 		},
 	}
 }
 
-func (np *nftsProcessor) processEvent(args *argsProcessEvent) argOutputProcessEvent {
-	eventIdentifier := string(args.event.GetIdentifier())
+func (np *nftsProcessor) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	eventIdentifier := string(args.Event.GetIdentifier())
 	_, ok := np.nftOperationsIdentifiers[eventIdentifier]
 	if !ok {
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
 	// topics contains:
@@ -55,56 +56,60 @@ func (np *nftsProcessor) processEvent(args *argsProcessEvent) argOutputProcessEv
 	// [2] --> value
 	// [3] --> receiver NFT address in case of NFTTransfer
 	//     --> DCDT token data in case of NFTCreate
-	topics := args.event.GetTopics()
+	topics := args.Event.GetTopics()
 	nonceBig := big.NewInt(0).SetBytes(topics[1])
 	if nonceBig.Uint64() == 0 {
 		// this is a fungible token so we should return
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
-	sender := args.event.GetAddress()
-	senderShardID := sharding.ComputeShardID(sender, args.numOfShards)
-	if senderShardID == args.selfShardID {
-		np.processNFTEventOnSender(args.event, args.tokens, args.tokensSupply, args.timestamp)
+	sender := args.Event.GetAddress()
+	senderShardID := sharding.ComputeShardID(sender, args.NumOfShards)
+	if senderShardID == args.SelfShardID {
+		np.processNFTEventOnSender(args.Event, args.Tokens, args.TokensSupply, args.CollectionsStats, args.Timestamp)
 	}
 
 	token := string(topics[0])
 	identifier := converters.ComputeTokenIdentifier(token, nonceBig.Uint64())
 
 	if !np.shouldAddReceiverData(args) {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
-	receiver := args.event.GetTopics()[3]
-	receiverShardID := sharding.ComputeShardID(receiver, args.numOfShards)
-	if receiverShardID != args.selfShardID {
-		return argOutputProcessEvent{
-			processed: true,
+	receiver := args.Event.GetTopics()[3]
+	receiverShardID := sharding.ComputeShardID(receiver, args.NumOfShards)
+	if receiverShardID != args.SelfShardID {
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
 	if eventIdentifier == core.BuiltInFunctionDCDTWipe {
-		args.tokensSupply.Add(&data.TokenInfo{
+		args.TokensSupply.Add(&data.TokenInfo{
 			Token:      token,
 			Identifier: identifier,
-			Timestamp:  time.Duration(args.timestamp),
+			Timestamp:  time.Duration(args.Timestamp),
 			Nonce:      nonceBig.Uint64(),
 		})
+		// the wiped quantity is counted by the shard of the wiped account
+		if args.CollectionsStats != nil {
+			args.CollectionsStats.addBurn(token, big.NewInt(0).SetBytes(topics[2]))
+		}
 	}
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
 
-func (np *nftsProcessor) shouldAddReceiverData(args *argsProcessEvent) bool {
-	eventIdentifier := string(args.event.GetIdentifier())
+func (np *nftsProcessor) shouldAddReceiverData(args *ArgsProcessEvent) bool {
+	eventIdentifier := string(args.Event.GetIdentifier())
 	isWrongIdentifier := eventIdentifier != core.BuiltInFunctionDCDTNFTTransfer &&
 		eventIdentifier != core.BuiltInFunctionMultiDCDTNFTTransfer && eventIdentifier != core.BuiltInFunctionDCDTWipe
 
-	if isWrongIdentifier || len(args.event.GetTopics()) < numTopicsWithReceiverAddress {
+	if isWrongIdentifier || len(args.Event.GetTopics()) < numTopicsWithReceiverAddress {
 		return false
 	}
 
@@ -115,11 +120,16 @@ func (np *nftsProcessor) processNFTEventOnSender(
 	event coreData.EventHandler,
 	tokensCreateInfo data.TokensHandler,
 	tokensSupply data.TokensHandler,
+	stats *collectionsStats,
 	timestamp uint64,
 ) {
 	topics := event.GetTopics()
 	token := string(topics[0])
 	nonceBig := big.NewInt(0).SetBytes(topics[1])
+	quantity := big.NewInt(0)
+	if len(topics) > 2 {
+		quantity.SetBytes(topics[2])
+	}
 	eventIdentifier := string(event.GetIdentifier())
 	if eventIdentifier == core.BuiltInFunctionDCDTNFTBurn || eventIdentifier == core.BuiltInFunctionDCDTWipe {
 		tokensSupply.Add(&data.TokenInfo{
@@ -129,6 +139,12 @@ func (np *nftsProcessor) processNFTEventOnSender(
 			Nonce:      nonceBig.Uint64(),
 		})
 	}
+	if stats != nil && eventIdentifier == core.BuiltInFunctionDCDTNFTBurn {
+		stats.addBurn(token, quantity)
+	}
+	if stats != nil && eventIdentifier == core.BuiltInFunctionDCDTNFTAddQuantity {
+		stats.addMint(token, quantity, false, timestamp)
+	}
 
 	isNFTCreate := eventIdentifier == core.BuiltInFunctionDCDTNFTCreate
 	shouldReturn := !isNFTCreate || len(topics) < numTopicsWithReceiverAddress
@@ -145,6 +161,10 @@ func (np *nftsProcessor) processNFTEventOnSender(
 	}
 
 	tokenMetaData := converters.PrepareTokenMetaData(convertMetaData(np.pubKeyConverter, dcdtToken.TokenMetaData))
+	if stats != nil {
+		hasURIs := tokenMetaData != nil && tokenMetaData.NonEmptyURIs
+		stats.addMint(token, quantity, hasURIs, timestamp)
+	}
 	tokensCreateInfo.Add(&data.TokenInfo{
 		Token:      token,
 		Identifier: converters.ComputeTokenIdentifier(token, nonceBig.Uint64()),
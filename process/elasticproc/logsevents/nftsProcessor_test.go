@@ -35,14 +35,14 @@ func TestNftsProcessor_processLogAndEventsNFTs(t *testing.T) {
 	nftsProc := newNFTsProcessor(&mock.PubkeyConverterMock{}, &mock.MarshalizerMock{})
 
 	tokensCreateInfo := data.NewTokensInfo()
-	res := nftsProc.processEvent(&argsProcessEvent{
-		event:       event,
-		tokens:      tokensCreateInfo,
-		timestamp:   1000,
-		selfShardID: 2,
-		numOfShards: 3,
+	res := nftsProc.ProcessEvent(&ArgsProcessEvent{
+		Event:       event,
+		Tokens:      tokensCreateInfo,
+		Timestamp:   1000,
+		SelfShardID: 2,
+		NumOfShards: 3,
 	})
-	require.Equal(t, true, res.processed)
+	require.Equal(t, true, res.Processed)
 	require.Equal(t, &data.TokenInfo{
 		Identifier: "my-token-13",
 		Token:      "my-token",
@@ -70,14 +70,14 @@ func TestNftsProcessor_processLogAndEventsNFTs_Wipe(t *testing.T) {
 	}
 
 	tokensSupply := data.NewTokensInfo()
-	res := nftsProc.processEvent(&argsProcessEvent{
-		event:        events,
-		timestamp:    10000,
-		tokensSupply: tokensSupply,
-		numOfShards:  3,
-		selfShardID:  2,
+	res := nftsProc.ProcessEvent(&ArgsProcessEvent{
+		Event:        events,
+		Timestamp:    10000,
+		TokensSupply: tokensSupply,
+		NumOfShards:  3,
+		SelfShardID:  2,
 	})
-	require.Equal(t, true, res.processed)
+	require.Equal(t, true, res.Processed)
 	require.Equal(t, &data.TokenInfo{
 		Identifier: "nft-0123-14",
 		Token:      "nft-0123",
@@ -85,3 +85,54 @@ func TestNftsProcessor_processLogAndEventsNFTs_Wipe(t *testing.T) {
 		Timestamp:  time.Duration(10000),
 	}, tokensSupply.GetAll()[0])
 }
+
+func TestNftsProcessor_processLogAndEventsNFTs_CollectionsStats(t *testing.T) {
+	t.Parallel()
+
+	dcdtData := &dcdt.DCDigitalToken{
+		TokenMetaData: &dcdt.MetaData{
+			Creator: []byte("creator"),
+			URIs:    [][]byte{[]byte("uri")},
+		},
+	}
+	dcdtDataBytes, _ := json.Marshal(dcdtData)
+
+	nftsProc := newNFTsProcessor(&mock.PubkeyConverterMock{}, &mock.MarshalizerMock{})
+	stats := newCollectionsStats()
+
+	createEvent := &transaction.Event{
+		Address:    []byte("addr"),
+		Identifier: []byte(core.BuiltInFunctionDCDTNFTCreate),
+		Topics:     [][]byte{[]byte("NFT-abcd"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes(), dcdtDataBytes},
+	}
+	burnEvent := &transaction.Event{
+		Address:    []byte("addr"),
+		Identifier: []byte(core.BuiltInFunctionDCDTNFTBurn),
+		Topics:     [][]byte{[]byte("NFT-abcd"), big.NewInt(2).Bytes(), big.NewInt(1).Bytes()},
+	}
+
+	for _, event := range []*transaction.Event{createEvent, burnEvent} {
+		res := nftsProc.ProcessEvent(&ArgsProcessEvent{
+			Event:            event,
+			Tokens:           data.NewTokensInfo(),
+			TokensSupply:     data.NewTokensInfo(),
+			CollectionsStats: stats,
+			Timestamp:        1000,
+			SelfShardID:      2,
+			NumOfShards:      3,
+		})
+		require.True(t, res.Processed)
+	}
+
+	require.Equal(t, &data.CollectionStats{
+		Collection:         "NFT-abcd",
+		Minted:             "1",
+		Burned:             "1",
+		Supply:             "0",
+		NFTsWithURIs:       1,
+		FirstMintTimestamp: 1000,
+		LastMintTimestamp:  1000,
+		ShardID:            2,
+		Timestamp:          1000,
+	}, stats.getAll(1000, 2)["NFT-abcd"])
+}
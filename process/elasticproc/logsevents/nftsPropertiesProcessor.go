@@ -38,22 +38,22 @@ func newNFTsPropertiesProcessor(pubKeyConverter core.PubkeyConverter, marshaller
 	}
 }
 
-func (npp *nftsPropertiesProc) processEvent(args *argsProcessEvent) argOutputProcessEvent {
+func (npp *nftsPropertiesProc) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
 	//nolint
-	eventIdentifier := string(args.event.GetIdentifier())
+	eventIdentifier := string(args.Event.GetIdentifier())
 	_, ok := npp.propertiesChangeOperations[eventIdentifier]
 	if !ok {
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
-	callerAddress := npp.pubKeyConverter.SilentEncode(args.event.GetAddress(), log)
+	callerAddress := npp.pubKeyConverter.SilentEncode(args.Event.GetAddress(), log)
 	if callerAddress == "" {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
-	topics := args.event.GetTopics()
+	topics := args.Event.GetTopics()
 	if len(topics) == 1 {
 		return npp.processPauseAndUnPauseEvent(eventIdentifier, string(topics[0]))
 	}
@@ -67,22 +67,22 @@ func (npp *nftsPropertiesProc) processEvent(args *argsProcessEvent) argOutputPro
 
 	isModifyCreator := len(topics) == minTopicsUpdate-1 && eventIdentifier == core.DCDTModifyCreator
 	if len(topics) < minTopicsUpdate && !isModifyCreator {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
-	callerAddress = npp.pubKeyConverter.SilentEncode(args.event.GetAddress(), log)
+	callerAddress = npp.pubKeyConverter.SilentEncode(args.Event.GetAddress(), log)
 	if callerAddress == "" {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
 	nonceBig := big.NewInt(0).SetBytes(topics[1])
 	if nonceBig.Uint64() == 0 {
 		// this is a fungible token so we should return
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
 	token := string(topics[0])
@@ -117,9 +117,9 @@ func (npp *nftsPropertiesProc) processEvent(args *argsProcessEvent) argOutputPro
 		}
 	}
 
-	return argOutputProcessEvent{
-		processed:     true,
-		updatePropNFT: updateNFT,
+	return ArgOutputProcessEvent{
+		Processed:     true,
+		UpdatePropNFT: updateNFT,
 	}
 }
 
@@ -135,7 +135,7 @@ func (npp *nftsPropertiesProc) processMetaDataUpdate(updateNFT *data.NFTDataUpda
 	updateNFT.NewMetaData = tokenMetaData
 }
 
-func (npp *nftsPropertiesProc) processPauseAndUnPauseEvent(eventIdentifier string, token string) argOutputProcessEvent {
+func (npp *nftsPropertiesProc) processPauseAndUnPauseEvent(eventIdentifier string, token string) ArgOutputProcessEvent {
 	var updateNFT *data.NFTDataUpdate
 
 	switch eventIdentifier {
@@ -151,8 +151,8 @@ func (npp *nftsPropertiesProc) processPauseAndUnPauseEvent(eventIdentifier strin
 		}
 	}
 
-	return argOutputProcessEvent{
-		processed:     true,
-		updatePropNFT: updateNFT,
+	return ArgOutputProcessEvent{
+		Processed:     true,
+		UpdatePropNFT: updateNFT,
 	}
 }
@@ -22,20 +22,20 @@ func TestProcessNFTProperties_Update(t *testing.T) {
 		Identifier: []byte("DCDTNFTUpdateAttributes"),
 		Topics:     [][]byte{[]byte("TOUC-aaaa"), big.NewInt(1).Bytes(), nil, []byte("new-something")},
 	}
-	args := &argsProcessEvent{
-		timestamp: 1234,
-		event:     event,
+	args := &ArgsProcessEvent{
+		Timestamp: 1234,
+		Event:     event,
 	}
 
 	nftsPropertiesP := newNFTsPropertiesProcessor(&mock.PubkeyConverterMock{}, &mock.MarshalizerMock{})
 
-	res := nftsPropertiesP.processEvent(args)
-	require.True(t, res.processed)
+	res := nftsPropertiesP.ProcessEvent(args)
+	require.True(t, res.Processed)
 	require.Equal(t, &data.NFTDataUpdate{
 		Identifier:    "TOUC-aaaa-01",
 		NewAttributes: []byte("new-something"),
 		Address:       "61646472",
-	}, res.updatePropNFT)
+	}, res.UpdatePropNFT)
 }
 
 func TestProcessNFTProperties_AddUris(t *testing.T) {
@@ -46,20 +46,20 @@ func TestProcessNFTProperties_AddUris(t *testing.T) {
 		Identifier: []byte("DCDTNFTAddURI"),
 		Topics:     [][]byte{[]byte("TOUC-aaaa"), big.NewInt(1).Bytes(), nil, []byte("uri1"), []byte("uri2")},
 	}
-	args := &argsProcessEvent{
-		timestamp: 1234,
-		event:     event,
+	args := &ArgsProcessEvent{
+		Timestamp: 1234,
+		Event:     event,
 	}
 
 	nftsPropertiesP := newNFTsPropertiesProcessor(&mock.PubkeyConverterMock{}, &mock.MarshalizerMock{})
 
-	res := nftsPropertiesP.processEvent(args)
-	require.True(t, res.processed)
+	res := nftsPropertiesP.ProcessEvent(args)
+	require.True(t, res.Processed)
 	require.Equal(t, &data.NFTDataUpdate{
 		Identifier: "TOUC-aaaa-01",
 		URIsToAdd:  [][]byte{[]byte("uri1"), []byte("uri2")},
 		Address:    "61646472",
-	}, res.updatePropNFT)
+	}, res.UpdatePropNFT)
 }
 
 func TestProcessNFTMetaDataRecreate(t *testing.T) {
@@ -78,15 +78,15 @@ func TestProcessNFTMetaDataRecreate(t *testing.T) {
 		Identifier: []byte(core.DCDTMetaDataRecreate),
 		Topics:     [][]byte{[]byte("my-token"), big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(1).Bytes(), dcdtDataBytes},
 	}
-	args := &argsProcessEvent{
-		timestamp: 1234,
-		event:     event,
+	args := &ArgsProcessEvent{
+		Timestamp: 1234,
+		Event:     event,
 	}
 
-	res := nftsPropertiesP.processEvent(args)
-	require.True(t, res.processed)
-	require.NotNil(t, res.updatePropNFT.NewMetaData)
-	require.Equal(t, hex.EncodeToString([]byte("creator")), res.updatePropNFT.NewMetaData.Creator)
+	res := nftsPropertiesP.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.NotNil(t, res.UpdatePropNFT.NewMetaData)
+	require.Equal(t, hex.EncodeToString([]byte("creator")), res.UpdatePropNFT.NewMetaData.Creator)
 }
 
 func TestProcessNFTProperties_FreezeAndUnFreeze(t *testing.T) {
@@ -98,16 +98,16 @@ func TestProcessNFTProperties_FreezeAndUnFreeze(t *testing.T) {
 		Identifier: []byte("DCDTFreeze"),
 		Topics:     [][]byte{[]byte("TOUC-aaaa"), big.NewInt(1).Bytes(), nil, []byte("something")},
 	}
-	args := &argsProcessEvent{
-		timestamp: 1234,
-		event:     event,
+	args := &ArgsProcessEvent{
+		Timestamp: 1234,
+		Event:     event,
 	}
 
 	nftsPropertiesP := newNFTsPropertiesProcessor(&mock.PubkeyConverterMock{}, &mock.MarshalizerMock{})
 
-	res := nftsPropertiesP.processEvent(args)
-	require.True(t, res.processed)
-	require.True(t, res.updatePropNFT.Freeze)
+	res := nftsPropertiesP.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.True(t, res.UpdatePropNFT.Freeze)
 
 	// unFreeze
 	event = &transaction.Event{
@@ -115,14 +115,14 @@ func TestProcessNFTProperties_FreezeAndUnFreeze(t *testing.T) {
 		Identifier: []byte("DCDTUnFreeze"),
 		Topics:     [][]byte{[]byte("TOUC-aaaa"), big.NewInt(1).Bytes(), nil, []byte("something")},
 	}
-	args = &argsProcessEvent{
-		timestamp: 1234,
-		event:     event,
+	args = &ArgsProcessEvent{
+		Timestamp: 1234,
+		Event:     event,
 	}
 
-	res = nftsPropertiesP.processEvent(args)
-	require.True(t, res.processed)
-	require.True(t, res.updatePropNFT.UnFreeze)
+	res = nftsPropertiesP.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.True(t, res.UpdatePropNFT.UnFreeze)
 }
 
 func TestProcessPauseAndUnPauseEvent(t *testing.T) {
@@ -130,20 +130,20 @@ func TestProcessPauseAndUnPauseEvent(t *testing.T) {
 
 	// test pause event
 	result := npp.processPauseAndUnPauseEvent(core.BuiltInFunctionDCDTPause, "token1")
-	require.True(t, result.processed, "Expected processed to be true")
-	require.Equal(t, "token1", result.updatePropNFT.Identifier, "Expected identifier to be token1")
-	require.True(t, result.updatePropNFT.Pause, "Expected pause to be true")
-	require.False(t, result.updatePropNFT.UnPause, "Expected unpause to be false")
+	require.True(t, result.Processed, "Expected processed to be true")
+	require.Equal(t, "token1", result.UpdatePropNFT.Identifier, "Expected identifier to be token1")
+	require.True(t, result.UpdatePropNFT.Pause, "Expected pause to be true")
+	require.False(t, result.UpdatePropNFT.UnPause, "Expected unpause to be false")
 
 	// test unpause event
 	result = npp.processPauseAndUnPauseEvent(core.BuiltInFunctionDCDTUnPause, "token2")
-	require.True(t, result.processed, "Expected processed to be true")
-	require.Equal(t, "token2", result.updatePropNFT.Identifier, "Expected identifier to be token2")
-	require.False(t, result.updatePropNFT.Pause, "Expected pause to be false")
-	require.True(t, result.updatePropNFT.UnPause, "Expected unpause to be true")
+	require.True(t, result.Processed, "Expected processed to be true")
+	require.Equal(t, "token2", result.UpdatePropNFT.Identifier, "Expected identifier to be token2")
+	require.False(t, result.UpdatePropNFT.Pause, "Expected pause to be false")
+	require.True(t, result.UpdatePropNFT.UnPause, "Expected unpause to be true")
 
 	// test wrong event
 	result = npp.processPauseAndUnPauseEvent("wrong", "token2")
-	require.Nil(t, result.updatePropNFT, "Expected updatePropNFT to be nil")
-	require.True(t, result.processed, "Expected processed to be true")
+	require.Nil(t, result.UpdatePropNFT, "Expected updatePropNFT to be nil")
+	require.True(t, result.Processed, "Expected processed to be true")
 }
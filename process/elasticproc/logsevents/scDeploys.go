@@ -28,51 +28,51 @@ func newSCDeploysProcessor(pubKeyConverter core.PubkeyConverter) *scDeploysProce
 	}
 }
 
-func (sdp *scDeploysProcessor) processEvent(args *argsProcessEvent) argOutputProcessEvent {
-	eventIdentifier := string(args.event.GetIdentifier())
+func (sdp *scDeploysProcessor) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	eventIdentifier := string(args.Event.GetIdentifier())
 	_, ok := sdp.scDeploysIdentifiers[eventIdentifier]
 	if !ok {
-		return argOutputProcessEvent{}
+		return ArgOutputProcessEvent{}
 	}
 
-	topics := args.event.GetTopics()
+	topics := args.Event.GetTopics()
 	isChangeOwnerEvent := len(topics) == numTopicsChangeOwner && eventIdentifier == core.BuiltInFunctionChangeOwnerAddress
 	if isChangeOwnerEvent {
 		return sdp.processChangeOwnerEvent(args)
 	}
 
 	if len(topics) < minTopicsContractEvent {
-		return argOutputProcessEvent{
-			processed: true,
+		return ArgOutputProcessEvent{
+			Processed: true,
 		}
 	}
 
 	scAddress := sdp.pubKeyConverter.SilentEncode(topics[0], log)
 	creatorAddress := sdp.pubKeyConverter.SilentEncode(topics[1], log)
 
-	args.scDeploys[scAddress] = &data.ScDeployInfo{
-		TxHash:       args.txHashHexEncoded,
+	args.ScDeploys[scAddress] = &data.ScDeployInfo{
+		TxHash:       args.TxHashHexEncoded,
 		Creator:      creatorAddress,
 		CurrentOwner: creatorAddress,
 		CodeHash:     topics[2],
-		Timestamp:    args.timestamp,
+		Timestamp:    args.Timestamp,
 	}
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
 
-func (sdp *scDeploysProcessor) processChangeOwnerEvent(args *argsProcessEvent) argOutputProcessEvent {
-	scAddress := sdp.pubKeyConverter.SilentEncode(args.event.GetAddress(), log)
-	newOwner := sdp.pubKeyConverter.SilentEncode(args.event.GetTopics()[0], log)
-	args.changeOwnerOperations[scAddress] = &data.OwnerData{
-		TxHash:    args.txHashHexEncoded,
+func (sdp *scDeploysProcessor) processChangeOwnerEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	scAddress := sdp.pubKeyConverter.SilentEncode(args.Event.GetAddress(), log)
+	newOwner := sdp.pubKeyConverter.SilentEncode(args.Event.GetTopics()[0], log)
+	args.ChangeOwnerOperations[scAddress] = &data.OwnerData{
+		TxHash:    args.TxHashHexEncoded,
 		Address:   newOwner,
-		Timestamp: time.Duration(args.timestamp),
+		Timestamp: time.Duration(args.Timestamp),
 	}
 
-	return argOutputProcessEvent{
-		processed: true,
+	return ArgOutputProcessEvent{
+		Processed: true,
 	}
 }
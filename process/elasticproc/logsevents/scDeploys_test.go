@@ -22,13 +22,13 @@ func TestScDeploysProcessor(t *testing.T) {
 	}
 
 	scDeploys := map[string]*data.ScDeployInfo{}
-	res := scDeploysProc.processEvent(&argsProcessEvent{
-		event:            event,
-		timestamp:        1000,
-		scDeploys:        scDeploys,
-		txHashHexEncoded: "01020304",
+	res := scDeploysProc.ProcessEvent(&ArgsProcessEvent{
+		Event:            event,
+		Timestamp:        1000,
+		ScDeploys:        scDeploys,
+		TxHashHexEncoded: "01020304",
 	})
-	require.True(t, res.processed)
+	require.True(t, res.Processed)
 
 	require.Equal(t, &data.ScDeployInfo{
 		TxHash:       "01020304",
@@ -49,13 +49,13 @@ func TestScDeploysProcessorChangeOwner(t *testing.T) {
 	scDeploysProc := newSCDeploysProcessor(&mock.PubkeyConverterMock{})
 
 	changeOwnerOperations := map[string]*data.OwnerData{}
-	res := scDeploysProc.processEvent(&argsProcessEvent{
-		event:                 event,
-		changeOwnerOperations: changeOwnerOperations,
-		timestamp:             2000,
-		txHashHexEncoded:      "01020304",
+	res := scDeploysProc.ProcessEvent(&ArgsProcessEvent{
+		Event:                 event,
+		ChangeOwnerOperations: changeOwnerOperations,
+		Timestamp:             2000,
+		TxHashHexEncoded:      "01020304",
 	})
-	require.True(t, res.processed)
+	require.True(t, res.Processed)
 
 	require.Equal(t, &data.OwnerData{
 		TxHash:    "01020304",
@@ -1,6 +1,7 @@
 package logsevents
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -284,8 +285,7 @@ func serializeTokenTransferOwnership(tokenData *data.TokenInfo, index string) ([
 // SerializeSupplyData will serialize the provided supply data
 func (lep *logsAndEventsProcessor) SerializeSupplyData(tokensSupply data.TokensHandler, buffSlice *data.BufferSlice, index string) error {
 	for _, supplyData := range tokensSupply.GetAll() {
-		shouldSkip := supplyData.Type != core.NonFungibleDCDT && supplyData.Type != core.NonFungibleDCDTv2 && supplyData.Type != core.DynamicNFTDCDT
-		if shouldSkip {
+		if !isNFTDeletedOnBurn(supplyData.Type) {
 			continue
 		}
 
@@ -299,6 +299,135 @@ func (lep *logsAndEventsProcessor) SerializeSupplyData(tokensSupply data.TokensH
 	return nil
 }
 
+// SerializeCollectionsStats will serialize the changes done by a block in the statistics of the NFT collections. The
+// changes are added to the statistics of the indexed collections, and a collection that is not indexed is skipped. The
+// last change of every shard is kept together with the timestamp of its block and the mint timestamps it replaced, so the
+// same block is not counted twice and the change can be subtracted in case of revert.
+func (lep *logsAndEventsProcessor) SerializeCollectionsStats(collectionsStats map[string]*data.CollectionStats, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop'
+		} else {
+			if (!ctx._source.containsKey('collectionStats') || ctx._source.collectionStats == null) {
+				ctx._source.collectionStats = ['minted': '0', 'burned': '0', 'supply': '0', 'holders': 0, 'nftsWithURIs': 0];
+			}
+			def stats = ctx._source.collectionStats;
+			if (!stats.containsKey('statsChanges') || stats.statsChanges == null) {
+				stats.statsChanges = [];
+			}
+			def lastChange = null;
+			for (change in stats.statsChanges) {
+				if (change.shardID == params.shardID) {
+					lastChange = change;
+				}
+			}
+			if (lastChange != null && lastChange.timestamp >= params.timestamp) {
+				ctx.op = 'noop';
+			} else {
+				if (lastChange == null) {
+					lastChange = ['shardID': params.shardID];
+					stats.statsChanges.add(lastChange);
+				}
+				lastChange.timestamp = params.timestamp;
+				lastChange.minted = params.stats.minted;
+				lastChange.burned = params.stats.burned;
+				lastChange.supply = params.stats.supply;
+				lastChange.nftsWithURIs = params.stats.nftsWithURIs;
+				lastChange.remove('firstMintTimestamp');
+				lastChange.remove('previousFirstMintTimestamp');
+				lastChange.remove('lastMintTimestamp');
+				lastChange.remove('previousLastMintTimestamp');
+				stats.minted = new BigInteger(stats.minted).add(new BigInteger(params.stats.minted)).toString();
+				stats.burned = new BigInteger(stats.burned).add(new BigInteger(params.stats.burned)).toString();
+				stats.supply = new BigInteger(stats.supply).add(new BigInteger(params.stats.supply)).toString();
+				stats.holders += params.stats.holders;
+				stats.nftsWithURIs += params.stats.nftsWithURIs;
+				if (params.stats.containsKey('firstMintTimestamp')) {
+					if (!stats.containsKey('firstMintTimestamp') || stats.firstMintTimestamp > params.stats.firstMintTimestamp) {
+						lastChange.previousFirstMintTimestamp = stats.firstMintTimestamp;
+						lastChange.firstMintTimestamp = params.stats.firstMintTimestamp;
+						stats.firstMintTimestamp = params.stats.firstMintTimestamp;
+					}
+				}
+				if (params.stats.containsKey('lastMintTimestamp')) {
+					if (!stats.containsKey('lastMintTimestamp') || stats.lastMintTimestamp < params.stats.lastMintTimestamp) {
+						lastChange.previousLastMintTimestamp = stats.lastMintTimestamp;
+						lastChange.lastMintTimestamp = params.stats.lastMintTimestamp;
+						stats.lastMintTimestamp = params.stats.lastMintTimestamp;
+					}
+				}
+			}
+		}
+`
+	for _, stats := range collectionsStats {
+		meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(stats.Collection), "\n"))
+		marshaledStats, err := json.Marshal(stats)
+		if err != nil {
+			return err
+		}
+
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": {"stats": %s, "shardID": %d, "timestamp": %d}},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(marshaledStats), stats.ShardID, stats.Timestamp)
+
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareCollectionsStatsQueryInCaseOfRevert will prepare the update by query that subtracts the changes done by the
+// reverted block in the statistics of the NFT collections. The mint timestamps are restored only if they were not changed
+// by a later block of another shard.
+func (lep *logsAndEventsProcessor) PrepareCollectionsStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := `
+		def stats = ctx._source.collectionStats;
+		for (change in stats.statsChanges) {
+			if (change.shardID == params.shardID && change.timestamp == params.timestamp) {
+				stats.minted = new BigInteger(stats.minted).subtract(new BigInteger(change.minted)).toString();
+				stats.burned = new BigInteger(stats.burned).subtract(new BigInteger(change.burned)).toString();
+				stats.supply = new BigInteger(stats.supply).subtract(new BigInteger(change.supply)).toString();
+				stats.nftsWithURIs -= change.nftsWithURIs;
+				if (change.containsKey('firstMintTimestamp') && stats.firstMintTimestamp == change.firstMintTimestamp) {
+					if (change.previousFirstMintTimestamp == null) {
+						stats.remove('firstMintTimestamp');
+					} else {
+						stats.firstMintTimestamp = change.previousFirstMintTimestamp;
+					}
+				}
+				if (change.containsKey('lastMintTimestamp') && stats.lastMintTimestamp == change.lastMintTimestamp) {
+					if (change.previousLastMintTimestamp == null) {
+						stats.remove('lastMintTimestamp');
+					} else {
+						stats.lastMintTimestamp = change.previousLastMintTimestamp;
+					}
+				}
+				change.timestamp = params.timestamp - 1;
+				change.minted = '0';
+				change.burned = '0';
+				change.supply = '0';
+				change.nftsWithURIs = 0;
+				change.remove('firstMintTimestamp');
+				change.remove('previousFirstMintTimestamp');
+				change.remove('lastMintTimestamp');
+				change.remove('previousLastMintTimestamp');
+			}
+		}
+`
+
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"collectionStats.statsChanges.shardID": {"query": %d,"operator": "AND"}}},{"match": {"collectionStats.statsChanges.timestamp": {"query": "%d","operator": "AND"}}}]}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"shardID": %d, "timestamp": %d}}}`,
+		shardID, timestamp, converters.FormatPainlessSource(codeToExecute), shardID, timestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
 // SerializeRolesData will serialize the provided roles data
 func (lep *logsAndEventsProcessor) SerializeRolesData(
 	tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties,
@@ -405,3 +534,26 @@ func serializePropertiesData(buffSlice *data.BufferSlice, index string, tokenPro
 
 	return buffSlice.PutData(meta, []byte(serializedDataStr))
 }
+
+// SerializeCustomEvents will serialize the documents produced by the custom events processors, each one in its own index,
+// in a way that Elasticsearch expects a bulk request
+func (*logsAndEventsProcessor) SerializeCustomEvents(customEvents []*data.CustomEvent, buffSlice *data.BufferSlice) error {
+	for _, customEvent := range customEvents {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, converters.JsonEscape(customEvent.Index), converters.JsonEscape(customEvent.ID), "\n"))
+		serializedData, err := json.Marshal(customEvent.Fields)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isNFTDeletedOnBurn(tokenType string) bool {
+	return tokenType == core.NonFungibleDCDT || tokenType == core.NonFungibleDCDTv2 || tokenType == core.DynamicNFTDCDT
+}
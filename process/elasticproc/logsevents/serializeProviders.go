@@ -0,0 +1,81 @@
+package logsevents
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeProviders will serialize the provided staking providers in a way that Elasticsearch expects a bulk request.
+// Only the fields changed by the events of the block are updated.
+func (lep *logsAndEventsProcessor) SerializeProviders(providers map[string]*data.StakingProvider, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.provider;
+		} else if (ctx._source.timestamp <= params.provider.timestamp) {
+			for (entry in params.provider.entrySet()) {
+				ctx._source[entry.getKey()] = entry.getValue();
+			}
+		} else {
+			ctx.op = 'noop';
+		}
+`
+	for _, provider := range providers {
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(provider.Address), "\n"))
+		providerSerialized, err := json.Marshal(provider)
+		if err != nil {
+			return err
+		}
+
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": { "provider": %s }},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(providerSerialized),
+		)
+
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeNodes will serialize the provided nodes in a way that Elasticsearch expects a bulk request. A node document
+// is replaced only by a change that is not older.
+func (lep *logsAndEventsProcessor) SerializeNodes(nodes map[string]*data.StakingNode, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op || ctx._source.timestamp <= params.node.timestamp) {
+			ctx._source = params.node;
+		} else {
+			ctx.op = 'noop';
+		}
+`
+	for _, node := range nodes {
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(node.BLSKey), "\n"))
+		nodeSerialized, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": { "node": %s }},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(nodeSerialized),
+		)
+
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package logsevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestLogsAndEventsProcessor_SerializeProviders(t *testing.T) {
+	t.Parallel()
+
+	serviceFee := uint64(0)
+	providers := map[string]*data.StakingProvider{
+		"contract1": {
+			Address:    "contract1",
+			Owner:      "owner1",
+			ServiceFee: &serviceFee,
+			Timestamp:  5000,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&logsAndEventsProcessor{}).SerializeProviders(providers, buffSlice, "providers")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"providers", "_id" : "contract1" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.provider;} else if (ctx._source.timestamp <= params.provider.timestamp) {for (entry in params.provider.entrySet()) {ctx._source[entry.getKey()] = entry.getValue();}} else {ctx.op = 'noop';}","lang": "painless","params": { "provider": {"address":"contract1","owner":"owner1","serviceFee":0,"timestamp":5000} }},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestLogsAndEventsProcessor_SerializeNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := map[string]*data.StakingNode{
+		"bls1": {
+			BLSKey:    "bls1",
+			Owner:     "owner1",
+			Provider:  "contract1",
+			Status:    NodeStatusStaked,
+			TxHash:    "tx1",
+			Timestamp: 5000,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&logsAndEventsProcessor{}).SerializeNodes(nodes, buffSlice, "nodes")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"nodes", "_id" : "bls1" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op || ctx._source.timestamp <= params.node.timestamp) {ctx._source = params.node;} else {ctx.op = 'noop';}","lang": "painless","params": { "node": {"blsKey":"bls1","owner":"owner1","provider":"contract1","status":"staked","txHash":"tx1","timestamp":5000} }},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
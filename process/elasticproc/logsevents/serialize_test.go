@@ -2,6 +2,7 @@ package logsevents
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -164,3 +165,68 @@ func TestLogsAndEventsProcessor_SerializeDelegatorsDelete(t *testing.T) {
 `
 	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
 }
+
+func TestLogsAndEventsProcessor_SerializeCustomEvents(t *testing.T) {
+	t.Parallel()
+
+	customEvents := []*data.CustomEvent{
+		{
+			Index: "swaps",
+			ID:    "hash-0",
+			Fields: map[string]interface{}{
+				"amount": "1000",
+				"token":  "WREWA-abcd",
+			},
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&logsAndEventsProcessor{}).SerializeCustomEvents(customEvents, buffSlice)
+	require.Nil(t, err)
+
+	expectedRes := `{ "index" : { "_index":"swaps", "_id" : "hash-0" } }
+{"amount":"1000","token":"WREWA-abcd"}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestLogsAndEventsProcessor_SerializeCollectionsStats(t *testing.T) {
+	t.Parallel()
+
+	collectionsStats := map[string]*data.CollectionStats{
+		"NFT-abcd": {
+			Collection:         "NFT-abcd",
+			Minted:             "2",
+			Burned:             "1",
+			Supply:             "1",
+			NFTsWithURIs:       1,
+			FirstMintTimestamp: 1000,
+			LastMintTimestamp:  1000,
+			ShardID:            1,
+			Timestamp:          1000,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&logsAndEventsProcessor{}).SerializeCollectionsStats(collectionsStats, buffSlice, "tokens")
+	require.Nil(t, err)
+
+	res := buffSlice.Buffers()[0].String()
+	require.True(t, strings.HasPrefix(res, `{ "update" : {"_index":"tokens", "_id" : "NFT-abcd" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop'}`))
+	require.True(t, strings.HasSuffix(res, `"lang": "painless","params": {"stats": {"minted":"2","burned":"1","supply":"1","holders":0,"nftsWithURIs":1,"firstMintTimestamp":1000,"lastMintTimestamp":1000}, "shardID": 1, "timestamp": 1000}},"upsert": {}}
+`))
+	require.Contains(t, res, `lastChange.timestamp = params.timestamp;lastChange.minted = params.stats.minted;`)
+}
+
+func TestLogsAndEventsProcessor_PrepareCollectionsStatsQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	query := (&logsAndEventsProcessor{}).PrepareCollectionsStatsQueryInCaseOfRevert(5000, 1).String()
+	require.True(t, strings.HasPrefix(query, `{"query": {"bool": {"must": [{"match": {"collectionStats.statsChanges.shardID": {"query": 1,"operator": "AND"}}},{"match": {"collectionStats.statsChanges.timestamp": {"query": "5000","operator": "AND"}}}]}},`))
+	require.Contains(t, query, `stats.minted = new BigInteger(stats.minted).subtract(new BigInteger(change.minted)).toString();`)
+	require.Contains(t, query, `stats.nftsWithURIs -= change.nftsWithURIs;`)
+	require.Contains(t, query, `stats.firstMintTimestamp = change.previousFirstMintTimestamp;`)
+	require.Contains(t, query, `change.timestamp = params.timestamp - 1;change.minted = '0';`)
+	require.True(t, strings.HasSuffix(query, `"params": {"shardID": 1, "timestamp": 5000}}}`))
+}
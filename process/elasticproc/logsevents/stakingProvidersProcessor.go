@@ -0,0 +1,194 @@
+package logsevents
+
+import (
+	"bytes"
+	"math/big"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+const (
+	createNewDelegationContractFunc = "createNewDelegationContract"
+	changeServiceFeeFunc            = "changeServiceFee"
+	modifyTotalDelegationCapFunc    = "modifyTotalDelegationCap"
+	setMetaDataFunc                 = "setMetaData"
+	addNodesFunc                    = "addNodes"
+	stakeNodesFunc                  = "stakeNodes"
+	unStakeNodesFunc                = "unStakeNodes"
+	unBondNodesFunc                 = "unBondNodes"
+	removeNodesFunc                 = "removeNodes"
+	validatorStakeFunc              = "stake"
+	validatorUnStakeFunc            = "unStake"
+	validatorUnBondFunc             = "unBond"
+
+	// NodeStatusNotStaked marks a node that was added to a staking provider, but is not staked or was unbonded
+	NodeStatusNotStaked = "notStaked"
+	// NodeStatusStaked marks a staked node
+	NodeStatusStaked = "staked"
+	// NodeStatusUnStaked marks a node that was unstaked and waits for the unbonding period
+	NodeStatusUnStaked = "unStaked"
+	// NodeStatusRemoved marks a node that was removed from its staking provider
+	NodeStatusRemoved = "removed"
+
+	numTopicsSetMetaData = 3
+)
+
+// validatorSCAddress is the address of the validator system smart contract, which stakes the nodes of the owners that
+// do not use a staking provider
+var validatorSCAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 255, 255}
+
+type stakingProvidersProc struct {
+	pubKeyConverter          core.PubkeyConverter
+	validatorPubKeyConverter core.PubkeyConverter
+	providersOperations      map[string]struct{}
+	nodesOperations          map[string]string
+	validatorNodesOperations map[string]string
+}
+
+func newStakingProvidersProcessor(pubKeyConverter core.PubkeyConverter, validatorPubKeyConverter core.PubkeyConverter) *stakingProvidersProc {
+	return &stakingProvidersProc{
+		pubKeyConverter:          pubKeyConverter,
+		validatorPubKeyConverter: validatorPubKeyConverter,
+		providersOperations: map[string]struct{}{
+			createNewDelegationContractFunc: {},
+			changeServiceFeeFunc:            {},
+			modifyTotalDelegationCapFunc:    {},
+			setMetaDataFunc:                 {},
+		},
+		nodesOperations: map[string]string{
+			addNodesFunc:     NodeStatusNotStaked,
+			stakeNodesFunc:   NodeStatusStaked,
+			unStakeNodesFunc: NodeStatusUnStaked,
+			unBondNodesFunc:  NodeStatusNotStaked,
+			removeNodesFunc:  NodeStatusRemoved,
+		},
+		validatorNodesOperations: map[string]string{
+			validatorStakeFunc:   NodeStatusStaked,
+			validatorUnStakeFunc: NodeStatusUnStaked,
+			validatorUnBondFunc:  NodeStatusNotStaked,
+		},
+	}
+}
+
+func (spp *stakingProvidersProc) ProcessEvent(args *ArgsProcessEvent) ArgOutputProcessEvent {
+	eventIdentifierStr := string(args.Event.GetIdentifier())
+	status, isNodesOperation := spp.nodesOperations[eventIdentifierStr]
+	if isNodesOperation {
+		return ArgOutputProcessEvent{
+			Nodes:     spp.getNodesFromEvent(args, spp.pubKeyConverter.SilentEncode(args.LogAddress, log), status),
+			Processed: true,
+		}
+	}
+
+	status, isValidatorNodesOperation := spp.validatorNodesOperations[eventIdentifierStr]
+	if isValidatorNodesOperation && bytes.Equal(args.LogAddress, validatorSCAddress) {
+		return spp.processValidatorNodesEvent(args, status)
+	}
+
+	_, isProvidersOperation := spp.providersOperations[eventIdentifierStr]
+	if !isProvidersOperation {
+		return ArgOutputProcessEvent{}
+	}
+
+	return ArgOutputProcessEvent{
+		Provider:  spp.getProviderFromEvent(args, eventIdentifierStr),
+		Processed: true,
+	}
+}
+
+// the providers operations can be called only by the owner of the staking provider, which is the event address
+func (spp *stakingProvidersProc) getProviderFromEvent(args *ArgsProcessEvent, eventIdentifierStr string) *data.StakingProvider {
+	topics := args.Event.GetTopics()
+	provider := &data.StakingProvider{
+		Address:   spp.pubKeyConverter.SilentEncode(args.LogAddress, log),
+		Owner:     spp.pubKeyConverter.SilentEncode(args.Event.GetAddress(), log),
+		Timestamp: time.Duration(args.Timestamp),
+	}
+
+	switch eventIdentifierStr {
+	case createNewDelegationContractFunc:
+		// topics[0] -- the address of the new delegation contract
+		// topics[1] -- total delegation cap
+		// topics[2] -- service fee
+		if len(topics) == 0 || len(topics[0]) != spp.pubKeyConverter.Len() {
+			return nil
+		}
+		provider.Address = spp.pubKeyConverter.SilentEncode(topics[0], log)
+		if len(topics) > 1 {
+			provider.TotalDelegationCap = big.NewInt(0).SetBytes(topics[1]).String()
+		}
+		if len(topics) > 2 {
+			provider.ServiceFee = bytesToServiceFee(topics[2])
+		}
+	case changeServiceFeeFunc:
+		// topics[0] -- the new service fee
+		if len(topics) == 0 {
+			return nil
+		}
+		provider.ServiceFee = bytesToServiceFee(topics[0])
+	case modifyTotalDelegationCapFunc:
+		// topics[0] -- the new total delegation cap
+		if len(topics) == 0 {
+			return nil
+		}
+		provider.TotalDelegationCap = big.NewInt(0).SetBytes(topics[0]).String()
+	case setMetaDataFunc:
+		// topics[0] -- name
+		// topics[1] -- website
+		// topics[2] -- identity
+		if len(topics) < numTopicsSetMetaData {
+			return nil
+		}
+		provider.Name = string(topics[0])
+		provider.Website = string(topics[1])
+		provider.Identity = string(topics[2])
+	}
+
+	return provider
+}
+
+// the staking providers stake their nodes through the validator contract, but their nodes are indexed from their own
+// events, so only the nodes staked directly by their owners are taken from the validator contract events
+func (spp *stakingProvidersProc) processValidatorNodesEvent(args *ArgsProcessEvent, status string) ArgOutputProcessEvent {
+	if core.IsSmartContractAddress(args.Event.GetAddress()) {
+		return ArgOutputProcessEvent{
+			Processed: true,
+		}
+	}
+
+	return ArgOutputProcessEvent{
+		Nodes:     spp.getNodesFromEvent(args, "", status),
+		Processed: true,
+	}
+}
+
+// the nodes operations can be called only by the owner of the nodes or of the staking provider, the topics holding the
+// BLS keys of the nodes. The topics that are not BLS keys, like the signatures of the added nodes, are skipped
+func (spp *stakingProvidersProc) getNodesFromEvent(args *ArgsProcessEvent, provider string, status string) []*data.StakingNode {
+	owner := spp.pubKeyConverter.SilentEncode(args.Event.GetAddress(), log)
+
+	nodes := make([]*data.StakingNode, 0)
+	for _, topic := range args.Event.GetTopics() {
+		if len(topic) != spp.validatorPubKeyConverter.Len() {
+			continue
+		}
+
+		nodes = append(nodes, &data.StakingNode{
+			BLSKey:    spp.validatorPubKeyConverter.SilentEncode(topic, log),
+			Owner:     owner,
+			Provider:  provider,
+			Status:    status,
+			TxHash:    args.TxHashHexEncoded,
+			Timestamp: time.Duration(args.Timestamp),
+		})
+	}
+
+	return nodes
+}
+
+func bytesToServiceFee(serviceFeeBytes []byte) *uint64 {
+	serviceFee := big.NewInt(0).SetBytes(serviceFeeBytes).Uint64()
+	return &serviceFee
+}
@@ -0,0 +1,214 @@
+package logsevents
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+)
+
+func createStakingProvidersProcessor() *stakingProvidersProc {
+	return newStakingProvidersProcessor(mock.NewPubkeyConverterMock(32), mock.NewPubkeyConverterMock(96))
+}
+
+func TestStakingProvidersProcessor_ProcessEventNotHandled(t *testing.T) {
+	t.Parallel()
+
+	res := createStakingProvidersProcessor().ProcessEvent(&ArgsProcessEvent{
+		Event: &transaction.Event{Identifier: []byte(delegateFunc)},
+	})
+	require.Equal(t, ArgOutputProcessEvent{}, res)
+}
+
+func TestStakingProvidersProcessor_CreateNewDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	contract := bytes.Repeat([]byte{1}, 32)
+	event := &transaction.Event{
+		Address:    []byte("owner"),
+		Identifier: []byte(createNewDelegationContractFunc),
+		Topics:     [][]byte{contract, big.NewInt(5000).Bytes(), big.NewInt(1000).Bytes()},
+	}
+
+	res := createStakingProvidersProcessor().ProcessEvent(&ArgsProcessEvent{
+		Event:      event,
+		LogAddress: []byte("manager"),
+		Timestamp:  1234,
+	})
+	require.True(t, res.Processed)
+
+	serviceFee := uint64(1000)
+	require.Equal(t, &data.StakingProvider{
+		Address:            hex.EncodeToString(contract),
+		Owner:              hex.EncodeToString([]byte("owner")),
+		ServiceFee:         &serviceFee,
+		TotalDelegationCap: "5000",
+		Timestamp:          1234,
+	}, res.Provider)
+
+	event.Topics = [][]byte{[]byte("short")}
+	res = createStakingProvidersProcessor().ProcessEvent(&ArgsProcessEvent{Event: event})
+	require.True(t, res.Processed)
+	require.Nil(t, res.Provider)
+}
+
+func TestStakingProvidersProcessor_ProviderOperations(t *testing.T) {
+	t.Parallel()
+
+	spp := createStakingProvidersProcessor()
+	args := &ArgsProcessEvent{
+		LogAddress: []byte("contract"),
+		Timestamp:  1234,
+	}
+
+	args.Event = &transaction.Event{Address: []byte("owner"), Identifier: []byte(changeServiceFeeFunc), Topics: [][]byte{{}}}
+	res := spp.ProcessEvent(args)
+	serviceFee := uint64(0)
+	require.Equal(t, &serviceFee, res.Provider.ServiceFee)
+	require.Equal(t, hex.EncodeToString([]byte("contract")), res.Provider.Address)
+	require.Equal(t, hex.EncodeToString([]byte("owner")), res.Provider.Owner)
+
+	args.Event = &transaction.Event{Address: []byte("owner"), Identifier: []byte(modifyTotalDelegationCapFunc), Topics: [][]byte{big.NewInt(100).Bytes()}}
+	res = spp.ProcessEvent(args)
+	require.Equal(t, "100", res.Provider.TotalDelegationCap)
+	require.Nil(t, res.Provider.ServiceFee)
+
+	args.Event = &transaction.Event{Address: []byte("owner"), Identifier: []byte(setMetaDataFunc), Topics: [][]byte{[]byte("name"), []byte("website"), []byte("identity")}}
+	res = spp.ProcessEvent(args)
+	require.Equal(t, "name", res.Provider.Name)
+	require.Equal(t, "website", res.Provider.Website)
+	require.Equal(t, "identity", res.Provider.Identity)
+
+	args.Event = &transaction.Event{Address: []byte("owner"), Identifier: []byte(setMetaDataFunc), Topics: [][]byte{[]byte("name")}}
+	res = spp.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.Nil(t, res.Provider)
+}
+
+func TestStakingProvidersProcessor_NodesOperations(t *testing.T) {
+	t.Parallel()
+
+	blsKey1 := bytes.Repeat([]byte{1}, 96)
+	blsKey2 := bytes.Repeat([]byte{2}, 96)
+	signature := bytes.Repeat([]byte{3}, 48)
+
+	spp := createStakingProvidersProcessor()
+	args := &ArgsProcessEvent{
+		TxHashHexEncoded: "txHash",
+		LogAddress:       []byte("contract"),
+		Timestamp:        1234,
+		Event: &transaction.Event{
+			Address:    []byte("owner"),
+			Identifier: []byte(addNodesFunc),
+			Topics:     [][]byte{blsKey1, signature, blsKey2, signature},
+		},
+	}
+
+	res := spp.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.Equal(t, []*data.StakingNode{
+		{
+			BLSKey:    hex.EncodeToString(blsKey1),
+			Owner:     hex.EncodeToString([]byte("owner")),
+			Provider:  hex.EncodeToString([]byte("contract")),
+			Status:    NodeStatusNotStaked,
+			TxHash:    "txHash",
+			Timestamp: 1234,
+		},
+		{
+			BLSKey:    hex.EncodeToString(blsKey2),
+			Owner:     hex.EncodeToString([]byte("owner")),
+			Provider:  hex.EncodeToString([]byte("contract")),
+			Status:    NodeStatusNotStaked,
+			TxHash:    "txHash",
+			Timestamp: 1234,
+		},
+	}, res.Nodes)
+
+	expectedStatuses := map[string]string{
+		stakeNodesFunc:   NodeStatusStaked,
+		unStakeNodesFunc: NodeStatusUnStaked,
+		unBondNodesFunc:  NodeStatusNotStaked,
+		removeNodesFunc:  NodeStatusRemoved,
+	}
+	for identifier, status := range expectedStatuses {
+		args.Event = &transaction.Event{Address: []byte("owner"), Identifier: []byte(identifier), Topics: [][]byte{blsKey1}}
+		res = spp.ProcessEvent(args)
+		require.Len(t, res.Nodes, 1)
+		require.Equal(t, status, res.Nodes[0].Status)
+	}
+}
+
+func TestStakingProvidersProcessor_ValidatorNodesOperations(t *testing.T) {
+	t.Parallel()
+
+	blsKey := bytes.Repeat([]byte{1}, 96)
+	owner := bytes.Repeat([]byte{2}, 32)
+
+	spp := createStakingProvidersProcessor()
+	args := &ArgsProcessEvent{
+		TxHashHexEncoded: "txHash",
+		LogAddress:       validatorSCAddress,
+		Timestamp:        1234,
+		Event:            &transaction.Event{Address: owner, Identifier: []byte(validatorStakeFunc), Topics: [][]byte{blsKey}},
+	}
+
+	res := spp.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.Equal(t, []*data.StakingNode{
+		{
+			BLSKey:    hex.EncodeToString(blsKey),
+			Owner:     hex.EncodeToString(owner),
+			Status:    NodeStatusStaked,
+			TxHash:    "txHash",
+			Timestamp: 1234,
+		},
+	}, res.Nodes)
+
+	expectedStatuses := map[string]string{
+		validatorUnStakeFunc: NodeStatusUnStaked,
+		validatorUnBondFunc:  NodeStatusNotStaked,
+	}
+	for identifier, status := range expectedStatuses {
+		args.Event = &transaction.Event{Address: owner, Identifier: []byte(identifier), Topics: [][]byte{blsKey}}
+		res = spp.ProcessEvent(args)
+		require.Len(t, res.Nodes, 1)
+		require.Equal(t, status, res.Nodes[0].Status)
+	}
+
+	delegationContract := append(make([]byte, 10), bytes.Repeat([]byte{3}, 22)...)
+	args.Event = &transaction.Event{Address: delegationContract, Identifier: []byte(validatorStakeFunc), Topics: [][]byte{blsKey}}
+	res = spp.ProcessEvent(args)
+	require.True(t, res.Processed)
+	require.Empty(t, res.Nodes)
+
+	args.LogAddress = []byte("contract")
+	args.Event = &transaction.Event{Address: owner, Identifier: []byte(validatorStakeFunc), Topics: [][]byte{blsKey}}
+	require.Equal(t, ArgOutputProcessEvent{}, spp.ProcessEvent(args))
+}
+
+func TestLogsData_AddProvider(t *testing.T) {
+	t.Parallel()
+
+	serviceFee := uint64(1000)
+	ld := newLogsData(0, nil, nil)
+	ld.addProvider(&data.StakingProvider{Address: "contract", Owner: "owner", ServiceFee: &serviceFee, Timestamp: 1})
+	ld.addProvider(&data.StakingProvider{Address: "contract", Owner: "owner", Name: "name", Identity: "identity", Timestamp: 2})
+
+	require.Equal(t, map[string]*data.StakingProvider{
+		"contract": {
+			Address:    "contract",
+			Owner:      "owner",
+			ServiceFee: &serviceFee,
+			Name:       "name",
+			Identity:   "identity",
+			Timestamp:  2,
+		},
+	}, ld.providers)
+}
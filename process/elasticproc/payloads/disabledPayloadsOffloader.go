@@ -0,0 +1,27 @@
+package payloads
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type disabledPayloadsOffloader struct{}
+
+// NewDisabledPayloadsOffloader will create a new instance of disabledPayloadsOffloader
+func NewDisabledPayloadsOffloader() *disabledPayloadsOffloader {
+	return &disabledPayloadsOffloader{}
+}
+
+// OffloadPayloads returns an empty map
+func (dpo *disabledPayloadsOffloader) OffloadPayloads(_ *data.PreparedResults, _ uint64) map[string]*data.Payload {
+	return make(map[string]*data.Payload)
+}
+
+// SerializePayloads does nothing
+func (dpo *disabledPayloadsOffloader) SerializePayloads(_ map[string]*data.Payload, _ *data.BufferSlice, _ string) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpo *disabledPayloadsOffloader) IsInterfaceNil() bool {
+	return dpo == nil
+}
@@ -0,0 +1,18 @@
+package payloads
+
+type disabledPayloadsReader struct{}
+
+// NewDisabledPayloadsReader will create a new instance of disabledPayloadsReader
+func NewDisabledPayloadsReader() *disabledPayloadsReader {
+	return &disabledPayloadsReader{}
+}
+
+// GetPayload returns ErrPayloadNotFound, as no payload is offloaded
+func (dpr *disabledPayloadsReader) GetPayload(_ string) ([]byte, error) {
+	return nil, ErrPayloadNotFound
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpr *disabledPayloadsReader) IsInterfaceNil() bool {
+	return dpr == nil
+}
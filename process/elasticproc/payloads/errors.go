@@ -0,0 +1,12 @@
+package payloads
+
+import "errors"
+
+// ErrInvalidThreshold signals that an invalid size threshold of the offloaded payloads has been provided
+var ErrInvalidThreshold = errors.New("invalid payloads offloading threshold")
+
+// ErrInvalidPrefixLength signals that the length of the kept data prefix is not lower than the threshold
+var ErrInvalidPrefixLength = errors.New("invalid payloads offloading prefix length")
+
+// ErrPayloadNotFound signals that the payload was not found
+var ErrPayloadNotFound = errors.New("payload not found")
@@ -0,0 +1,11 @@
+package payloads
+
+import (
+	"context"
+)
+
+// DatabaseClientHandler defines the actions that the payloads reader needs from the database client
+type DatabaseClientHandler interface {
+	DoMultiGet(ctx context.Context, ids []string, index string, withSource bool, res interface{}) error
+	IsInterfaceNil() bool
+}
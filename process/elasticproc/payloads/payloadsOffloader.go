@@ -0,0 +1,117 @@
+package payloads
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+var log = logger.GetOrCreate("indexer/process/payloads")
+
+// ArgsPayloadsOffloader holds all the components needed to create a new payloads offloader
+type ArgsPayloadsOffloader struct {
+	Hasher           hashing.Hasher
+	ThresholdInBytes int
+	PrefixLength     int
+}
+
+type payloadsOffloader struct {
+	hasher       hashing.Hasher
+	threshold    int
+	prefixLength int
+}
+
+// NewPayloadsOffloader will create a new instance of payloadsOffloader
+func NewPayloadsOffloader(args ArgsPayloadsOffloader) (*payloadsOffloader, error) {
+	if check.IfNil(args.Hasher) {
+		return nil, dataindexer.ErrNilHasher
+	}
+	if args.ThresholdInBytes <= 0 {
+		return nil, ErrInvalidThreshold
+	}
+	if args.PrefixLength < 0 || args.PrefixLength >= args.ThresholdInBytes {
+		return nil, ErrInvalidPrefixLength
+	}
+
+	return &payloadsOffloader{
+		hasher:       args.Hasher,
+		threshold:    args.ThresholdInBytes,
+		prefixLength: args.PrefixLength,
+	}, nil
+}
+
+// OffloadPayloads will move the data fields of the transactions and of the smart contract results that exceed the
+// threshold into payloads keyed by the hex encoded hash of the data. The documents keep only a prefix of the data,
+// together with the hash and the length of the full data
+func (po *payloadsOffloader) OffloadPayloads(preparedResults *data.PreparedResults, timestamp uint64) map[string]*data.Payload {
+	payloads := make(map[string]*data.Payload)
+	if preparedResults == nil {
+		return payloads
+	}
+
+	for _, tx := range preparedResults.Transactions {
+		tx.Data, tx.DataHash, tx.DataLength = po.offloadData(tx.Data, timestamp, payloads)
+	}
+	for _, scr := range preparedResults.ScResults {
+		scr.Data, scr.DataHash, scr.DataLength = po.offloadData(scr.Data, timestamp, payloads)
+	}
+
+	return payloads
+}
+
+func (po *payloadsOffloader) offloadData(dataField []byte, timestamp uint64, payloads map[string]*data.Payload) ([]byte, string, int) {
+	if len(dataField) <= po.threshold {
+		return dataField, "", 0
+	}
+
+	hash := hex.EncodeToString(po.hasher.Compute(string(dataField)))
+	_, alreadyOffloaded := payloads[hash]
+	if !alreadyOffloaded {
+		compressedData, err := compress(dataField)
+		if err != nil {
+			log.Warn("payloadsOffloader: cannot compress the data, keeping it in the document", "hash", hash, "error", err)
+			return dataField, "", 0
+		}
+
+		payloads[hash] = &data.Payload{
+			Hash:      hash,
+			Data:      compressedData,
+			Length:    len(dataField),
+			Timestamp: time.Duration(timestamp),
+		}
+	}
+
+	prefix := make([]byte, po.prefixLength)
+	copy(prefix, dataField[:po.prefixLength])
+
+	return prefix, hash, len(dataField)
+}
+
+func compress(dataField []byte) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	writer := gzip.NewWriter(buff)
+	_, err := writer.Write(dataField)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (po *payloadsOffloader) IsInterfaceNil() bool {
+	return po == nil
+}
@@ -0,0 +1,119 @@
+package payloads
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func createMockArgsPayloadsOffloader() ArgsPayloadsOffloader {
+	return ArgsPayloadsOffloader{
+		Hasher:           &mock.HasherMock{},
+		ThresholdInBytes: 10,
+		PrefixLength:     4,
+	}
+}
+
+func TestNewPayloadsOffloader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPayloadsOffloader()
+	args.Hasher = nil
+	po, err := NewPayloadsOffloader(args)
+	require.Nil(t, po)
+	require.Equal(t, dataindexer.ErrNilHasher, err)
+
+	args = createMockArgsPayloadsOffloader()
+	args.ThresholdInBytes = 0
+	po, err = NewPayloadsOffloader(args)
+	require.Nil(t, po)
+	require.Equal(t, ErrInvalidThreshold, err)
+
+	args = createMockArgsPayloadsOffloader()
+	args.PrefixLength = 10
+	po, err = NewPayloadsOffloader(args)
+	require.Nil(t, po)
+	require.Equal(t, ErrInvalidPrefixLength, err)
+
+	po, err = NewPayloadsOffloader(createMockArgsPayloadsOffloader())
+	require.Nil(t, err)
+	require.False(t, po.IsInterfaceNil())
+}
+
+func TestPayloadsOffloader_OffloadPayloads(t *testing.T) {
+	t.Parallel()
+
+	po, _ := NewPayloadsOffloader(createMockArgsPayloadsOffloader())
+
+	largeData := []byte("deploy@0061736d0100000001")
+	largeDataHash := hex.EncodeToString(mock.HasherMock{}.Compute(string(largeData)))
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Data: []byte("transfer")},
+			{Hash: "tx2", Data: largeData},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "scr1", Data: largeData},
+			{Hash: "scr2"},
+		},
+	}
+
+	payloads := po.OffloadPayloads(preparedResults, 5000)
+	require.Len(t, payloads, 1)
+	require.Equal(t, largeDataHash, payloads[largeDataHash].Hash)
+	require.Equal(t, len(largeData), payloads[largeDataHash].Length)
+	require.Equal(t, time.Duration(5000), payloads[largeDataHash].Timestamp)
+
+	decompressedData, err := decompress(payloads[largeDataHash].Data)
+	require.Nil(t, err)
+	require.Equal(t, largeData, decompressedData)
+
+	require.Equal(t, &data.Transaction{Hash: "tx1", Data: []byte("transfer")}, preparedResults.Transactions[0])
+	require.Equal(t, &data.Transaction{Hash: "tx2", Data: []byte("depl"), DataHash: largeDataHash, DataLength: len(largeData)}, preparedResults.Transactions[1])
+	require.Equal(t, &data.ScResult{Hash: "scr1", Data: []byte("depl"), DataHash: largeDataHash, DataLength: len(largeData)}, preparedResults.ScResults[0])
+	require.Equal(t, &data.ScResult{Hash: "scr2"}, preparedResults.ScResults[1])
+}
+
+func TestPayloadsOffloader_SerializePayloads(t *testing.T) {
+	t.Parallel()
+
+	po, _ := NewPayloadsOffloader(createMockArgsPayloadsOffloader())
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := po.SerializePayloads(map[string]*data.Payload{
+		"bb": {Hash: "bb", Data: []byte{2}, Length: 20, Timestamp: 5000},
+		"aa": {Hash: "aa", Data: []byte{1}, Length: 10, Timestamp: 5000},
+	}, buffSlice, dataindexer.PayloadsIndex)
+	require.Nil(t, err)
+
+	expected := `{ "index" : { "_index":"payloads", "_id" : "aa" } }
+{"data":"AQ==","length":10,"timestamp":5000}
+{ "index" : { "_index":"payloads", "_id" : "bb" } }
+{"data":"Ag==","length":20,"timestamp":5000}
+`
+	require.Equal(t, expected, buffSlice.Buffers()[0].String())
+}
+
+func TestDisabledPayloads(t *testing.T) {
+	t.Parallel()
+
+	dpo := NewDisabledPayloadsOffloader()
+	require.False(t, dpo.IsInterfaceNil())
+	preparedResults := &data.PreparedResults{Transactions: []*data.Transaction{{Data: bytes.Repeat([]byte("a"), 100)}}}
+	require.Empty(t, dpo.OffloadPayloads(preparedResults, 0))
+	require.Len(t, preparedResults.Transactions[0].Data, 100)
+	require.Nil(t, dpo.SerializePayloads(nil, nil, ""))
+
+	dpr := NewDisabledPayloadsReader()
+	require.False(t, dpr.IsInterfaceNil())
+	payload, err := dpr.GetPayload("aa")
+	require.Nil(t, payload)
+	require.Equal(t, ErrPayloadNotFound, err)
+}
@@ -0,0 +1,68 @@
+package payloads
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+type payloadsReader struct {
+	dbClients []DatabaseClientHandler
+}
+
+// NewPayloadsReader will create a new instance of payloadsReader, which reads the payloads indexes of the provided
+// database clients, one for every pipeline
+func NewPayloadsReader(dbClients ...DatabaseClientHandler) (*payloadsReader, error) {
+	if len(dbClients) == 0 {
+		return nil, dataindexer.ErrNilDatabaseClient
+	}
+	for _, dbClient := range dbClients {
+		if check.IfNil(dbClient) {
+			return nil, dataindexer.ErrNilDatabaseClient
+		}
+	}
+
+	return &payloadsReader{
+		dbClients: dbClients,
+	}, nil
+}
+
+// GetPayload returns the full data field with the provided hash. The payloads are keyed by the hash of the data, so
+// the first payloads index that holds the hash is used
+func (pr *payloadsReader) GetPayload(hash string) ([]byte, error) {
+	for _, dbClient := range pr.dbClients {
+		response := &data.ResponsePayloads{}
+		err := dbClient.DoMultiGet(context.Background(), []string{hash}, dataindexer.PayloadsIndex, true, response)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Docs) > 0 && response.Docs[0].Found {
+			return decompress(response.Docs[0].Source.Data)
+		}
+	}
+
+	return nil, ErrPayloadNotFound
+}
+
+func decompress(compressedData []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressedData))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	return io.ReadAll(reader)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *payloadsReader) IsInterfaceNil() bool {
+	return pr == nil
+}
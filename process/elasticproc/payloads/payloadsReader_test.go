@@ -0,0 +1,73 @@
+package payloads
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func TestPayloadsReader_GetPayload(t *testing.T) {
+	t.Parallel()
+
+	pr, err := NewPayloadsReader()
+	require.Nil(t, pr)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	pr, err = NewPayloadsReader(&mock.DatabaseWriterStub{}, nil)
+	require.Nil(t, pr)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	compressedData, _ := compress(bytes.Repeat([]byte("a"), 100))
+	pr, _ = NewPayloadsReader(&mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Equal(t, dataindexer.PayloadsIndex, index)
+			require.True(t, withSource)
+
+			found := ids[0] == "aa"
+			response.(*data.ResponsePayloads).Docs = []data.ResponsePayloadDB{
+				{Found: found, ID: ids[0], Source: data.Payload{Data: compressedData, Length: 100}},
+			}
+			return nil
+		},
+	})
+	require.False(t, pr.IsInterfaceNil())
+
+	payload, err := pr.GetPayload("aa")
+	require.Nil(t, err)
+	require.Equal(t, bytes.Repeat([]byte("a"), 100), payload)
+
+	payload, err = pr.GetPayload("bb")
+	require.Nil(t, payload)
+	require.Equal(t, ErrPayloadNotFound, err)
+}
+
+func TestPayloadsReader_GetPayloadFromAnyPipeline(t *testing.T) {
+	t.Parallel()
+
+	compressedData, _ := compress([]byte("data"))
+	createDatabaseClient := func(hash string) *mock.DatabaseWriterStub {
+		return &mock.DatabaseWriterStub{
+			DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+				response.(*data.ResponsePayloads).Docs = []data.ResponsePayloadDB{
+					{Found: ids[0] == hash, ID: ids[0], Source: data.Payload{Data: compressedData, Length: 4}},
+				}
+				return nil
+			},
+		}
+	}
+
+	pr, _ := NewPayloadsReader(createDatabaseClient("aa"), createDatabaseClient("bb"))
+
+	payload, err := pr.GetPayload("bb")
+	require.Nil(t, err)
+	require.Equal(t, []byte("data"), payload)
+
+	payload, err = pr.GetPayload("cc")
+	require.Nil(t, payload)
+	require.Equal(t, ErrPayloadNotFound, err)
+}
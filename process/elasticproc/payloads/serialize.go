@@ -0,0 +1,35 @@
+package payloads
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializePayloads will serialize the provided payloads. The payloads are keyed by the hash of their content, so
+// indexing the same payload again only overwrites it with the same content
+func (po *payloadsOffloader) SerializePayloads(payloads map[string]*data.Payload, buffSlice *data.BufferSlice, index string) error {
+	hashes := make([]string, 0, len(payloads))
+	for hash := range payloads {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	for _, hash := range hashes {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(hash), "\n"))
+		serializedData, err := json.Marshal(payloads[hash])
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
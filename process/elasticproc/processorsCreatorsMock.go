@@ -0,0 +1,45 @@
+package elasticproc
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+// BlockProcessorCreatorMock -
+type BlockProcessorCreatorMock struct {
+	CreateBlockProcessorCalled func(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (DBBlockHandler, error)
+}
+
+// CreateBlockProcessor -
+func (bpcm *BlockProcessorCreatorMock) CreateBlockProcessor(hasher hashing.Hasher, marshalizer marshal.Marshalizer) (DBBlockHandler, error) {
+	if bpcm.CreateBlockProcessorCalled != nil {
+		return bpcm.CreateBlockProcessorCalled(hasher, marshalizer)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bpcm *BlockProcessorCreatorMock) IsInterfaceNil() bool {
+	return bpcm == nil
+}
+
+// AccountsProcessorCreatorMock -
+type AccountsProcessorCreatorMock struct {
+	CreateAccountsProcessorCalled func(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (DBAccountHandler, error)
+}
+
+// CreateAccountsProcessor -
+func (apcm *AccountsProcessorCreatorMock) CreateAccountsProcessor(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (DBAccountHandler, error) {
+	if apcm.CreateAccountsProcessorCalled != nil {
+		return apcm.CreateAccountsProcessorCalled(pubKeyConverter, balanceConverter)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (apcm *AccountsProcessorCreatorMock) IsInterfaceNil() bool {
+	return apcm == nil
+}
@@ -0,0 +1,401 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	indexerCore "github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+)
+
+const (
+	// StuckPendingTransactionsMetric is the gauge holding the number of cross-shard transactions stuck in the pending status
+	StuckPendingTransactionsMetric = "stuckPendingTransactions"
+	// StuckPendingScResultsMetric is the gauge holding the number of cross-shard smart contract results not executed on destination
+	StuckPendingScResultsMetric = "stuckPendingScResults"
+
+	reconciliationTopicShard = core.AllShardId
+	maxIDsPerRequest         = 1000
+)
+
+var log = logger.GetOrCreate("indexer/process/reconciliation")
+
+// ArgsCrossShardReconciler holds all the components needed to create a new instance of crossShardReconciler
+type ArgsCrossShardReconciler struct {
+	DBClient           DatabaseClientHandler
+	StatusMetrics      elasticproc.GaugeMetricsHandler
+	Interval           time.Duration
+	PendingTimeout     time.Duration
+	BulkRequestMaxSize int
+}
+
+// crossShardReconciler periodically verifies the cross-shard transactions and smart contract results that are still
+// waiting for the destination shard. A cross-shard item is executed on destination once its miniblock has the
+// receiver block hash set. The pending transactions whose miniblock was executed are finalized with the status given
+// by the error events of the destination, while the items whose miniblock was not executed after the pending timeout
+// are flagged with stuckPending and counted in the status metrics.
+type crossShardReconciler struct {
+	dbClient           DatabaseClientHandler
+	statusMetrics      elasticproc.GaugeMetricsHandler
+	interval           time.Duration
+	pendingTimeout     time.Duration
+	bulkRequestMaxSize int
+	timeNow            func() time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type pendingDocument struct {
+	MBHash       string `json:"miniBlockHash"`
+	StuckPending bool   `json:"stuckPending"`
+}
+
+type responseMiniblocks struct {
+	Docs []struct {
+		ID     string `json:"_id"`
+		Found  bool   `json:"found"`
+		Source struct {
+			ReceiverBlockHash string `json:"receiverBlockHash"`
+		} `json:"_source"`
+	} `json:"docs"`
+}
+
+// NewCrossShardReconciler will create a new instance of crossShardReconciler and will start the periodic reconciliation
+func NewCrossShardReconciler(args ArgsCrossShardReconciler) (*crossShardReconciler, error) {
+	if check.IfNil(args.DBClient) {
+		return nil, dataindexer.ErrNilDatabaseClient
+	}
+	if check.IfNil(args.StatusMetrics) {
+		return nil, indexerCore.ErrNilMetricsHandler
+	}
+	if args.Interval <= 0 {
+		return nil, ErrInvalidReconciliationInterval
+	}
+	if args.PendingTimeout <= 0 {
+		return nil, ErrInvalidPendingTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	csr := &crossShardReconciler{
+		dbClient:           args.DBClient,
+		statusMetrics:      args.StatusMetrics,
+		interval:           args.Interval,
+		pendingTimeout:     args.PendingTimeout,
+		bulkRequestMaxSize: args.BulkRequestMaxSize,
+		timeNow:            time.Now,
+		cancel:             cancel,
+	}
+
+	csr.wg.Add(1)
+	go csr.run(ctx)
+
+	return csr, nil
+}
+
+func (csr *crossShardReconciler) run(ctx context.Context) {
+	defer csr.wg.Done()
+
+	ticker := time.NewTicker(csr.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			startTime := time.Now()
+			err := csr.reconcile(ctx)
+			if err != nil {
+				log.Warn("crossShardReconciler.run: cannot reconcile the cross-shard items", "error", err)
+				continue
+			}
+
+			log.Debug("crossShardReconciler.run: reconciled the cross-shard items", "duration", time.Since(startTime))
+		}
+	}
+}
+
+func (csr *crossShardReconciler) reconcile(ctx context.Context) error {
+	maxTimestamp := csr.timeNow().Add(-csr.pendingTimeout).Unix()
+
+	numStuckTxs, err := csr.reconcileTransactions(ctx, maxTimestamp)
+	if err != nil {
+		return err
+	}
+	csr.statusMetrics.SetGauge(StuckPendingTransactionsMetric, numStuckTxs)
+
+	numStuckSCRs, err := csr.reconcileScResults(ctx, maxTimestamp)
+	if err != nil {
+		return err
+	}
+	csr.statusMetrics.SetGauge(StuckPendingScResultsMetric, numStuckSCRs)
+
+	return nil
+}
+
+// reconcileTransactions finalizes the pending transactions executed on destination, flags the other ones and returns
+// the number of stuck transactions
+func (csr *crossShardReconciler) reconcileTransactions(ctx context.Context, maxTimestamp int64) (uint64, error) {
+	pendingTxs := make(map[string]*pendingDocument)
+	err := csr.scrollDocuments(ctx, dataindexer.TransactionsIndex, pendingTransactionsQuery(maxTimestamp), true, func(id string, source json.RawMessage) error {
+		pendingTx := &pendingDocument{}
+		pendingTxs[id] = pendingTx
+		return json.Unmarshal(source, pendingTx)
+	})
+	if err != nil || len(pendingTxs) == 0 {
+		return 0, err
+	}
+
+	mbHashes := make(map[string]struct{})
+	for _, pendingTx := range pendingTxs {
+		mbHashes[pendingTx.MBHash] = struct{}{}
+	}
+	executedMiniblocks, err := csr.getExecutedMiniblocks(ctx, sortedKeys(mbHashes))
+	if err != nil {
+		return 0, err
+	}
+
+	executedTxs := make([]string, 0)
+	txsToFlag := make([]string, 0)
+	numStuckTxs := uint64(0)
+	for _, txHash := range sortedKeys(pendingTxs) {
+		pendingTx := pendingTxs[txHash]
+		_, executed := executedMiniblocks[pendingTx.MBHash]
+		if executed {
+			executedTxs = append(executedTxs, txHash)
+			continue
+		}
+
+		numStuckTxs++
+		if !pendingTx.StuckPending {
+			txsToFlag = append(txsToFlag, txHash)
+		}
+	}
+
+	failedTxs, err := csr.getFailedTransactions(ctx, executedTxs)
+	if err != nil {
+		return 0, err
+	}
+
+	buffSlice := data.NewBufferSlice(csr.bulkRequestMaxSize)
+	for _, txHash := range executedTxs {
+		status := transaction.TxStatusSuccess.String()
+		if _, failed := failedTxs[txHash]; failed {
+			status = transaction.TxStatusFail.String()
+		}
+
+		err = serializeFinalizedTransaction(txHash, status, buffSlice)
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, txHash := range txsToFlag {
+		err = serializeStuckTransaction(txHash, buffSlice)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if len(executedTxs) > 0 || len(txsToFlag) > 0 {
+		log.Debug("crossShardReconciler.reconcileTransactions", "finalized", len(executedTxs), "flagged", len(txsToFlag), "stuck", numStuckTxs)
+	}
+
+	return numStuckTxs, csr.doBulkRequests(ctx, buffSlice, dataindexer.TransactionsIndex)
+}
+
+// reconcileScResults flags the smart contract results whose miniblock was not executed on destination, removes the flag
+// of the ones that were executed in the meantime and returns the number of stuck smart contract results. The smart
+// contract results do not have a pending status, so they are tracked only through their miniblock.
+func (csr *crossShardReconciler) reconcileScResults(ctx context.Context, maxTimestamp int64) (uint64, error) {
+	stuckMiniblocks := make(map[string]struct{})
+	err := csr.scrollDocuments(ctx, dataindexer.MiniblocksIndex, stuckScResultsMiniblocksQuery(maxTimestamp), false, func(id string, _ json.RawMessage) error {
+		stuckMiniblocks[id] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	numStuckSCRs := uint64(0)
+	scrsToFlag := make([]string, 0)
+	flagHandler := func(id string, source json.RawMessage) error {
+		stuckSCR := &pendingDocument{}
+		errUnmarshal := json.Unmarshal(source, stuckSCR)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
+
+		numStuckSCRs++
+		if !stuckSCR.StuckPending {
+			scrsToFlag = append(scrsToFlag, id)
+		}
+		return nil
+	}
+
+	mbHashes := sortedKeys(stuckMiniblocks)
+	for start := 0; start < len(mbHashes); start += maxIDsPerRequest {
+		end := min(start+maxIDsPerRequest, len(mbHashes))
+		err = csr.scrollDocuments(ctx, dataindexer.ScResultsIndex, scResultsByMiniblocksQuery(mbHashes[start:end]), true, flagHandler)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	scrsToUnflag := make([]string, 0)
+	err = csr.scrollDocuments(ctx, dataindexer.ScResultsIndex, []byte(flaggedDocumentsQuery), true, func(id string, source json.RawMessage) error {
+		flaggedSCR := &pendingDocument{}
+		errUnmarshal := json.Unmarshal(source, flaggedSCR)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
+
+		if _, stuck := stuckMiniblocks[flaggedSCR.MBHash]; !stuck {
+			scrsToUnflag = append(scrsToUnflag, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sort.Strings(scrsToFlag)
+	sort.Strings(scrsToUnflag)
+	buffSlice := data.NewBufferSlice(csr.bulkRequestMaxSize)
+	for _, scrHash := range scrsToFlag {
+		err = serializeStuckScResult(scrHash, buffSlice)
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, scrHash := range scrsToUnflag {
+		err = serializeExecutedScResult(scrHash, buffSlice)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if len(scrsToFlag) > 0 || len(scrsToUnflag) > 0 {
+		log.Debug("crossShardReconciler.reconcileScResults", "flagged", len(scrsToFlag), "unflagged", len(scrsToUnflag), "stuck", numStuckSCRs)
+	}
+
+	return numStuckSCRs, csr.doBulkRequests(ctx, buffSlice, dataindexer.ScResultsIndex)
+}
+
+// getExecutedMiniblocks returns the miniblocks that have the receiver block hash set. A miniblock that is not indexed
+// cannot be verified, so it is considered not executed
+func (csr *crossShardReconciler) getExecutedMiniblocks(ctx context.Context, mbHashes []string) (map[string]struct{}, error) {
+	executed := make(map[string]struct{})
+	getCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, reconciliationTopicShard))
+	for start := 0; start < len(mbHashes); start += maxIDsPerRequest {
+		end := min(start+maxIDsPerRequest, len(mbHashes))
+
+		response := &responseMiniblocks{}
+		err := csr.dbClient.DoMultiGet(getCtx, mbHashes[start:end], dataindexer.MiniblocksIndex, true, response)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, doc := range response.Docs {
+			if doc.Found && doc.Source.ReceiverBlockHash != "" {
+				executed[doc.ID] = struct{}{}
+			}
+		}
+	}
+
+	return executed, nil
+}
+
+// getFailedTransactions returns the transactions that generated an error event on the destination shard
+func (csr *crossShardReconciler) getFailedTransactions(ctx context.Context, txHashes []string) (map[string]struct{}, error) {
+	failed := make(map[string]struct{})
+	handler := func(_ string, source json.RawMessage) error {
+		event := &data.LogEvent{}
+		err := json.Unmarshal(source, event)
+		if err != nil {
+			return err
+		}
+
+		failed[event.TxHash] = struct{}{}
+		if event.OriginalTxHash != "" {
+			failed[event.OriginalTxHash] = struct{}{}
+		}
+		return nil
+	}
+
+	for start := 0; start < len(txHashes); start += maxIDsPerRequest {
+		end := min(start+maxIDsPerRequest, len(txHashes))
+		err := csr.scrollDocuments(ctx, dataindexer.EventsIndex, errorEventsQuery(txHashes[start:end]), true, handler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return failed, nil
+}
+
+func (csr *crossShardReconciler) scrollDocuments(ctx context.Context, index string, query []byte, withSource bool, handler func(id string, source json.RawMessage) error) error {
+	scrollCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.ScrollTopic, reconciliationTopicShard))
+	return csr.dbClient.DoScrollRequest(scrollCtx, index, query, withSource, func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			err = handler(hit.ID, hit.Source)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (csr *crossShardReconciler) doBulkRequests(ctx context.Context, buffSlice *data.BufferSlice, index string) error {
+	bulkCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.BulkTopic, reconciliationTopicShard))
+	for _, buff := range buffSlice.Buffers() {
+		err := csr.dbClient.DoBulkRequest(bulkCtx, buff, index)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys[T any](items map[string]T) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Close will stop the periodic reconciliation
+func (csr *crossShardReconciler) Close() error {
+	csr.cancel()
+	csr.wg.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (csr *crossShardReconciler) IsInterfaceNil() bool {
+	return csr == nil
+}
@@ -0,0 +1,233 @@
+package reconciliation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	indexerCore "github.com/TerraDharitri/drt-go-chain-es-indexer/core"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func createMockArgsCrossShardReconciler() ArgsCrossShardReconciler {
+	return ArgsCrossShardReconciler{
+		DBClient:           &mock.DatabaseWriterStub{},
+		StatusMetrics:      &mock.StatusMetricsStub{},
+		Interval:           time.Hour,
+		PendingTimeout:     time.Minute,
+		BulkRequestMaxSize: data.DefaultMaxBulkSize,
+	}
+}
+
+func TestNewCrossShardReconciler(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCrossShardReconciler()
+	args.DBClient = nil
+	csr, err := NewCrossShardReconciler(args)
+	require.Nil(t, csr)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	args = createMockArgsCrossShardReconciler()
+	args.StatusMetrics = nil
+	csr, err = NewCrossShardReconciler(args)
+	require.Nil(t, csr)
+	require.Equal(t, indexerCore.ErrNilMetricsHandler, err)
+
+	args = createMockArgsCrossShardReconciler()
+	args.Interval = 0
+	csr, err = NewCrossShardReconciler(args)
+	require.Nil(t, csr)
+	require.Equal(t, ErrInvalidReconciliationInterval, err)
+
+	args = createMockArgsCrossShardReconciler()
+	args.PendingTimeout = 0
+	csr, err = NewCrossShardReconciler(args)
+	require.Nil(t, csr)
+	require.Equal(t, ErrInvalidPendingTimeout, err)
+
+	csr, err = NewCrossShardReconciler(createMockArgsCrossShardReconciler())
+	require.Nil(t, err)
+	require.False(t, csr.IsInterfaceNil())
+	require.Nil(t, csr.Close())
+}
+
+func TestCrossShardReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	pendingTxsResponse := `{"hits": {"hits": [
+		{"_id": "tx1", "_source": {"miniBlockHash": "mb1"}},
+		{"_id": "tx2", "_source": {"miniBlockHash": "mb1"}},
+		{"_id": "tx3", "_source": {"miniBlockHash": "mb2"}},
+		{"_id": "tx4", "_source": {"miniBlockHash": "mb3", "stuckPending": true}}
+	]}}`
+	miniblocksResponse := `{"docs": [
+		{"_id": "mb1", "found": true, "_source": {"receiverBlockHash": "h1"}},
+		{"_id": "mb2", "found": true, "_source": {"senderBlockHash": "h2"}},
+		{"_id": "mb3", "found": false}
+	]}`
+
+	bulkBodies := make(map[string]string)
+	gauges := make(map[string]uint64)
+	args := createMockArgsCrossShardReconciler()
+	args.StatusMetrics = &mock.StatusMetricsStub{
+		SetGaugeCalled: func(name string, value uint64) {
+			gauges[name] = value
+		},
+	}
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			switch {
+			case index == dataindexer.TransactionsIndex:
+				require.Contains(t, string(body), `{"range": {"timestamp": {"lte": 4940}}}`)
+				return handlerFunc([]byte(pendingTxsResponse))
+			case index == dataindexer.EventsIndex:
+				require.Contains(t, string(body), `{"terms": {"txHash": ["tx1","tx2"]}}`)
+				return handlerFunc([]byte(`{"hits": {"hits": [{"_id": "e1", "_source": {"txHash": "tx2", "identifier": "signalError"}}]}}`))
+			case index == dataindexer.MiniblocksIndex:
+				require.Contains(t, string(body), `"SmartContractResultBlock"`)
+				return handlerFunc([]byte(`{"hits": {"hits": [{"_id": "mb4"}]}}`))
+			case strings.Contains(string(body), `"terms": {"miniBlockHash"`):
+				require.Contains(t, string(body), `["mb4"]`)
+				return handlerFunc([]byte(`{"hits": {"hits": [
+					{"_id": "scr1", "_source": {"miniBlockHash": "mb4"}},
+					{"_id": "scr2", "_source": {"miniBlockHash": "mb4", "stuckPending": true}}
+				]}}`))
+			default:
+				require.Contains(t, string(body), `"stuckPending": true`)
+				return handlerFunc([]byte(`{"hits": {"hits": [
+					{"_id": "scr2", "_source": {"miniBlockHash": "mb4"}},
+					{"_id": "scr3", "_source": {"miniBlockHash": "mb5"}}
+				]}}`))
+			}
+		},
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Equal(t, dataindexer.MiniblocksIndex, index)
+			require.Equal(t, []string{"mb1", "mb2", "mb3"}, ids)
+			return json.Unmarshal([]byte(miniblocksResponse), response)
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkBodies[index] += buff.String()
+			return nil
+		},
+	}
+	csr, _ := NewCrossShardReconciler(args)
+	defer func() {
+		_ = csr.Close()
+	}()
+	csr.timeNow = func() time.Time {
+		return time.Unix(5000, 0)
+	}
+
+	err := csr.reconcile(context.Background())
+	require.Nil(t, err)
+
+	txsBody := bulkBodies[dataindexer.TransactionsIndex]
+	require.Equal(t, 3, strings.Count(txsBody, `{ "update" : `))
+	require.Contains(t, txsBody, `{ "update" : {"_index":"transactions", "_id" : "tx1" } }`+"\n"+`{"script": {"source": "if (ctx._source.status == params.pending) {ctx._source.status = params.status;ctx._source.remove('stuckPending');} else {ctx.op = 'noop';}","lang": "painless","params": {"pending": "pending", "status": "success"}}}`)
+	require.Contains(t, txsBody, `{ "update" : {"_index":"transactions", "_id" : "tx2" } }`+"\n"+`{"script": {"source": "if (ctx._source.status == params.pending) {ctx._source.status = params.status;ctx._source.remove('stuckPending');} else {ctx.op = 'noop';}","lang": "painless","params": {"pending": "pending", "status": "fail"}}}`)
+	require.Contains(t, txsBody, `{ "update" : {"_index":"transactions", "_id" : "tx3" } }`+"\n"+`{"script": {"source": "if (ctx._source.status == params.pending) {ctx._source.stuckPending = true;} else {ctx.op = 'noop';}","lang": "painless","params": {"pending": "pending"}}}`)
+	require.NotContains(t, txsBody, `"tx4"`)
+
+	scrsBody := bulkBodies[dataindexer.ScResultsIndex]
+	require.Equal(t, 2, strings.Count(scrsBody, `{ "update" : `))
+	require.Contains(t, scrsBody, `{ "update" : {"_index":"scresults", "_id" : "scr1" } }`+"\n"+`{"doc": {"stuckPending": true}}`)
+	require.Contains(t, scrsBody, `{ "update" : {"_index":"scresults", "_id" : "scr3" } }`+"\n"+`{"script": {"source": "ctx._source.remove('stuckPending');", "lang": "painless"}}`)
+
+	require.Equal(t, uint64(2), gauges[StuckPendingTransactionsMetric])
+	require.Equal(t, uint64(2), gauges[StuckPendingScResultsMetric])
+}
+
+func TestCrossShardReconciler_GetFailedTransactionsByOriginalTxHash(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsCrossShardReconciler()
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.EventsIndex, index)
+			require.Contains(t, string(body), `"should": [{"terms": {"txHash": ["tx1","tx2"]}}, {"terms": {"originalTxHash": ["tx1","tx2"]}}], "minimum_should_match": 1`)
+			return handlerFunc([]byte(`{"hits": {"hits": [{"_id": "e1", "_source": {"txHash": "scr1", "originalTxHash": "tx1", "identifier": "signalError"}}]}}`))
+		},
+	}
+	csr, _ := NewCrossShardReconciler(args)
+	defer func() {
+		_ = csr.Close()
+	}()
+
+	failed, err := csr.getFailedTransactions(context.Background(), []string{"tx1", "tx2"})
+	require.Nil(t, err)
+	require.Contains(t, failed, "tx1")
+	require.NotContains(t, failed, "tx2")
+}
+
+func TestCrossShardReconciler_ReconcileNothingPending(t *testing.T) {
+	t.Parallel()
+
+	gauges := make(map[string]uint64)
+	args := createMockArgsCrossShardReconciler()
+	args.StatusMetrics = &mock.StatusMetricsStub{
+		SetGaugeCalled: func(name string, value uint64) {
+			gauges[name] = value
+		},
+	}
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return handlerFunc([]byte(`{"hits": {"hits": []}}`))
+		},
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	csr, _ := NewCrossShardReconciler(args)
+	defer func() {
+		_ = csr.Close()
+	}()
+
+	err := csr.reconcile(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, map[string]uint64{StuckPendingTransactionsMetric: 0, StuckPendingScResultsMetric: 0}, gauges)
+}
+
+func TestCrossShardReconciler_ReconcileMiniblocksUnavailable(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("miniblocks unavailable")
+	args := createMockArgsCrossShardReconciler()
+	args.StatusMetrics = &mock.StatusMetricsStub{
+		SetGaugeCalled: func(name string, value uint64) {
+			require.Fail(t, "the metrics should not change")
+		},
+	}
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return handlerFunc([]byte(`{"hits": {"hits": [{"_id": "tx1", "_source": {"miniBlockHash": "mb1"}}]}}`))
+		},
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			return expectedErr
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	csr, _ := NewCrossShardReconciler(args)
+	defer func() {
+		_ = csr.Close()
+	}()
+
+	err := csr.reconcile(context.Background())
+	require.Equal(t, expectedErr, err)
+}
@@ -0,0 +1,18 @@
+package reconciliation
+
+type disabledCrossShardReconciler struct{}
+
+// NewDisabledCrossShardReconciler will create a new instance of disabledCrossShardReconciler
+func NewDisabledCrossShardReconciler() *disabledCrossShardReconciler {
+	return &disabledCrossShardReconciler{}
+}
+
+// Close returns nil
+func (dcsr *disabledCrossShardReconciler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcsr *disabledCrossShardReconciler) IsInterfaceNil() bool {
+	return dcsr == nil
+}
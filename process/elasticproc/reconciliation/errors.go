@@ -0,0 +1,9 @@
+package reconciliation
+
+import "errors"
+
+// ErrInvalidReconciliationInterval signals that the interval between two reconciliations is not valid
+var ErrInvalidReconciliationInterval = errors.New("invalid reconciliation interval")
+
+// ErrInvalidPendingTimeout signals that the duration after which a pending item is verified is not valid
+var ErrInvalidPendingTimeout = errors.New("invalid pending timeout")
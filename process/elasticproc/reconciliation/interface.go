@@ -0,0 +1,14 @@
+package reconciliation
+
+import (
+	"bytes"
+	"context"
+)
+
+// DatabaseClientHandler defines the actions that the cross-shard reconciler needs from the database client
+type DatabaseClientHandler interface {
+	DoBulkRequest(ctx context.Context, buff *bytes.Buffer, index string) error
+	DoScrollRequest(ctx context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
+	DoMultiGet(ctx context.Context, ids []string, index string, withSource bool, res interface{}) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,108 @@
+package reconciliation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/block"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+const flaggedDocumentsQuery = `{"query": {"term": {"stuckPending": true}}, "_source": ["miniBlockHash"]}`
+
+func pendingTransactionsQuery(maxTimestamp int64) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"filter": [`+
+		`{"term": {"status": "%s"}}, `+
+		`{"range": {"timestamp": {"lte": %d}}}`+
+		`]}}, "_source": ["miniBlockHash", "stuckPending"]}`, transaction.TxStatusPending.String(), maxTimestamp))
+}
+
+func stuckScResultsMiniblocksQuery(maxTimestamp int64) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"filter": [`+
+		`{"term": {"type": "%s"}}, `+
+		`{"range": {"timestamp": {"lte": %d}}}`+
+		`], "must_not": [`+
+		`{"exists": {"field": "receiverBlockHash"}}`+
+		`]}}}`, block.SmartContractResultBlock.String(), maxTimestamp))
+}
+
+func scResultsByMiniblocksQuery(mbHashes []string) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"terms": {"miniBlockHash": %s}}, "_source": ["miniBlockHash", "stuckPending"]}`, marshalStrings(mbHashes)))
+}
+
+// the error event of a cross-shard transaction can be logged by one of its smart contract results, so the events are
+// matched by the original transaction hash as well
+func errorEventsQuery(txHashes []string) []byte {
+	marshaledTxHashes := marshalStrings(txHashes)
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"filter": [`+
+		`{"terms": {"identifier": %s}}`+
+		`], "should": [`+
+		`{"terms": {"txHash": %s}}, `+
+		`{"terms": {"originalTxHash": %s}}`+
+		`], "minimum_should_match": 1}}, "_source": ["txHash", "originalTxHash"]}`,
+		marshalStrings([]string{core.SignalErrorOperation, core.InternalVMErrorsOperation}), marshaledTxHashes, marshaledTxHashes))
+}
+
+func marshalStrings(values []string) string {
+	marshaled, _ := json.Marshal(values)
+	return string(marshaled)
+}
+
+// the source shard could write the transaction again in the meantime, but it never changes the status of a pending
+// transaction, while the destination shard replaces the whole document, so only the pending transactions are updated
+func serializeFinalizedTransaction(txHash string, status string, buffSlice *data.BufferSlice) error {
+	codeToExecute := `
+		if (ctx._source.status == params.pending) {
+			ctx._source.status = params.status;
+			ctx._source.remove('stuckPending');
+		} else {
+			ctx.op = 'noop';
+		}
+`
+	serializedData := fmt.Sprintf(`{"script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": {"pending": "%s", "status": "%s"}}}`,
+		converters.FormatPainlessSource(codeToExecute), transaction.TxStatusPending.String(), converters.JsonEscape(status))
+
+	return putUpdate(dataindexer.TransactionsIndex, txHash, serializedData, buffSlice)
+}
+
+func serializeStuckTransaction(txHash string, buffSlice *data.BufferSlice) error {
+	codeToExecute := `
+		if (ctx._source.status == params.pending) {
+			ctx._source.stuckPending = true;
+		} else {
+			ctx.op = 'noop';
+		}
+`
+	serializedData := fmt.Sprintf(`{"script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": {"pending": "%s"}}}`,
+		converters.FormatPainlessSource(codeToExecute), transaction.TxStatusPending.String())
+
+	return putUpdate(dataindexer.TransactionsIndex, txHash, serializedData, buffSlice)
+}
+
+func serializeStuckScResult(scrHash string, buffSlice *data.BufferSlice) error {
+	return putUpdate(dataindexer.ScResultsIndex, scrHash, `{"doc": {"stuckPending": true}}`, buffSlice)
+}
+
+func serializeExecutedScResult(scrHash string, buffSlice *data.BufferSlice) error {
+	serializedData := fmt.Sprintf(`{"script": {"source": "%s", "lang": "painless"}}`,
+		converters.FormatPainlessSource(`ctx._source.remove('stuckPending');`))
+
+	return putUpdate(dataindexer.ScResultsIndex, scrHash, serializedData, buffSlice)
+}
+
+func putUpdate(index string, id string, serializedData string, buffSlice *data.BufferSlice) error {
+	meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(id), "\n"))
+
+	return buffSlice.PutData(meta, []byte(serializedData))
+}
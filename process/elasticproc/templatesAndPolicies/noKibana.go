@@ -43,6 +43,19 @@ func (tr *templatesAndPolicyReaderNoKibana) GetElasticTemplatesAndPolicies() (ma
 	indexTemplates[indexer.DCDTsIndex] = noKibana.DCDTs.ToBuffer()
 	indexTemplates[indexer.ValuesIndex] = noKibana.Values.ToBuffer()
 	indexTemplates[indexer.EventsIndex] = noKibana.Events.ToBuffer()
+	indexTemplates[indexer.TransfersIndex] = noKibana.Transfers.ToBuffer()
+	indexTemplates[indexer.ContractCallsIndex] = noKibana.ContractCalls.ToBuffer()
+	indexTemplates[indexer.ContractCallStatsIndex] = noKibana.ContractCallStats.ToBuffer()
+	indexTemplates[indexer.TxTracesIndex] = noKibana.TxTraces.ToBuffer()
+	indexTemplates[indexer.TxStatusHistoryIndex] = noKibana.TxStatusHistory.ToBuffer()
+	indexTemplates[indexer.UsernamesIndex] = noKibana.Usernames.ToBuffer()
+	indexTemplates[indexer.GuardiansIndex] = noKibana.Guardians.ToBuffer()
+	indexTemplates[indexer.ProvidersIndex] = noKibana.Providers.ToBuffer()
+	indexTemplates[indexer.NodesIndex] = noKibana.Nodes.ToBuffer()
+	indexTemplates[indexer.AddressActivityIndex] = noKibana.AddressActivity.ToBuffer()
+	indexTemplates[indexer.BridgeOperationsIndex] = noKibana.BridgeOperations.ToBuffer()
+	indexTemplates[indexer.BridgeTransfersIndex] = noKibana.BridgeTransfers.ToBuffer()
+	indexTemplates[indexer.PayloadsIndex] = noKibana.Payloads.ToBuffer()
 
 	return indexTemplates, indexPolicies, nil
 }
@@ -51,3 +64,9 @@ func (tr *templatesAndPolicyReaderNoKibana) GetElasticTemplatesAndPolicies() (ma
 func (tr *templatesAndPolicyReaderNoKibana) GetExtraMappings() ([]templates.ExtraMapping, error) {
 	return []templates.ExtraMapping{}, nil
 }
+
+// GetCustomEventsTemplate will return the template of the provided custom events index
+func (tr *templatesAndPolicyReaderNoKibana) GetCustomEventsTemplate(index string) *bytes.Buffer {
+	template := noKibana.CustomEvents(index)
+	return template.ToBuffer()
+}
@@ -14,5 +14,15 @@ func TestTemplatesAndPolicyReaderNoKibana_GetElasticTemplatesAndPolicies(t *test
 	templates, policies, err := reader.GetElasticTemplatesAndPolicies()
 	require.Nil(t, err)
 	require.Len(t, policies, 0)
-	require.Len(t, templates, 23)
+	require.Len(t, templates, 36)
+}
+
+func TestTemplatesAndPolicyReaderNoKibana_GetCustomEventsTemplate(t *testing.T) {
+	t.Parallel()
+
+	reader := NewTemplatesAndPolicyReaderNoKibana()
+
+	template := reader.GetCustomEventsTemplate("swaps")
+	require.Contains(t, template.String(), `"index_patterns":["swaps-*"]`)
+	require.Contains(t, template.String(), `"eventID":{"type":"keyword"}`)
 }
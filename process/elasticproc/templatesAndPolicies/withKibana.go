@@ -52,6 +52,19 @@ func getTemplatesKibana() map[string]*bytes.Buffer {
 	indexTemplates[indexer.DelegatorsIndex] = withKibana.Delegators.ToBuffer()
 	indexTemplates[indexer.OperationsIndex] = withKibana.Operations.ToBuffer()
 	indexTemplates[indexer.DCDTsIndex] = withKibana.DCDTs.ToBuffer()
+	indexTemplates[indexer.TransfersIndex] = withKibana.Transfers.ToBuffer()
+	indexTemplates[indexer.ContractCallsIndex] = withKibana.ContractCalls.ToBuffer()
+	indexTemplates[indexer.ContractCallStatsIndex] = withKibana.ContractCallStats.ToBuffer()
+	indexTemplates[indexer.TxTracesIndex] = withKibana.TxTraces.ToBuffer()
+	indexTemplates[indexer.TxStatusHistoryIndex] = withKibana.TxStatusHistory.ToBuffer()
+	indexTemplates[indexer.UsernamesIndex] = withKibana.Usernames.ToBuffer()
+	indexTemplates[indexer.GuardiansIndex] = withKibana.Guardians.ToBuffer()
+	indexTemplates[indexer.ProvidersIndex] = withKibana.Providers.ToBuffer()
+	indexTemplates[indexer.NodesIndex] = withKibana.Nodes.ToBuffer()
+	indexTemplates[indexer.AddressActivityIndex] = withKibana.AddressActivity.ToBuffer()
+	indexTemplates[indexer.BridgeOperationsIndex] = withKibana.BridgeOperations.ToBuffer()
+	indexTemplates[indexer.BridgeTransfersIndex] = withKibana.BridgeTransfers.ToBuffer()
+	indexTemplates[indexer.PayloadsIndex] = withKibana.Payloads.ToBuffer()
 
 	return indexTemplates
 }
@@ -75,3 +88,9 @@ func getPolicies() map[string]*bytes.Buffer {
 
 	return indexesPolicies
 }
+
+// GetCustomEventsTemplate will return the template of the provided custom events index
+func (tr *templatesAndPolicyReaderWithKibana) GetCustomEventsTemplate(index string) *bytes.Buffer {
+	template := withKibana.CustomEvents(index)
+	return template.ToBuffer()
+}
@@ -14,5 +14,15 @@ func TestTemplatesAndPolicyReaderWithKibana_GetElasticTemplatesAndPolicies(t *te
 	templates, policies, err := reader.GetElasticTemplatesAndPolicies()
 	require.Nil(t, err)
 	require.Len(t, policies, 12)
-	require.Len(t, templates, 21)
+	require.Len(t, templates, 34)
+}
+
+func TestTemplatesAndPolicyReaderWithKibana_GetCustomEventsTemplate(t *testing.T) {
+	t.Parallel()
+
+	reader := NewTemplatesAndPolicyReaderWithKibana()
+
+	template := reader.GetCustomEventsTemplate("swaps")
+	require.Contains(t, template.String(), `"index_patterns":["swaps-*"]`)
+	require.Contains(t, template.String(), `"eventID":{"type":"keyword"}`)
 }
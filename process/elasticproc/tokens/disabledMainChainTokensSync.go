@@ -0,0 +1,18 @@
+package tokens
+
+type disabledMainChainTokensSync struct{}
+
+// NewDisabledMainChainTokensSync will create a new instance of disabledMainChainTokensSync
+func NewDisabledMainChainTokensSync() *disabledMainChainTokensSync {
+	return &disabledMainChainTokensSync{}
+}
+
+// Close returns nil
+func (dmts *disabledMainChainTokensSync) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dmts *disabledMainChainTokensSync) IsInterfaceNil() bool {
+	return dmts == nil
+}
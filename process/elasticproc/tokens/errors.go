@@ -0,0 +1,6 @@
+package tokens
+
+import "errors"
+
+// ErrInvalidSyncInterval signals that the interval between two synchronizations with the main chain is not valid
+var ErrInvalidSyncInterval = errors.New("invalid main chain tokens sync interval")
@@ -0,0 +1,14 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+)
+
+// DatabaseClientHandler defines the actions that the main chain tokens sync needs from the database clients
+type DatabaseClientHandler interface {
+	DoBulkRequest(ctx context.Context, buff *bytes.Buffer, index string) error
+	DoMultiGet(ctx context.Context, ids []string, index string, withSource bool, res interface{}) error
+	DoScrollRequest(ctx context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
+	IsInterfaceNil() bool
+}
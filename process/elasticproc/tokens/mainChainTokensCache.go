@@ -0,0 +1,109 @@
+package tokens
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type cachedToken struct {
+	id        string
+	token     *data.TokenInfo
+	expiresAt time.Time
+}
+
+// mainChainTokensCache is a bounded LRU cache of the tokens resolved from the main chain. The tokens that were not
+// found on the main chain are cached as well, but only for a limited duration, because they can be issued later
+type mainChainTokensCache struct {
+	mut         sync.Mutex
+	maxSize     int
+	notFoundTTL time.Duration
+	items       map[string]*list.Element
+	order       *list.List
+	timeNow     func() time.Time
+}
+
+func newMainChainTokensCache(maxSize int, notFoundTTL time.Duration) *mainChainTokensCache {
+	return &mainChainTokensCache{
+		maxSize:     maxSize,
+		notFoundTTL: notFoundTTL,
+		items:       make(map[string]*list.Element),
+		order:       list.New(),
+		timeNow:     time.Now,
+	}
+}
+
+// get returns the cached token and true if the token was resolved. A nil token means that it does not exist on the main chain
+func (mtc *mainChainTokensCache) get(id string) (*data.TokenInfo, bool) {
+	mtc.mut.Lock()
+	defer mtc.mut.Unlock()
+
+	element, found := mtc.items[id]
+	if !found {
+		return nil, false
+	}
+
+	cached := element.Value.(*cachedToken)
+	isExpired := !cached.expiresAt.IsZero() && mtc.timeNow().After(cached.expiresAt)
+	if isExpired {
+		mtc.removeElement(element)
+		return nil, false
+	}
+
+	mtc.order.MoveToFront(element)
+
+	return cached.token, true
+}
+
+func (mtc *mainChainTokensCache) addToken(id string, token data.TokenInfo) {
+	mtc.add(&cachedToken{
+		id:    id,
+		token: &token,
+	})
+}
+
+func (mtc *mainChainTokensCache) addNotFound(id string) {
+	if mtc.notFoundTTL <= 0 {
+		return
+	}
+
+	mtc.add(&cachedToken{
+		id:        id,
+		expiresAt: mtc.timeNow().Add(mtc.notFoundTTL),
+	})
+}
+
+func (mtc *mainChainTokensCache) add(cached *cachedToken) {
+	if mtc.maxSize <= 0 {
+		return
+	}
+
+	mtc.mut.Lock()
+	defer mtc.mut.Unlock()
+
+	element, found := mtc.items[cached.id]
+	if found {
+		element.Value = cached
+		mtc.order.MoveToFront(element)
+		return
+	}
+
+	mtc.items[cached.id] = mtc.order.PushFront(cached)
+	if mtc.order.Len() > mtc.maxSize {
+		mtc.removeElement(mtc.order.Back())
+	}
+}
+
+func (mtc *mainChainTokensCache) removeElement(element *list.Element) {
+	mtc.order.Remove(element)
+	delete(mtc.items, element.Value.(*cachedToken).id)
+}
+
+func (mtc *mainChainTokensCache) len() int {
+	mtc.mut.Lock()
+	defer mtc.mut.Unlock()
+
+	return mtc.order.Len()
+}
@@ -0,0 +1,63 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestMainChainTokensCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	cache := newMainChainTokensCache(2, time.Minute)
+	cache.addToken("A-123456", data.TokenInfo{Token: "A-123456"})
+	cache.addToken("B-123456", data.TokenInfo{Token: "B-123456"})
+
+	_, found := cache.get("A-123456")
+	require.True(t, found)
+
+	cache.addToken("C-123456", data.TokenInfo{Token: "C-123456"})
+	require.Equal(t, 2, cache.len())
+
+	_, found = cache.get("B-123456")
+	require.False(t, found)
+
+	token, found := cache.get("A-123456")
+	require.True(t, found)
+	require.Equal(t, "A-123456", token.Token)
+}
+
+func TestMainChainTokensCache_NotFoundExpires(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	cache := newMainChainTokensCache(2, time.Minute)
+	cache.timeNow = func() time.Time {
+		return currentTime
+	}
+
+	cache.addNotFound("A-123456")
+	token, found := cache.get("A-123456")
+	require.True(t, found)
+	require.Nil(t, token)
+
+	currentTime = currentTime.Add(2 * time.Minute)
+	_, found = cache.get("A-123456")
+	require.False(t, found)
+	require.Equal(t, 0, cache.len())
+}
+
+func TestMainChainTokensCache_Disabled(t *testing.T) {
+	t.Parallel()
+
+	cache := newMainChainTokensCache(0, time.Minute)
+	cache.addToken("A-123456", data.TokenInfo{Token: "A-123456"})
+	cache.addNotFound("B-123456")
+
+	require.Equal(t, 0, cache.len())
+	_, found := cache.get("A-123456")
+	require.False(t, found)
+}
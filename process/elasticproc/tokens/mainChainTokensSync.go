@@ -0,0 +1,427 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/core/request"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+const (
+	// MainChainTokensSyncCheckpointKey is the id of the document from the values index that holds the timestamp of
+	// the last main chain event that was mirrored
+	MainChainTokensSyncCheckpointKey = "main-chain-tokens-sync"
+
+	// the events of the main chain are not indexed in the order of their timestamps, because the shards are indexed
+	// independently, so the events that are close to the checkpoint are processed again
+	checkpointSafetyWindowInSec = 300
+	syncTopicShard              = core.AllShardId
+)
+
+const (
+	transferOwnershipEvent = "transferOwnership"
+	changeToDynamicEvent   = "changeToDynamic"
+)
+
+// the main chain events that change the properties of a token that was already issued
+var tokenChangesEvents = []string{
+	transferOwnershipEvent,
+	changeToDynamicEvent,
+	"upgradeProperties",
+	core.BuiltInFunctionSetDCDTRole,
+	core.BuiltInFunctionUnSetDCDTRole,
+	core.BuiltInFunctionDCDTNFTCreateRoleTransfer,
+	vmcommon.BuiltInFunctionDCDTSetBurnRoleForAll,
+	vmcommon.BuiltInFunctionDCDTUnSetBurnRoleForAll,
+	core.BuiltInFunctionDCDTPause,
+	core.BuiltInFunctionDCDTUnPause,
+	core.BuiltInFunctionDCDTNFTAddURI,
+	core.BuiltInFunctionDCDTNFTUpdateAttributes,
+	core.DCDTMetaDataRecreate,
+	core.DCDTMetaDataUpdate,
+	core.DCDTSetNewURIs,
+	core.DCDTModifyCreator,
+	core.DCDTModifyRoyalties,
+}
+
+// the fields of a main chain token document that are mirrored. The counters and the statistics are computed by the
+// sovereign chain indexer and are not overwritten
+var mirroredTokenFields = []string{
+	"name",
+	"ticker",
+	"currentOwner",
+	"type",
+	"numDecimals",
+	"properties",
+	"roles",
+	"data",
+	"changedToDynamicTimestamp",
+}
+
+// ArgsMainChainTokensSync holds all the components needed to create a new instance of mainChainTokensSync
+type ArgsMainChainTokensSync struct {
+	DBClient           DatabaseClientHandler
+	MainChainDBClient  DatabaseClientHandler
+	DCDTPrefix         string
+	Interval           time.Duration
+	BulkRequestMaxSize int
+}
+
+// mainChainTokensSync periodically follows the events of the main chain that change the tokens and mirrors the new
+// properties of the bridged tokens in the tokens index of the sovereign chain
+type mainChainTokensSync struct {
+	dbClient           DatabaseClientHandler
+	mainChainDBClient  DatabaseClientHandler
+	dcdtPrefix         string
+	interval           time.Duration
+	bulkRequestMaxSize int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type responseCheckpoint struct {
+	Docs []struct {
+		Found  bool             `json:"found"`
+		Source data.KeyValueObj `json:"_source"`
+	} `json:"docs"`
+}
+
+type responseRawTokens struct {
+	Docs []struct {
+		Found  bool                       `json:"found"`
+		ID     string                     `json:"_id"`
+		Source map[string]json.RawMessage `json:"_source"`
+	} `json:"docs"`
+}
+
+// NewMainChainTokensSync will create a new instance of mainChainTokensSync and will start the periodic synchronization
+func NewMainChainTokensSync(args ArgsMainChainTokensSync) (*mainChainTokensSync, error) {
+	if check.IfNil(args.DBClient) {
+		return nil, dataindexer.ErrNilDatabaseClient
+	}
+	if check.IfNil(args.MainChainDBClient) {
+		return nil, dataindexer.ErrNilDatabaseClient
+	}
+	if args.Interval <= 0 {
+		return nil, ErrInvalidSyncInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mts := &mainChainTokensSync{
+		dbClient:           args.DBClient,
+		mainChainDBClient:  args.MainChainDBClient,
+		dcdtPrefix:         args.DCDTPrefix,
+		interval:           args.Interval,
+		bulkRequestMaxSize: args.BulkRequestMaxSize,
+		cancel:             cancel,
+	}
+
+	mts.wg.Add(1)
+	go mts.run(ctx)
+
+	return mts, nil
+}
+
+func (mts *mainChainTokensSync) run(ctx context.Context) {
+	defer mts.wg.Done()
+
+	ticker := time.NewTicker(mts.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := mts.sync(ctx)
+			if err != nil {
+				log.Warn("mainChainTokensSync.run: cannot mirror the main chain tokens changes", "error", err)
+			}
+		}
+	}
+}
+
+func (mts *mainChainTokensSync) sync(ctx context.Context) error {
+	checkpoint, err := mts.getCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	changedTokens, lastTimestamp, err := mts.getChangedTokens(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+	if len(changedTokens) == 0 {
+		return nil
+	}
+
+	bridgedTokens, err := mts.getBridgedTokens(ctx, changedTokens)
+	if err != nil {
+		return err
+	}
+
+	buffSlice := data.NewBufferSlice(mts.bulkRequestMaxSize)
+	err = mts.serializeMainChainTokens(ctx, bridgedTokens, buffSlice)
+	if err != nil {
+		return err
+	}
+
+	err = serializeCheckpoint(lastTimestamp, buffSlice)
+	if err != nil {
+		return err
+	}
+
+	bulkCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.BulkTopic, syncTopicShard))
+	for _, buff := range buffSlice.Buffers() {
+		err = mts.dbClient.DoBulkRequest(bulkCtx, buff, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("mainChainTokensSync.sync: mirrored the main chain tokens changes",
+		"num changed tokens", len(changedTokens), "num bridged tokens", len(bridgedTokens), "checkpoint", lastTimestamp)
+
+	return nil
+}
+
+func (mts *mainChainTokensSync) getCheckpoint(ctx context.Context) (uint64, error) {
+	getCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, syncTopicShard))
+	response := &responseCheckpoint{}
+	err := mts.dbClient.DoMultiGet(getCtx, []string{MainChainTokensSyncCheckpointKey}, dataindexer.ValuesIndex, true, response)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(response.Docs) == 0 || !response.Docs[0].Found {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(response.Docs[0].Source.Value, 10, 64)
+}
+
+// getChangedTokens returns the identifiers of the tokens changed by the main chain events that happened after the
+// checkpoint and the timestamp of the last event
+func (mts *mainChainTokensSync) getChangedTokens(ctx context.Context, checkpoint uint64) (map[string]struct{}, uint64, error) {
+	fromTimestamp := uint64(0)
+	if checkpoint > checkpointSafetyWindowInSec {
+		fromTimestamp = checkpoint - checkpointSafetyWindowInSec
+	}
+
+	changedTokens := make(map[string]struct{})
+	lastTimestamp := checkpoint
+	handler := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			event := &data.LogEvent{}
+			err = json.Unmarshal(hit.Source, event)
+			if err != nil {
+				return err
+			}
+
+			timestamp := uint64(event.Timestamp)
+			if timestamp > lastTimestamp {
+				lastTimestamp = timestamp
+			}
+
+			identifier := getChangedTokenIdentifier(event.Identifier, event.Topics)
+			if identifier != "" {
+				changedTokens[identifier] = struct{}{}
+			}
+		}
+
+		return nil
+	}
+
+	scrollCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.ScrollTopic, syncTopicShard))
+	err := mts.mainChainDBClient.DoScrollRequest(scrollCtx, dataindexer.EventsIndex, prepareTokenChangesQuery(fromTimestamp), true, handler)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return changedTokens, lastTimestamp, nil
+}
+
+// getBridgedTokens returns the changed tokens that are indexed by the sovereign chain. The tokens issued by the
+// sovereign chain are skipped because the sovereign chain is their source of truth
+func (mts *mainChainTokensSync) getBridgedTokens(ctx context.Context, changedTokens map[string]struct{}) ([]string, error) {
+	identifiers := make([]string, 0, len(changedTokens))
+	for identifier := range changedTokens {
+		tokenPrefix, hasPrefix := dcdt.IsValidPrefixedToken(identifier)
+		if hasPrefix && tokenPrefix == mts.dcdtPrefix {
+			continue
+		}
+		identifiers = append(identifiers, identifier)
+	}
+	if len(identifiers) == 0 {
+		return identifiers, nil
+	}
+	sort.Strings(identifiers)
+
+	getCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, syncTopicShard))
+	responseTokens := &data.ResponseTokens{}
+	err := mts.dbClient.DoMultiGet(getCtx, identifiers, dataindexer.TokensIndex, false, responseTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgedTokens := make([]string, 0)
+	for _, token := range responseTokens.Docs {
+		if token.Found {
+			bridgedTokens = append(bridgedTokens, token.ID)
+		}
+	}
+
+	return bridgedTokens, nil
+}
+
+func (mts *mainChainTokensSync) serializeMainChainTokens(ctx context.Context, identifiers []string, buffSlice *data.BufferSlice) error {
+	if len(identifiers) == 0 {
+		return nil
+	}
+
+	getCtx := context.WithValue(ctx, request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, syncTopicShard))
+	mainChainTokens := &responseRawTokens{}
+	err := mts.mainChainDBClient.DoMultiGet(getCtx, identifiers, dataindexer.TokensIndex, true, mainChainTokens)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range mainChainTokens.Docs {
+		if !token.Found {
+			continue
+		}
+
+		err = serializeMirroredToken(token.ID, token.Source, buffSlice)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serializeMirroredToken(identifier string, source map[string]json.RawMessage, buffSlice *data.BufferSlice) error {
+	mirroredFields := make(map[string]json.RawMessage)
+	for _, field := range mirroredTokenFields {
+		value, found := source[field]
+		if found {
+			mirroredFields[field] = value
+		}
+	}
+
+	serializedFields, err := json.Marshal(mirroredFields)
+	if err != nil {
+		return err
+	}
+
+	meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, dataindexer.TokensIndex, converters.JsonEscape(identifier), "\n"))
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop'
+		} else {
+			params.token.forEach((key, value) -> {
+				ctx._source[key] = value;
+			});
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": {"token": %s}},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedFields))
+
+	return buffSlice.PutData(meta, []byte(serializedDataStr))
+}
+
+func serializeCheckpoint(timestamp uint64, buffSlice *data.BufferSlice) error {
+	meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, dataindexer.ValuesIndex, MainChainTokensSyncCheckpointKey, "\n"))
+	serializedCheckpoint, err := json.Marshal(&data.KeyValueObj{
+		Key:   MainChainTokensSyncCheckpointKey,
+		Value: strconv.FormatUint(timestamp, 10),
+	})
+	if err != nil {
+		return err
+	}
+
+	return buffSlice.PutData(meta, serializedCheckpoint)
+}
+
+func prepareTokenChangesQuery(fromTimestamp uint64) []byte {
+	identifiers := make([]string, 0, len(tokenChangesEvents))
+	for _, identifier := range tokenChangesEvents {
+		identifiers = append(identifiers, `"`+identifier+`"`)
+	}
+
+	query := bytes.NewBufferString(`{"query": {"bool": {"filter": [`)
+	query.WriteString(fmt.Sprintf(`{"terms": {"identifier": [%s]}},`, strings.Join(identifiers, ",")))
+	query.WriteString(fmt.Sprintf(`{"range": {"timestamp": {"gte": %d}}}`, fromTimestamp))
+	query.WriteString(`]}}, "sort": [{"timestamp": {"order": "asc"}}]}`)
+
+	return query.Bytes()
+}
+
+// the first topic of the token changes events is the token and the second one, if present, is the nonce. The events
+// emitted by the system smart contract hold the name of the token in the second topic and always target the collection
+func getChangedTokenIdentifier(eventIdentifier string, topics []string) string {
+	if len(topics) == 0 {
+		return ""
+	}
+
+	token, err := hex.DecodeString(topics[0])
+	if err != nil || len(token) == 0 {
+		return ""
+	}
+
+	isCollectionEvent := eventIdentifier == transferOwnershipEvent || eventIdentifier == changeToDynamicEvent
+	nonce := uint64(0)
+	if len(topics) > 1 && !isCollectionEvent {
+		nonceBytes, errDecode := hex.DecodeString(topics[1])
+		if errDecode == nil {
+			nonce = big.NewInt(0).SetBytes(nonceBytes).Uint64()
+		}
+	}
+
+	if nonce == 0 {
+		return string(token)
+	}
+
+	return converters.ComputeTokenIdentifier(string(token), nonce)
+}
+
+// Close will stop the periodic synchronization
+func (mts *mainChainTokensSync) Close() error {
+	mts.cancel()
+	mts.wg.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mts *mainChainTokensSync) IsInterfaceNil() bool {
+	return mts == nil
+}
@@ -0,0 +1,189 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+func createMockArgsMainChainTokensSync() ArgsMainChainTokensSync {
+	return ArgsMainChainTokensSync{
+		DBClient:           &mock.DatabaseWriterStub{},
+		MainChainDBClient:  &mock.DatabaseWriterStub{},
+		DCDTPrefix:         prefix,
+		Interval:           time.Hour,
+		BulkRequestMaxSize: data.DefaultMaxBulkSize,
+	}
+}
+
+func hexTopic(topic string) string {
+	return hex.EncodeToString([]byte(topic))
+}
+
+func TestNewMainChainTokensSync(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMainChainTokensSync()
+	args.DBClient = nil
+	mts, err := NewMainChainTokensSync(args)
+	require.Nil(t, mts)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	args = createMockArgsMainChainTokensSync()
+	args.MainChainDBClient = nil
+	mts, err = NewMainChainTokensSync(args)
+	require.Nil(t, mts)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	args = createMockArgsMainChainTokensSync()
+	args.Interval = 0
+	mts, err = NewMainChainTokensSync(args)
+	require.Nil(t, mts)
+	require.Equal(t, ErrInvalidSyncInterval, err)
+
+	mts, err = NewMainChainTokensSync(createMockArgsMainChainTokensSync())
+	require.Nil(t, err)
+	require.False(t, mts.IsInterfaceNil())
+	require.Nil(t, mts.Close())
+}
+
+func TestMainChainTokensSync_Sync(t *testing.T) {
+	t.Parallel()
+
+	eventsResponse := `{"hits": {"hits": [
+		{"_id": "1", "_source": {"identifier": "transferOwnership", "topics": ["` + hexTopic("TKN-abcdef") + `", "` + hexTopic("Token") + `"], "timestamp": 5000}},
+		{"_id": "2", "_source": {"identifier": "DCDTNFTUpdateAttributes", "topics": ["` + hexTopic("NFT-abcdef") + `", "0a"], "timestamp": 5010}},
+		{"_id": "3", "_source": {"identifier": "DCDTSetRole", "topics": ["` + hexTopic("OTHER-abcdef") + `", ""], "timestamp": 5005}},
+		{"_id": "4", "_source": {"identifier": "DCDTPause", "topics": ["` + hexTopic("sov-SOV-abcdef") + `"], "timestamp": 5007}}
+	]}}`
+	mainChainTokensResponse := `{"docs": [
+		{"found": true, "_id": "NFT-abcdef-0a", "_source": {"identifier": "NFT-abcdef-0a", "name": "NFT", "data": {"attributes": "YXR0cg=="}, "holdersCount": 10}},
+		{"found": true, "_id": "TKN-abcdef", "_source": {"token": "TKN-abcdef", "currentOwner": "drt1new", "holdersCount": 100, "ownersHistory": []}}
+	]}`
+
+	bulkBody := ""
+	args := createMockArgsMainChainTokensSync()
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			if index == dataindexer.ValuesIndex {
+				require.Equal(t, []string{MainChainTokensSyncCheckpointKey}, ids)
+				return json.Unmarshal([]byte(`{"docs": [{"found": true, "_source": {"key": "main-chain-tokens-sync", "value": "4900"}}]}`), response)
+			}
+
+			require.Equal(t, dataindexer.TokensIndex, index)
+			require.Equal(t, []string{"NFT-abcdef-0a", "OTHER-abcdef", "TKN-abcdef"}, ids)
+			response.(*data.ResponseTokens).Docs = []data.ResponseTokenDB{
+				{Found: true, ID: "NFT-abcdef-0a"},
+				{Found: false, ID: "OTHER-abcdef"},
+				{Found: true, ID: "TKN-abcdef"},
+			}
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkBody += buff.String()
+			return nil
+		},
+	}
+	args.MainChainDBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.EventsIndex, index)
+			require.Contains(t, string(body), `{"range": {"timestamp": {"gte": 4600}}}`)
+			require.Contains(t, string(body), `"transferOwnership"`)
+			return handlerFunc([]byte(eventsResponse))
+		},
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Equal(t, dataindexer.TokensIndex, index)
+			require.Equal(t, []string{"NFT-abcdef-0a", "TKN-abcdef"}, ids)
+			return json.Unmarshal([]byte(mainChainTokensResponse), response)
+		},
+	}
+	mts, _ := NewMainChainTokensSync(args)
+	defer func() {
+		_ = mts.Close()
+	}()
+
+	err := mts.sync(context.Background())
+	require.Nil(t, err)
+
+	require.Equal(t, 2, strings.Count(bulkBody, `{ "update" : `))
+	require.Contains(t, bulkBody, `{ "update" : {"_index":"tokens", "_id" : "TKN-abcdef" } }`)
+	require.Contains(t, bulkBody, `"params": {"token": {"currentOwner":"drt1new"}}}`)
+	require.Contains(t, bulkBody, `{ "update" : {"_index":"tokens", "_id" : "NFT-abcdef-0a" } }`)
+	require.Contains(t, bulkBody, `"params": {"token": {"data":{"attributes":"YXR0cg=="},"name":"NFT"}}}`)
+	require.NotContains(t, bulkBody, "holdersCount")
+	require.Contains(t, bulkBody, `{ "index" : { "_index":"values", "_id" : "main-chain-tokens-sync" } }`)
+	require.Contains(t, bulkBody, `{"key":"main-chain-tokens-sync","value":"5010"}`)
+}
+
+func TestMainChainTokensSync_SyncNoChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMainChainTokensSync()
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	args.MainChainDBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Contains(t, string(body), `{"range": {"timestamp": {"gte": 0}}}`)
+			return handlerFunc([]byte(`{"hits": {"hits": []}}`))
+		},
+	}
+	mts, _ := NewMainChainTokensSync(args)
+	defer func() {
+		_ = mts.Close()
+	}()
+
+	err := mts.sync(context.Background())
+	require.Nil(t, err)
+}
+
+func TestMainChainTokensSync_SyncMainChainUnavailable(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("main chain unavailable")
+	args := createMockArgsMainChainTokensSync()
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "the checkpoint should not move")
+			return nil
+		},
+	}
+	args.MainChainDBClient = &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return expectedErr
+		},
+	}
+	mts, _ := NewMainChainTokensSync(args)
+	defer func() {
+		_ = mts.Close()
+	}()
+
+	err := mts.sync(context.Background())
+	require.Equal(t, expectedErr, err)
+}
+
+func TestGetChangedTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "", getChangedTokenIdentifier(core.BuiltInFunctionDCDTPause, nil))
+	require.Equal(t, "", getChangedTokenIdentifier(core.BuiltInFunctionDCDTPause, []string{"invalid"}))
+	require.Equal(t, "TKN-abcdef", getChangedTokenIdentifier(core.BuiltInFunctionDCDTPause, []string{hexTopic("TKN-abcdef")}))
+	require.Equal(t, "TKN-abcdef", getChangedTokenIdentifier(core.BuiltInFunctionSetDCDTRole, []string{hexTopic("TKN-abcdef"), ""}))
+	require.Equal(t, "NFT-abcdef-01", getChangedTokenIdentifier(core.DCDTMetaDataUpdate, []string{hexTopic("NFT-abcdef"), "01"}))
+	require.Equal(t, "TKN-abcdef", getChangedTokenIdentifier(transferOwnershipEvent, []string{hexTopic("TKN-abcdef"), hexTopic("Token")}))
+}
@@ -3,11 +3,17 @@ package tokens
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
 
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
 	indexerdata "github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
@@ -15,25 +21,79 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
 )
 
+const (
+	pendingMainChainTokensMetric = "pendingMainChainTokens"
+	pendingTokensQuery           = `{"query": {"term": {"pendingResolution": true}}}`
+)
+
+var (
+	log = logger.GetOrCreate("indexer/process/tokens")
+
+	errMainChainUnavailable = errors.New("main chain elastic cluster is unavailable")
+)
+
+// ArgsSovereignIndexTokensHandler holds all the components needed to create a new sovereign index tokens handler
+type ArgsSovereignIndexTokensHandler struct {
+	MainChainElasticClient elasticproc.MainChainDatabaseClientHandler
+	DCDTPrefix             string
+	CacheSize              int
+	NotFoundCacheTTL       time.Duration
+	RetryInterval          time.Duration
+	StatusMetrics          elasticproc.GaugeMetricsHandler
+}
+
 type sovereignIndexTokensHandler struct {
 	mainChainElasticClient elasticproc.MainChainDatabaseClientHandler
 	dcdtPrefix             string
+	statusMetrics          elasticproc.GaugeMetricsHandler
+	cache                  *mainChainTokensCache
+	retryInterval          time.Duration
+
+	mut                 sync.Mutex
+	pendingTokens       map[string]struct{}
+	pendingTokensLoaded bool
+	nextMainChainRetry  time.Time
+	timeNow             func() time.Time
 }
 
 // NewSovereignIndexTokensHandler creates a new sovereign index tokens handler
-func NewSovereignIndexTokensHandler(mainChainElasticClient elasticproc.MainChainDatabaseClientHandler, dcdtPrefix string) (*sovereignIndexTokensHandler, error) {
+func NewSovereignIndexTokensHandler(args ArgsSovereignIndexTokensHandler) (*sovereignIndexTokensHandler, error) {
+	if check.IfNil(args.MainChainElasticClient) {
+		return nil, indexerdata.ErrNilDatabaseClient
+	}
+
 	return &sovereignIndexTokensHandler{
-		mainChainElasticClient: mainChainElasticClient,
-		dcdtPrefix:             dcdtPrefix,
+		mainChainElasticClient: args.MainChainElasticClient,
+		dcdtPrefix:             args.DCDTPrefix,
+		statusMetrics:          args.StatusMetrics,
+		cache:                  newMainChainTokensCache(args.CacheSize, args.NotFoundCacheTTL),
+		retryInterval:          args.RetryInterval,
+		pendingTokens:          make(map[string]struct{}),
+		timeNow:                time.Now,
 	}, nil
 }
 
-// IndexCrossChainTokens will index the new tokens properties
+// IndexCrossChainTokens will index the new tokens properties. The tokens are resolved from the main chain elastic
+// cluster and, if it is unavailable, placeholder documents are indexed instead and replaced once the cluster returns.
+// The placeholders indexed before a restart are loaded from the database on the first call
 func (sit *sovereignIndexTokensHandler) IndexCrossChainTokens(elasticClient elasticproc.DatabaseClientHandler, scrs []*data.ScResult, buffSlice *data.BufferSlice) error {
 	if !sit.mainChainElasticClient.IsEnabled() {
 		return nil
 	}
 
+	sit.mut.Lock()
+	defer sit.mut.Unlock()
+
+	err := sit.loadPendingTokens(elasticClient)
+	if err != nil {
+		return err
+	}
+
+	err = sit.backfillPendingTokens(buffSlice)
+	if err != nil {
+		return err
+	}
+
 	newTokens, err := sit.getNewTokensFromSCRs(elasticClient, scrs)
 	if err != nil {
 		return err
@@ -43,14 +103,35 @@ func (sit *sovereignIndexTokensHandler) IndexCrossChainTokens(elasticClient elas
 		return nil
 	}
 
-	// get tokens from main chain elastic db
-	mainChainTokens := &data.ResponseTokenInfo{}
-	err = sit.mainChainElasticClient.DoMultiGet(context.Background(), newTokens, indexerdata.TokensIndex, true, mainChainTokens)
+	tokensToResolve := make([]string, 0, len(newTokens))
+	for _, identifier := range newTokens {
+		token, found := sit.cache.get(identifier)
+		if !found {
+			tokensToResolve = append(tokensToResolve, identifier)
+			continue
+		}
+		if token == nil { // recently checked and not issued on the main chain
+			continue
+		}
+
+		err = serializeToken(identifier, *token, buffSlice)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(tokensToResolve) == 0 {
+		return nil
+	}
+
+	mainChainTokens, err := sit.getTokensFromMainChain(tokensToResolve)
 	if err != nil {
-		return err
+		log.Warn("sovereignIndexTokensHandler.IndexCrossChainTokens: cannot get the tokens from the main chain, indexing placeholders",
+			"num tokens", len(tokensToResolve), "error", err)
+		return sit.deferTokens(tokensToResolve, buffSlice)
 	}
 
-	return sit.serializeNewTokens(mainChainTokens.Docs, buffSlice)
+	return serializeTokens(mainChainTokens, buffSlice)
 }
 
 func (sit *sovereignIndexTokensHandler) getNewTokensFromSCRs(elasticClient elasticproc.DatabaseClientHandler, scrs []*data.ScResult) ([]string, error) {
@@ -107,25 +188,165 @@ func getTokenCollection(hasPrefix bool, tokenIdentifier string) string {
 	return ""
 }
 
-func (sit *sovereignIndexTokensHandler) serializeNewTokens(responseTokensInfo []data.ResponseTokenInfoDB, buffSlice *data.BufferSlice) error {
-	for _, responseToken := range responseTokensInfo {
+// getTokensFromMainChain returns the found tokens mapped by their identifier and caches the result of the lookup.
+// After a failed request, the main chain is not queried again until the retry interval passes, so a main chain
+// maintenance window does not slow down every block
+func (sit *sovereignIndexTokensHandler) getTokensFromMainChain(identifiers []string) (map[string]data.TokenInfo, error) {
+	if sit.timeNow().Before(sit.nextMainChainRetry) {
+		return nil, errMainChainUnavailable
+	}
+
+	mainChainTokens := &data.ResponseTokenInfo{}
+	err := sit.mainChainElasticClient.DoMultiGet(context.Background(), identifiers, indexerdata.TokensIndex, true, mainChainTokens)
+	if err != nil {
+		sit.nextMainChainRetry = sit.timeNow().Add(sit.retryInterval)
+		return nil, err
+	}
+
+	tokens := make(map[string]data.TokenInfo)
+	for _, responseToken := range mainChainTokens.Docs {
+		if !responseToken.Found {
+			sit.cache.addNotFound(responseToken.ID)
+			continue
+		}
+
 		token, identifier := formatToken(responseToken)
+		sit.cache.addToken(identifier, token)
+		tokens[identifier] = token
+	}
 
-		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, indexerdata.TokensIndex, converters.JsonEscape(identifier), "\n"))
-		serializedTokenData, err := json.Marshal(token)
+	return tokens, nil
+}
+
+func (sit *sovereignIndexTokensHandler) deferTokens(identifiers []string, buffSlice *data.BufferSlice) error {
+	for _, identifier := range identifiers {
+		sit.pendingTokens[identifier] = struct{}{}
+
+		err := serializeToken(identifier, createPlaceholderToken(identifier), buffSlice)
 		if err != nil {
 			return err
 		}
+	}
 
-		err = buffSlice.PutData(meta, serializedTokenData)
+	sit.updatePendingTokensMetric()
+
+	return nil
+}
+
+func (sit *sovereignIndexTokensHandler) loadPendingTokens(elasticClient elasticproc.DatabaseClientHandler) error {
+	if sit.pendingTokensLoaded {
+		return nil
+	}
+
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
 		if err != nil {
 			return err
 		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			sit.pendingTokens[hit.ID] = struct{}{}
+		}
+
+		return nil
 	}
 
+	err := elasticClient.DoScrollRequest(context.Background(), indexerdata.TokensIndex, []byte(pendingTokensQuery), false, handlerFunc)
+	if err != nil {
+		return err
+	}
+
+	sit.pendingTokensLoaded = true
+	sit.updatePendingTokensMetric()
+
 	return nil
 }
 
+func (sit *sovereignIndexTokensHandler) backfillPendingTokens(buffSlice *data.BufferSlice) error {
+	if len(sit.pendingTokens) == 0 {
+		return nil
+	}
+
+	identifiers := make([]string, 0, len(sit.pendingTokens))
+	for identifier := range sit.pendingTokens {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	mainChainTokens, err := sit.getTokensFromMainChain(identifiers)
+	if err != nil {
+		log.Debug("sovereignIndexTokensHandler.backfillPendingTokens: main chain still unavailable",
+			"num pending tokens", len(identifiers), "error", err)
+		return nil
+	}
+
+	for _, identifier := range identifiers {
+		_, found := mainChainTokens[identifier]
+		if !found {
+			log.Warn("sovereignIndexTokensHandler.backfillPendingTokens: token not found on the main chain, keeping the placeholder",
+				"identifier", identifier)
+		}
+		delete(sit.pendingTokens, identifier)
+	}
+
+	sit.updatePendingTokensMetric()
+
+	return serializeTokens(mainChainTokens, buffSlice)
+}
+
+func (sit *sovereignIndexTokensHandler) updatePendingTokensMetric() {
+	if check.IfNil(sit.statusMetrics) {
+		return
+	}
+
+	sit.statusMetrics.SetGauge(pendingMainChainTokensMetric, uint64(len(sit.pendingTokens)))
+}
+
+func createPlaceholderToken(identifier string) data.TokenInfo {
+	_, hasPrefix := dcdt.IsValidPrefixedToken(identifier)
+	collection := getTokenCollection(hasPrefix, identifier)
+	if collection == "" {
+		return data.TokenInfo{
+			Token:             identifier,
+			PendingResolution: true,
+		}
+	}
+
+	return data.TokenInfo{
+		Identifier:        identifier,
+		Token:             collection,
+		PendingResolution: true,
+	}
+}
+
+func serializeTokens(tokens map[string]data.TokenInfo, buffSlice *data.BufferSlice) error {
+	identifiers := make([]string, 0, len(tokens))
+	for identifier := range tokens {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	for _, identifier := range identifiers {
+		err := serializeToken(identifier, tokens[identifier], buffSlice)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serializeToken(identifier string, token data.TokenInfo, buffSlice *data.BufferSlice) error {
+	meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, indexerdata.TokensIndex, converters.JsonEscape(identifier), "\n"))
+	serializedTokenData, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	return buffSlice.PutData(meta, serializedTokenData)
+}
+
 func formatToken(token data.ResponseTokenInfoDB) (data.TokenInfo, string) {
 	token.Source.OwnersHistory = nil
 	token.Source.Properties = nil
@@ -1,30 +1,102 @@
 package tokens
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/stretchr/testify/require"
 
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/client/disabled"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 )
 
 const (
 	prefix = "sov"
 )
 
+func createMockArgsSovereignIndexTokensHandler(mainChainClient *mock.DatabaseWriterStub) ArgsSovereignIndexTokensHandler {
+	return ArgsSovereignIndexTokensHandler{
+		MainChainElasticClient: mainChainClient,
+		DCDTPrefix:             prefix,
+		CacheSize:              10,
+		NotFoundCacheTTL:       time.Minute,
+		RetryInterval:          time.Minute,
+		StatusMetrics:          &mock.StatusMetricsStub{},
+	}
+}
+
+func createLocalClientWithoutTokens() *mock.DatabaseWriterStub {
+	return &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			docs := make([]data.ResponseTokenDB, 0, len(ids))
+			for _, id := range ids {
+				docs = append(docs, data.ResponseTokenDB{ID: id})
+			}
+			response.(*data.ResponseTokens).Docs = docs
+			return nil
+		},
+	}
+}
+
+func createMainChainClient(numCalls *int, errToReturn *error) *mock.DatabaseWriterStub {
+	return &mock.DatabaseWriterStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			*numCalls++
+			if *errToReturn != nil {
+				return *errToReturn
+			}
+
+			docs := make([]data.ResponseTokenInfoDB, 0, len(ids))
+			for _, id := range ids {
+				if id == "MISSING-abcdef" {
+					docs = append(docs, data.ResponseTokenInfoDB{ID: id})
+					continue
+				}
+				docs = append(docs, data.ResponseTokenInfoDB{
+					Found: true,
+					ID:    id,
+					Source: data.TokenInfo{
+						Name:          "Token",
+						Token:         id,
+						NumDecimals:   18,
+						OwnersHistory: []*data.OwnerData{{Address: "owner"}},
+					},
+				})
+			}
+			response.(*data.ResponseTokenInfo).Docs = docs
+			return nil
+		},
+	}
+}
+
 func TestSovereignNewIndexTokensHandler(t *testing.T) {
 	t.Parallel()
 
+	t.Run("nil main chain client, should error", func(t *testing.T) {
+		args := createMockArgsSovereignIndexTokensHandler(nil)
+		args.MainChainElasticClient = nil
+		sith, err := NewSovereignIndexTokensHandler(args)
+		require.Nil(t, sith)
+		require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+	})
 	t.Run("valid disabled config, should work", func(t *testing.T) {
-		sith, err := NewSovereignIndexTokensHandler(disabled.NewDisabledElasticClient(), prefix)
+		args := createMockArgsSovereignIndexTokensHandler(nil)
+		args.MainChainElasticClient = disabled.NewDisabledElasticClient()
+		sith, err := NewSovereignIndexTokensHandler(args)
 		require.NoError(t, err)
 		require.Equal(t, "*disabled.elasticClient", fmt.Sprintf("%T", sith.mainChainElasticClient))
 	})
 	t.Run("valid config, should work", func(t *testing.T) {
-		sith, err := NewSovereignIndexTokensHandler(&mock.DatabaseWriterStub{}, prefix)
+		sith, err := NewSovereignIndexTokensHandler(createMockArgsSovereignIndexTokensHandler(&mock.DatabaseWriterStub{}))
 		require.NoError(t, err)
 		require.Equal(t, "*mock.DatabaseWriterStub", fmt.Sprintf("%T", sith.mainChainElasticClient))
 	})
@@ -33,7 +105,9 @@ func TestSovereignNewIndexTokensHandler(t *testing.T) {
 func TestSovereignIndexTokensHandler_IndexCrossChainTokens(t *testing.T) {
 	t.Parallel()
 
-	sith, err := NewSovereignIndexTokensHandler(disabled.NewDisabledElasticClient(), prefix)
+	args := createMockArgsSovereignIndexTokensHandler(nil)
+	args.MainChainElasticClient = disabled.NewDisabledElasticClient()
+	sith, err := NewSovereignIndexTokensHandler(args)
 	require.NoError(t, err)
 	require.NotNil(t, sith)
 
@@ -43,3 +117,123 @@ func TestSovereignIndexTokensHandler_IndexCrossChainTokens(t *testing.T) {
 
 	// actual indexing is tested in TestCrossChainTokensIndexingFromMainChain
 }
+
+func TestSovereignIndexTokensHandler_IndexCrossChainTokensUsesCache(t *testing.T) {
+	t.Parallel()
+
+	numMainChainCalls := 0
+	var mainChainErr error
+	sith, _ := NewSovereignIndexTokensHandler(createMockArgsSovereignIndexTokensHandler(createMainChainClient(&numMainChainCalls, &mainChainErr)))
+
+	scrs := []*data.ScResult{{SenderShard: core.MainChainShardId, Tokens: []string{"TKN-123456", "MISSING-abcdef"}}}
+	for i := 0; i < 2; i++ {
+		buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+		err := sith.IndexCrossChainTokens(createLocalClientWithoutTokens(), scrs, buffSlice)
+		require.NoError(t, err)
+		require.Equal(t, `{ "index" : { "_index":"tokens", "_id" : "TKN-123456" } }
+{"name":"Token","token":"TKN-123456","numDecimals":18}
+`, buffSlice.Buffers()[0].String())
+	}
+
+	require.Equal(t, 1, numMainChainCalls)
+	require.Equal(t, 2, sith.cache.len())
+}
+
+func TestSovereignIndexTokensHandler_IndexCrossChainTokensMainChainUnavailable(t *testing.T) {
+	t.Parallel()
+
+	numMainChainCalls := 0
+	mainChainErr := errors.New("connection refused")
+	pendingGauge := uint64(0)
+	args := createMockArgsSovereignIndexTokensHandler(createMainChainClient(&numMainChainCalls, &mainChainErr))
+	args.StatusMetrics = &mock.StatusMetricsStub{
+		SetGaugeCalled: func(name string, value uint64) {
+			require.Equal(t, pendingMainChainTokensMetric, name)
+			pendingGauge = value
+		},
+	}
+	sith, _ := NewSovereignIndexTokensHandler(args)
+	currentTime := time.Unix(1000, 0)
+	sith.timeNow = func() time.Time {
+		return currentTime
+	}
+
+	scrs := []*data.ScResult{{SenderShard: core.MainChainShardId, Tokens: []string{"NFT-abcdef-01"}}}
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := sith.IndexCrossChainTokens(createLocalClientWithoutTokens(), scrs, buffSlice)
+	require.NoError(t, err)
+	require.Equal(t, `{ "index" : { "_index":"tokens", "_id" : "NFT-abcdef-01" } }
+{"identifier":"NFT-abcdef-01","token":"NFT-abcdef","numDecimals":0,"pendingResolution":true}
+{ "index" : { "_index":"tokens", "_id" : "NFT-abcdef" } }
+{"token":"NFT-abcdef","numDecimals":0,"pendingResolution":true}
+`, buffSlice.Buffers()[0].String())
+	require.Equal(t, 1, numMainChainCalls)
+	require.Equal(t, uint64(2), pendingGauge)
+
+	// the main chain is not requested again before the retry interval passes
+	mainChainErr = nil
+	buffSlice = data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err = sith.IndexCrossChainTokens(createLocalClientWithoutTokens(), nil, buffSlice)
+	require.NoError(t, err)
+	require.Empty(t, buffSlice.Buffers())
+	require.Equal(t, 1, numMainChainCalls)
+
+	// the placeholders are replaced once the main chain is reachable
+	currentTime = currentTime.Add(2 * time.Minute)
+	buffSlice = data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err = sith.IndexCrossChainTokens(createLocalClientWithoutTokens(), nil, buffSlice)
+	require.NoError(t, err)
+	require.Equal(t, `{ "index" : { "_index":"tokens", "_id" : "NFT-abcdef" } }
+{"name":"Token","token":"NFT-abcdef","numDecimals":18}
+{ "index" : { "_index":"tokens", "_id" : "NFT-abcdef-01" } }
+{"name":"Token","token":"NFT-abcdef-01","numDecimals":18}
+`, buffSlice.Buffers()[0].String())
+	require.Equal(t, 2, numMainChainCalls)
+	require.Equal(t, uint64(0), pendingGauge)
+	require.Empty(t, sith.pendingTokens)
+}
+
+func TestSovereignIndexTokensHandler_IndexCrossChainTokensLoadsPendingTokens(t *testing.T) {
+	t.Parallel()
+
+	numMainChainCalls := 0
+	var mainChainErr error
+	sith, _ := NewSovereignIndexTokensHandler(createMockArgsSovereignIndexTokensHandler(createMainChainClient(&numMainChainCalls, &mainChainErr)))
+
+	numScrollCalls := 0
+	localClient := createLocalClientWithoutTokens()
+	localClient.DoScrollRequestCalled = func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+		numScrollCalls++
+		require.Equal(t, dataindexer.TokensIndex, index)
+		require.Equal(t, pendingTokensQuery, string(body))
+		require.False(t, withSource)
+
+		return handlerFunc([]byte(`{"hits":{"hits":[{"_id":"TKN-123456"}]}}`))
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := sith.IndexCrossChainTokens(localClient, nil, buffSlice)
+	require.NoError(t, err)
+	require.Equal(t, `{ "index" : { "_index":"tokens", "_id" : "TKN-123456" } }
+{"name":"Token","token":"TKN-123456","numDecimals":18}
+`, buffSlice.Buffers()[0].String())
+	require.Empty(t, sith.pendingTokens)
+
+	// the pending tokens are loaded only once
+	err = sith.IndexCrossChainTokens(localClient, nil, data.NewBufferSlice(data.DefaultMaxBulkSize))
+	require.NoError(t, err)
+	require.Equal(t, 1, numScrollCalls)
+	require.Equal(t, 1, numMainChainCalls)
+}
+
+func TestCreatePlaceholderToken(t *testing.T) {
+	t.Parallel()
+
+	token := createPlaceholderToken("TKN-123456")
+	serialized, _ := json.Marshal(token)
+	require.Equal(t, `{"token":"TKN-123456","numDecimals":0,"pendingResolution":true}`, string(serialized))
+
+	token = createPlaceholderToken("abc-NFT-123456-0a")
+	require.Equal(t, "abc-NFT-123456-0a", token.Identifier)
+	require.Equal(t, "NFT-123456", token.Token)
+}
@@ -0,0 +1,27 @@
+package transfers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeTransfers will serialize the provided transfers
+func (tp *transfersProcessor) SerializeTransfers(transfers []*data.Transfer, buffSlice *data.BufferSlice, index string) error {
+	for _, transfer := range transfers {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(transfer.ID), "\n"))
+		serializedData, err := json.Marshal(transfer)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package transfers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestTransfersProcessor_SerializeTransfers(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	transfers := []*data.Transfer{
+		{
+			ID:             "h1-0",
+			TxHash:         "h1",
+			OriginalTxHash: "h1",
+			Sender:         "alice",
+			Receiver:       "bob",
+			Token:          "NFT-abcd",
+			Identifier:     "NFT-abcd-01",
+			Nonce:          1,
+			Amount:         "1",
+			Direction:      DirectionUserToUser,
+			Source:         SourceTransaction,
+			Timestamp:      5040,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := tp.SerializeTransfers(transfers, buffSlice, "transfers")
+	require.Nil(t, err)
+
+	expectedRes := `{ "index" : { "_index":"transfers", "_id" : "h1-0" } }
+{"txHash":"h1","originalTxHash":"h1","sender":"alice","receiver":"bob","senderShard":0,"receiverShard":0,"token":"NFT-abcd","identifier":"NFT-abcd-01","nonce":1,"amount":"1","amountNum":0,"direction":"userToUser","source":"transaction","shardID":0,"timestamp":5040}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
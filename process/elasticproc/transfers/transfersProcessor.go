@@ -0,0 +1,431 @@
+package transfers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/core/sharding"
+	"github.com/TerraDharitri/drt-go-chain-core/data/dcdt"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+const (
+	// RewaToken is the token name used for the native token transfers
+	RewaToken = "REWA"
+
+	// SourceTransaction marks the transfers extracted from transactions
+	SourceTransaction = "transaction"
+	// SourceScResult marks the transfers extracted from smart contract results
+	SourceScResult = "scResult"
+	// SourceEvent marks the transfers extracted from log events
+	SourceEvent = "event"
+
+	// DirectionUserToUser marks a transfer between two user accounts
+	DirectionUserToUser = "userToUser"
+	// DirectionUserToContract marks a transfer from a user account to a smart contract
+	DirectionUserToContract = "userToContract"
+	// DirectionContractToUser marks a transfer from a smart contract to a user account
+	DirectionContractToUser = "contractToUser"
+	// DirectionContractToContract marks a transfer between two smart contracts
+	DirectionContractToContract = "contractToContract"
+
+	transferValueOnlyIdentifier = "transferValueOnly"
+	numTopicsDCDTTransferEvent  = 4
+	numTopicsTransferValueOnly  = 2
+)
+
+var log = logger.GetOrCreate("indexer/process/transfers")
+
+type transfersProcessor struct {
+	pubKeyConverter  core.PubkeyConverter
+	balanceConverter dataindexer.BalanceConverter
+}
+
+// NewTransfersProcessor will create a new instance of transfersProcessor
+func NewTransfersProcessor(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (*transfersProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+	if check.IfNil(balanceConverter) {
+		return nil, dataindexer.ErrNilBalanceConverter
+	}
+
+	return &transfersProcessor{
+		pubKeyConverter:  pubKeyConverter,
+		balanceConverter: balanceConverter,
+	}, nil
+}
+
+// PrepareTransfers will split the transactions, the smart contract results and the log events in individual value movements.
+// Transactions and smart contract results are handled only in the sender shard, so every transfer is indexed once. The
+// log events are used for the transfers done by smart contracts that are not visible in a transaction or a smart contract
+// result of the same block. A transfer event is logged by both shards of a cross-shard transfer, so it is handled only in
+// the receiver shard, which executes the transfer, and it is skipped if the transaction or the smart contract result that
+// logged it failed there. The events of the transfers carried by the cross-shard transactions and smart contract results
+// received from other shards are skipped as well, since those transfers are indexed by the sender shard.
+func (tp *transfersProcessor) PrepareTransfers(
+	txs []*data.Transaction,
+	scrs []*data.ScResult,
+	events []*data.LogEvent,
+	selfShardID uint32,
+	numOfShards uint32,
+) []*data.Transfer {
+	transfers := make([]*data.Transfer, 0)
+	seen := make(map[string]struct{})
+
+	for _, tx := range txs {
+		if isFailed(tx.Status) {
+			continue
+		}
+
+		txTransfers := tp.prepareTransfersFromTxOrScr(newTxTransferSource(tx), selfShardID)
+		if tx.SenderShard != selfShardID {
+			markSeen(txTransfers, seen)
+			continue
+		}
+		transfers = appendNotSeen(transfers, txTransfers, seen)
+	}
+
+	for _, scr := range scrs {
+		if isFailed(scr.Status) {
+			continue
+		}
+
+		scrTransfers := tp.prepareTransfersFromTxOrScr(newScrTransferSource(scr), selfShardID)
+		if scr.SenderShard != selfShardID {
+			markSeen(scrTransfers, seen)
+			continue
+		}
+		transfers = appendNotSeen(transfers, scrTransfers, seen)
+	}
+
+	statuses := make(map[string]string, len(txs)+len(scrs))
+	for _, tx := range txs {
+		statuses[tx.Hash] = tx.Status
+	}
+	for _, scr := range scrs {
+		statuses[scr.Hash] = scr.Status
+	}
+
+	for _, event := range events {
+		if isFailed(statuses[event.TxHash]) {
+			continue
+		}
+
+		transfer := tp.prepareTransferFromEvent(event, selfShardID, numOfShards)
+		if transfer == nil || transfer.ReceiverShard != selfShardID {
+			continue
+		}
+		transfers = appendNotSeen(transfers, []*data.Transfer{transfer}, seen)
+	}
+
+	return transfers
+}
+
+type transferSource struct {
+	source         string
+	hash           string
+	originalTxHash string
+	sender         string
+	receiver       string
+	senderShard    uint32
+	receiverShard  uint32
+	value          string
+	tokens         []string
+	dcdtValues     []string
+	receivers      []string
+	receiversShard []uint32
+	timestamp      time.Duration
+}
+
+func newTxTransferSource(tx *data.Transaction) transferSource {
+	return transferSource{
+		source:         SourceTransaction,
+		hash:           tx.Hash,
+		originalTxHash: tx.Hash,
+		sender:         tx.Sender,
+		receiver:       tx.Receiver,
+		senderShard:    tx.SenderShard,
+		receiverShard:  tx.ReceiverShard,
+		value:          tx.Value,
+		tokens:         tx.Tokens,
+		dcdtValues:     tx.DCDTValues,
+		receivers:      tx.Receivers,
+		receiversShard: tx.ReceiversShardIDs,
+		timestamp:      tx.Timestamp,
+	}
+}
+
+func newScrTransferSource(scr *data.ScResult) transferSource {
+	originalTxHash := scr.OriginalTxHash
+	if originalTxHash == "" {
+		originalTxHash = scr.Hash
+	}
+
+	return transferSource{
+		source:         SourceScResult,
+		hash:           scr.Hash,
+		originalTxHash: originalTxHash,
+		sender:         scr.Sender,
+		receiver:       scr.Receiver,
+		senderShard:    scr.SenderShard,
+		receiverShard:  scr.ReceiverShard,
+		value:          scr.Value,
+		tokens:         scr.Tokens,
+		dcdtValues:     scr.DCDTValues,
+		receivers:      scr.Receivers,
+		receiversShard: scr.ReceiversShardIDs,
+		timestamp:      scr.Timestamp,
+	}
+}
+
+func (tp *transfersProcessor) prepareTransfersFromTxOrScr(src transferSource, selfShardID uint32) []*data.Transfer {
+	transfers := make([]*data.Transfer, 0)
+	if isPositive(src.value) {
+		transfers = append(transfers, tp.newTransfer(src, src.receiver, src.receiverShard, RewaToken, 0, src.value, selfShardID, len(transfers)))
+	}
+
+	for idx, identifier := range src.tokens {
+		if idx >= len(src.dcdtValues) || !isPositive(src.dcdtValues[idx]) {
+			continue
+		}
+
+		receiver, receiverShard := src.receiver, src.receiverShard
+		if idx < len(src.receivers) {
+			receiver = src.receivers[idx]
+		}
+		if idx < len(src.receiversShard) {
+			receiverShard = src.receiversShard[idx]
+		}
+
+		token, nonce := splitTokenIdentifier(identifier)
+		transfers = append(transfers, tp.newTransfer(src, receiver, receiverShard, token, nonce, src.dcdtValues[idx], selfShardID, len(transfers)))
+	}
+
+	return transfers
+}
+
+func (tp *transfersProcessor) newTransfer(
+	src transferSource,
+	receiver string,
+	receiverShard uint32,
+	token string,
+	nonce uint64,
+	amount string,
+	selfShardID uint32,
+	index int,
+) *data.Transfer {
+	identifier := token
+	if nonce > 0 {
+		identifier = converters.ComputeTokenIdentifier(token, nonce)
+	}
+
+	return &data.Transfer{
+		ID:             fmt.Sprintf("%s-%d", src.hash, index),
+		TxHash:         src.hash,
+		OriginalTxHash: src.originalTxHash,
+		Sender:         src.sender,
+		Receiver:       receiver,
+		SenderShard:    src.senderShard,
+		ReceiverShard:  receiverShard,
+		Token:          token,
+		Identifier:     identifier,
+		Nonce:          nonce,
+		Amount:         amount,
+		AmountNum:      tp.computeAmountNum(amount),
+		Direction:      tp.computeDirection(src.sender, receiver),
+		Source:         src.source,
+		ShardID:        selfShardID,
+		Timestamp:      src.timestamp,
+	}
+}
+
+func (tp *transfersProcessor) prepareTransferFromEvent(event *data.LogEvent, selfShardID uint32, numOfShards uint32) *data.Transfer {
+	var token, amount, receiverHex string
+	var nonce uint64
+
+	switch event.Identifier {
+	case transferValueOnlyIdentifier:
+		// topics[0] -- value
+		// topics[1] -- receiver
+		if len(event.Topics) < numTopicsTransferValueOnly {
+			return nil
+		}
+		token = RewaToken
+		amount = decodeHexBigInt(event.Topics[0])
+		receiverHex = event.Topics[1]
+	case core.BuiltInFunctionDCDTTransfer, core.BuiltInFunctionDCDTNFTTransfer, core.BuiltInFunctionMultiDCDTNFTTransfer:
+		// topics[0] -- token
+		// topics[1] -- nonce
+		// topics[2] -- value
+		// topics[3] -- receiver
+		if len(event.Topics) < numTopicsDCDTTransferEvent {
+			return nil
+		}
+		tokenBytes, err := hex.DecodeString(event.Topics[0])
+		if err != nil {
+			return nil
+		}
+		token = string(tokenBytes)
+		nonce = decodeHexBigIntAsUint64(event.Topics[1])
+		amount = decodeHexBigInt(event.Topics[2])
+		receiverHex = event.Topics[3]
+	default:
+		return nil
+	}
+
+	receiverBytes, err := hex.DecodeString(receiverHex)
+	if err != nil || len(receiverBytes) == 0 || !isPositive(amount) {
+		return nil
+	}
+	receiver, err := tp.pubKeyConverter.Encode(receiverBytes)
+	if err != nil {
+		log.Debug("transfersProcessor.prepareTransferFromEvent cannot encode receiver", "error", err)
+		return nil
+	}
+
+	originalTxHash := event.OriginalTxHash
+	if originalTxHash == "" {
+		originalTxHash = event.TxHash
+	}
+
+	src := transferSource{
+		source:         SourceEvent,
+		hash:           event.ID,
+		originalTxHash: originalTxHash,
+		sender:         event.Address,
+		senderShard:    selfShardID,
+		timestamp:      event.Timestamp,
+	}
+	transfer := tp.newTransfer(src, receiver, sharding.ComputeShardID(receiverBytes, numOfShards), token, nonce, amount, selfShardID, 0)
+	transfer.ID = event.ID
+	transfer.TxHash = event.TxHash
+
+	return transfer
+}
+
+func (tp *transfersProcessor) computeAmountNum(amount string) float64 {
+	amountBig, ok := big.NewInt(0).SetString(amount, 10)
+	if !ok {
+		return 0
+	}
+
+	amountNum, err := tp.balanceConverter.ConvertBigValueToFloat(amountBig)
+	if err != nil {
+		log.Debug("transfersProcessor.computeAmountNum", "amount", amount, "error", err)
+		return 0
+	}
+
+	return amountNum
+}
+
+func (tp *transfersProcessor) computeDirection(sender, receiver string) string {
+	isSenderContract := tp.isSmartContract(sender)
+	isReceiverContract := tp.isSmartContract(receiver)
+
+	switch {
+	case isSenderContract && isReceiverContract:
+		return DirectionContractToContract
+	case isSenderContract:
+		return DirectionContractToUser
+	case isReceiverContract:
+		return DirectionUserToContract
+	default:
+		return DirectionUserToUser
+	}
+}
+
+func (tp *transfersProcessor) isSmartContract(address string) bool {
+	addressBytes, err := tp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return false
+	}
+
+	return core.IsSmartContractAddress(addressBytes)
+}
+
+func markSeen(transfers []*data.Transfer, seen map[string]struct{}) {
+	for _, transfer := range transfers {
+		seen[computeTransferKey(transfer)] = struct{}{}
+	}
+}
+
+func computeTransferKey(transfer *data.Transfer) string {
+	return strings.Join([]string{transfer.OriginalTxHash, transfer.Sender, transfer.Receiver, transfer.Identifier, transfer.Amount}, "|")
+}
+
+// appendNotSeen skips the transfers already extracted from another source of the same original transaction, e.g. the
+// cross-shard DCDT transfer that is visible both in the transaction and in the smart contract result that carries it
+func appendNotSeen(transfers []*data.Transfer, newTransfers []*data.Transfer, seen map[string]struct{}) []*data.Transfer {
+	for _, transfer := range newTransfers {
+		key := computeTransferKey(transfer)
+		_, found := seen[key]
+		if found {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+// splitTokenIdentifier returns the collection and the nonce of an NFT identifier, or the identifier itself for a fungible token
+func splitTokenIdentifier(identifier string) (string, uint64) {
+	_, hasPrefix := dcdt.IsValidPrefixedToken(identifier)
+	numNFTParts := 3
+	if hasPrefix {
+		numNFTParts++
+	}
+
+	parts := strings.Split(identifier, "-")
+	if len(parts) != numNFTParts {
+		return identifier, 0
+	}
+
+	nonce, err := strconv.ParseUint(parts[len(parts)-1], 16, 64)
+	if err != nil {
+		return identifier, 0
+	}
+
+	return strings.Join(parts[:len(parts)-1], "-"), nonce
+}
+
+func isFailed(status string) bool {
+	return status == transaction.TxStatusFail.String() || status == transaction.TxStatusInvalid.String()
+}
+
+func isPositive(value string) bool {
+	valueBig, ok := big.NewInt(0).SetString(value, 10)
+	return ok && valueBig.Sign() > 0
+}
+
+func decodeHexBigInt(hexValue string) string {
+	valueBytes, err := hex.DecodeString(hexValue)
+	if err != nil {
+		return ""
+	}
+
+	return big.NewInt(0).SetBytes(valueBytes).String()
+}
+
+func decodeHexBigIntAsUint64(hexValue string) uint64 {
+	valueBytes, err := hex.DecodeString(hexValue)
+	if err != nil {
+		return 0
+	}
+
+	return big.NewInt(0).SetBytes(valueBytes).Uint64()
+}
@@ -0,0 +1,325 @@
+package transfers
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+var (
+	alice    = strings.Repeat("a1", 32)
+	bob      = strings.Repeat("b2", 32)
+	carol    = strings.Repeat("c0", 32)
+	contract = strings.Repeat("00", 10) + strings.Repeat("c3", 22)
+)
+
+func createTransfersProcessor() *transfersProcessor {
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	tp, _ := NewTransfersProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+
+	return tp
+}
+
+func TestNewTransfersProcessor(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+
+	tp, err := NewTransfersProcessor(nil, balanceConverter)
+	require.Nil(t, tp)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	tp, err = NewTransfersProcessor(mock.NewPubkeyConverterMock(32), nil)
+	require.Nil(t, tp)
+	require.Equal(t, dataindexer.ErrNilBalanceConverter, err)
+
+	tp, err = NewTransfersProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	require.Nil(t, err)
+	require.NotNil(t, tp)
+}
+
+func TestTransfersProcessor_PrepareTransfersRewaAndMultiTransfer(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	txs := []*data.Transaction{
+		{
+			Hash:          "h1",
+			Sender:        alice,
+			Receiver:      contract,
+			Value:         "1000000000000000000",
+			ReceiverShard: 1,
+			Timestamp:     time.Duration(5040),
+		},
+		{
+			Hash:              "h2",
+			Sender:            alice,
+			Receiver:          alice,
+			Value:             "0",
+			Tokens:            []string{"TKN-abcd", "NFT-abcd-0a"},
+			DCDTValues:        []string{"100", "1"},
+			Receivers:         []string{bob, bob},
+			ReceiversShardIDs: []uint32{2, 2},
+			Timestamp:         time.Duration(5040),
+		},
+		{
+			Hash:   "h3",
+			Sender: alice,
+			Value:  "10",
+			Status: transaction.TxStatusFail.String(),
+		},
+		{
+			Hash:        "h4",
+			Sender:      bob,
+			Value:       "10",
+			SenderShard: 1,
+		},
+	}
+
+	transfers := tp.PrepareTransfers(txs, nil, nil, 0, 3)
+	require.Len(t, transfers, 3)
+
+	require.Equal(t, &data.Transfer{
+		ID:             "h1-0",
+		TxHash:         "h1",
+		OriginalTxHash: "h1",
+		Sender:         alice,
+		Receiver:       contract,
+		ReceiverShard:  1,
+		Token:          RewaToken,
+		Identifier:     RewaToken,
+		Amount:         "1000000000000000000",
+		AmountNum:      1,
+		Direction:      DirectionUserToContract,
+		Source:         SourceTransaction,
+		Timestamp:      time.Duration(5040),
+	}, transfers[0])
+
+	require.Equal(t, "h2-0", transfers[1].ID)
+	require.Equal(t, bob, transfers[1].Receiver)
+	require.Equal(t, uint32(2), transfers[1].ReceiverShard)
+	require.Equal(t, "TKN-abcd", transfers[1].Token)
+	require.Equal(t, uint64(0), transfers[1].Nonce)
+	require.Equal(t, "100", transfers[1].Amount)
+	require.Equal(t, DirectionUserToUser, transfers[1].Direction)
+
+	require.Equal(t, "h2-1", transfers[2].ID)
+	require.Equal(t, "NFT-abcd", transfers[2].Token)
+	require.Equal(t, "NFT-abcd-0a", transfers[2].Identifier)
+	require.Equal(t, uint64(10), transfers[2].Nonce)
+	require.Equal(t, "1", transfers[2].Amount)
+}
+
+func TestTransfersProcessor_PrepareTransfersShouldSkipDuplicatedScrAndEvents(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	txs := []*data.Transaction{
+		{
+			Hash:          "h1",
+			Sender:        alice,
+			Receiver:      bob,
+			Value:         "0",
+			Tokens:        []string{"TKN-abcd"},
+			DCDTValues:    []string{"100"},
+			ReceiverShard: 1,
+		},
+	}
+	scrs := []*data.ScResult{
+		{
+			// the smart contract result that carries the cross-shard transfer of the transaction
+			Hash:           "scr1",
+			OriginalTxHash: "h1",
+			Sender:         alice,
+			Receiver:       bob,
+			Value:          "0",
+			Tokens:         []string{"TKN-abcd"},
+			DCDTValues:     []string{"100"},
+			ReceiverShard:  1,
+		},
+		{
+			Hash:           "scr2",
+			OriginalTxHash: "h1",
+			Sender:         contract,
+			Receiver:       alice,
+			Value:          "5",
+		},
+	}
+
+	carolBytes, _ := hex.DecodeString(carol)
+	aliceBytes, _ := hex.DecodeString(alice)
+	events := []*data.LogEvent{
+		{
+			// same transfer as the transaction
+			ID:         "h1-0-0",
+			TxHash:     "h1",
+			Address:    alice,
+			Identifier: core.BuiltInFunctionDCDTTransfer,
+			Topics:     []string{hex.EncodeToString([]byte("TKN-abcd")), "", hex.EncodeToString(big.NewInt(100).Bytes()), bob},
+		},
+		{
+			// transfer done by the contract that is visible only in the event
+			ID:             "scr2-0-1",
+			TxHash:         "scr2",
+			OriginalTxHash: "h1",
+			Address:        contract,
+			Identifier:     core.BuiltInFunctionDCDTNFTTransfer,
+			Topics:         []string{hex.EncodeToString([]byte("NFT-abcd")), "0a", "01", hex.EncodeToString(carolBytes)},
+		},
+		{
+			ID:             "scr2-0-2",
+			TxHash:         "scr2",
+			OriginalTxHash: "h1",
+			Address:        contract,
+			Identifier:     transferValueOnlyIdentifier,
+			Topics:         []string{hex.EncodeToString(big.NewInt(5).Bytes()), hex.EncodeToString(aliceBytes)},
+		},
+		{
+			ID:         "h1-0-3",
+			Address:    contract,
+			Identifier: "swap",
+			Topics:     []string{"01"},
+		},
+	}
+
+	transfers := tp.PrepareTransfers(txs, scrs, events, 0, 3)
+	require.Len(t, transfers, 3)
+	require.Equal(t, "h1-0", transfers[0].ID)
+	require.Equal(t, SourceTransaction, transfers[0].Source)
+
+	require.Equal(t, "scr2-0", transfers[1].ID)
+	require.Equal(t, SourceScResult, transfers[1].Source)
+	require.Equal(t, DirectionContractToUser, transfers[1].Direction)
+
+	require.Equal(t, "scr2-0-1", transfers[2].ID)
+	require.Equal(t, SourceEvent, transfers[2].Source)
+	require.Equal(t, "scr2", transfers[2].TxHash)
+	require.Equal(t, "h1", transfers[2].OriginalTxHash)
+	require.Equal(t, contract, transfers[2].Sender)
+	require.Equal(t, carol, transfers[2].Receiver)
+	require.Equal(t, "NFT-abcd-0a", transfers[2].Identifier)
+	require.Equal(t, uint64(10), transfers[2].Nonce)
+	require.Equal(t, "1", transfers[2].Amount)
+}
+
+func TestTransfersProcessor_PrepareTransfersFromEventsOnlyInReceiverShard(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	scrs := []*data.ScResult{
+		{Hash: "scr1", SenderShard: 1, Status: transaction.TxStatusSuccess.String()},
+		{Hash: "scr2", SenderShard: 1, Status: transaction.TxStatusFail.String()},
+	}
+
+	bobBytes, _ := hex.DecodeString(bob)
+	carolBytes, _ := hex.DecodeString(carol)
+	events := []*data.LogEvent{
+		{
+			// the receiver is in another shard, the transfer is indexed by the receiver shard
+			ID:         "scr1-0-0",
+			TxHash:     "scr1",
+			Address:    contract,
+			Identifier: core.BuiltInFunctionDCDTTransfer,
+			Topics:     []string{hex.EncodeToString([]byte("TKN-abcd")), "", "01", hex.EncodeToString(bobBytes)},
+		},
+		{
+			ID:         "scr1-0-1",
+			TxHash:     "scr1",
+			Address:    contract,
+			Identifier: core.BuiltInFunctionDCDTTransfer,
+			Topics:     []string{hex.EncodeToString([]byte("TKN-abcd")), "", "02", hex.EncodeToString(carolBytes)},
+		},
+		{
+			// the smart contract result that logged the event failed in this shard
+			ID:         "scr2-0-0",
+			TxHash:     "scr2",
+			Address:    contract,
+			Identifier: core.BuiltInFunctionDCDTTransfer,
+			Topics:     []string{hex.EncodeToString([]byte("TKN-abcd")), "", "03", hex.EncodeToString(carolBytes)},
+		},
+	}
+
+	transfers := tp.PrepareTransfers(nil, scrs, events, 0, 3)
+	require.Len(t, transfers, 1)
+	require.Equal(t, "scr1-0-1", transfers[0].ID)
+	require.Equal(t, carol, transfers[0].Receiver)
+	require.Equal(t, uint32(0), transfers[0].ReceiverShard)
+	require.Equal(t, "2", transfers[0].Amount)
+}
+
+func TestTransfersProcessor_PrepareTransfersCrossShardShouldIndexOneTransfer(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	tx := &data.Transaction{
+		Hash:          "h1",
+		Sender:        alice,
+		Receiver:      bob,
+		Value:         "0",
+		Tokens:        []string{"TKN-abcd"},
+		DCDTValues:    []string{"100"},
+		SenderShard:   0,
+		ReceiverShard: 2,
+		Status:        transaction.TxStatusSuccess.String(),
+	}
+
+	bobBytes, _ := hex.DecodeString(bob)
+	events := []*data.LogEvent{
+		{
+			// the event logged in the receiver shard for the transfer carried by the transaction
+			ID:         "h1-2-0",
+			TxHash:     "h1",
+			Address:    alice,
+			Identifier: core.BuiltInFunctionDCDTTransfer,
+			Topics:     []string{hex.EncodeToString([]byte("TKN-abcd")), "", hex.EncodeToString(big.NewInt(100).Bytes()), hex.EncodeToString(bobBytes)},
+		},
+	}
+
+	sourceTransfers := tp.PrepareTransfers([]*data.Transaction{tx}, nil, nil, 0, 3)
+	destinationTransfers := tp.PrepareTransfers([]*data.Transaction{tx}, nil, events, 2, 3)
+
+	ids := make(map[string]struct{})
+	for _, transfer := range append(sourceTransfers, destinationTransfers...) {
+		ids[transfer.ID] = struct{}{}
+	}
+	require.Len(t, ids, 1)
+	require.Len(t, sourceTransfers, 1)
+	require.Equal(t, "h1-0", sourceTransfers[0].ID)
+	require.Equal(t, uint32(2), sourceTransfers[0].ReceiverShard)
+	require.Len(t, destinationTransfers, 0)
+}
+
+func TestSplitTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	token, nonce := splitTokenIdentifier("TKN-abcd")
+	require.Equal(t, "TKN-abcd", token)
+	require.Equal(t, uint64(0), nonce)
+
+	token, nonce = splitTokenIdentifier("NFT-abcd-0f")
+	require.Equal(t, "NFT-abcd", token)
+	require.Equal(t, uint64(15), nonce)
+
+	token, nonce = splitTokenIdentifier("sov-TKN-abcdef")
+	require.Equal(t, "sov-TKN-abcdef", token)
+	require.Equal(t, uint64(0), nonce)
+
+	token, nonce = splitTokenIdentifier("sov-NFT-abcdef-01")
+	require.Equal(t, "sov-NFT-abcdef", token)
+	require.Equal(t, uint64(1), nonce)
+}
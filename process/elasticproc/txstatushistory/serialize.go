@@ -0,0 +1,27 @@
+package txstatushistory
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeStatusTransitions will serialize the provided status transitions in a way that Elasticsearch expects a bulk request
+func (tsh *txStatusHistoryProcessor) SerializeStatusTransitions(transitions []*data.TxStatusTransition, buffSlice *data.BufferSlice, index string) error {
+	for _, transition := range transitions {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(transition.ID), "\n"))
+		serializedData, err := json.Marshal(transition)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package txstatushistory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestTxStatusHistoryProcessor_SerializeStatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	tsh, _ := NewTxStatusHistoryProcessor()
+
+	transitions := []*data.TxStatusTransition{
+		newTransition("tx1", TypeTransaction, "pending", CauseSourceExecution, 0, "h1", 5000),
+		newTransition("tx1", TypeTransaction, "", CauseRevert, 0, "h1", 5000),
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := tsh.SerializeStatusTransitions(transitions, buffSlice, "txstatushistory")
+	require.Nil(t, err)
+
+	expectedBuff := `{ "index" : { "_index":"txstatushistory", "_id" : "tx1-0-5000-h1-sourceExecution-pending" } }
+{"txHash":"tx1","type":"transaction","status":"pending","cause":"sourceExecution","shardID":0,"timestamp":5000}
+{ "index" : { "_index":"txstatushistory", "_id" : "tx1-0-5000-h1-revert-" } }
+{"txHash":"tx1","type":"transaction","cause":"revert","shardID":0,"timestamp":5000}
+`
+	require.Equal(t, expectedBuff, buffSlice.Buffers()[0].String())
+}
@@ -0,0 +1,157 @@
+package txstatushistory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+const (
+	// TypeTransaction marks the status transitions of a transaction
+	TypeTransaction = "transaction"
+	// TypeScResult marks the status transitions of a smart contract result
+	TypeScResult = "scResult"
+
+	// CauseSourceExecution marks the status computed when the item is executed on the source shard
+	CauseSourceExecution = "sourceExecution"
+	// CauseDestinationExecution marks the status computed when a cross-shard item is executed on the destination shard
+	CauseDestinationExecution = "destinationExecution"
+	// CauseSignalError marks the status set by a signalError or an internalVMErrors event
+	CauseSignalError = "signalError"
+	// CauseCompletedTxEvent marks the completion of a transaction signaled by a completedTxEvent
+	CauseCompletedTxEvent = "completedTxEvent"
+	// CauseRefund marks the gas refund of a transaction, which is generated only after its execution
+	CauseRefund = "refund"
+	// CauseRevert marks the removal of an item whose block was reverted
+	CauseRevert = "revert"
+)
+
+type txStatusHistoryProcessor struct {
+}
+
+// NewTxStatusHistoryProcessor will create a new instance of txStatusHistoryProcessor
+func NewTxStatusHistoryProcessor() (*txStatusHistoryProcessor, error) {
+	return &txStatusHistoryProcessor{}, nil
+}
+
+// PrepareStatusTransitions will create the status transitions of the transactions and smart contract results of a block.
+// The transactions statuses updated by the informative events of the smart contract results are the same ones that
+// update the transactions documents.
+func (tsh *txStatusHistoryProcessor) PrepareStatusTransitions(
+	preparedResults *data.PreparedResults,
+	logsData *data.PreparedLogsResults,
+	headerHash string,
+	timestamp uint64,
+	selfShardID uint32,
+) []*data.TxStatusTransition {
+	transitions := make([]*data.TxStatusTransition, 0)
+	if preparedResults == nil {
+		return transitions
+	}
+
+	for _, tx := range preparedResults.Transactions {
+		cause := computeExecutionCause(tx.SenderShard, tx.ReceiverShard, selfShardID)
+		switch {
+		case tx.ErrorEvent:
+			cause = CauseSignalError
+		case tx.CompletedEvent:
+			cause = CauseCompletedTxEvent
+		}
+
+		transitions = append(transitions, newTransition(tx.Hash, TypeTransaction, tx.Status, cause, selfShardID, headerHash, tx.Timestamp))
+	}
+
+	for _, scr := range preparedResults.ScResults {
+		transitions = append(transitions, prepareScResultTransition(scr, selfShardID, headerHash))
+
+		isRefund := scr.GasRefunded > 0 || scr.ReturnMessage == data.GasRefundForRelayerMessage
+		if isRefund && scr.OriginalTxHash != "" {
+			transitions = append(transitions, newTransition(scr.OriginalTxHash, TypeTransaction, "", CauseRefund, selfShardID, headerHash, scr.Timestamp))
+		}
+	}
+
+	if logsData != nil {
+		for txHash, statusInfo := range logsData.TxHashStatusInfo {
+			if statusInfo == nil {
+				continue
+			}
+
+			switch {
+			case statusInfo.ErrorEvent:
+				transitions = append(transitions, newTransition(txHash, TypeTransaction, statusInfo.Status, CauseSignalError, selfShardID, headerHash, time.Duration(timestamp)))
+			case statusInfo.CompletedEvent:
+				transitions = append(transitions, newTransition(txHash, TypeTransaction, statusInfo.Status, CauseCompletedTxEvent, selfShardID, headerHash, time.Duration(timestamp)))
+			}
+		}
+	}
+
+	return sortTransitions(transitions)
+}
+
+// PrepareRevertTransitions will create the status transitions of the transactions and smart contract results removed
+// because their block was reverted
+func (tsh *txStatusHistoryProcessor) PrepareRevertTransitions(txHashes []string, scrHashes []string, headerHash string, timestamp uint64, shardID uint32) []*data.TxStatusTransition {
+	transitions := make([]*data.TxStatusTransition, 0, len(txHashes)+len(scrHashes))
+	for _, txHash := range txHashes {
+		transitions = append(transitions, newTransition(txHash, TypeTransaction, "", CauseRevert, shardID, headerHash, time.Duration(timestamp)))
+	}
+	for _, scrHash := range scrHashes {
+		transitions = append(transitions, newTransition(scrHash, TypeScResult, "", CauseRevert, shardID, headerHash, time.Duration(timestamp)))
+	}
+
+	return sortTransitions(transitions)
+}
+
+// the smart contract results documents do not hold a pending status, so it is computed in the same way as for the transactions
+func prepareScResultTransition(scr *data.ScResult, selfShardID uint32, headerHash string) *data.TxStatusTransition {
+	status := scr.Status
+	if status == "" {
+		status = transaction.TxStatusSuccess.String()
+		if selfShardID != scr.ReceiverShard {
+			status = transaction.TxStatusPending.String()
+		}
+	}
+
+	cause := computeExecutionCause(scr.SenderShard, scr.ReceiverShard, selfShardID)
+	if status == transaction.TxStatusFail.String() {
+		cause = CauseSignalError
+	}
+
+	return newTransition(scr.Hash, TypeScResult, status, cause, selfShardID, headerHash, scr.Timestamp)
+}
+
+func computeExecutionCause(senderShard uint32, receiverShard uint32, selfShardID uint32) string {
+	isCrossShardOnDestination := senderShard != receiverShard && selfShardID == receiverShard
+	if isCrossShardOnDestination {
+		return CauseDestinationExecution
+	}
+
+	return CauseSourceExecution
+}
+
+// newTransition returns a transition with an ID based on all its fields and on the hash of its block, so indexing the same
+// block twice does not duplicate the transitions, while the block that replaces a reverted one, having a different hash,
+// adds its own transitions next to the ones of the reverted block and to the revert transitions
+func newTransition(txHash string, txType string, status string, cause string, shardID uint32, headerHash string, timestamp time.Duration) *data.TxStatusTransition {
+	return &data.TxStatusTransition{
+		ID:        fmt.Sprintf("%s-%d-%d-%s-%s-%s", txHash, shardID, timestamp, headerHash, cause, status),
+		TxHash:    txHash,
+		Type:      txType,
+		Status:    status,
+		Cause:     cause,
+		ShardID:   shardID,
+		Timestamp: timestamp,
+	}
+}
+
+func sortTransitions(transitions []*data.TxStatusTransition) []*data.TxStatusTransition {
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].ID < transitions[j].ID
+	})
+
+	return transitions
+}
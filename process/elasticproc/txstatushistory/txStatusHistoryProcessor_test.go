@@ -0,0 +1,104 @@
+package txstatushistory
+
+import (
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/outport"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestTxStatusHistoryProcessor_PrepareStatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	tsh, _ := NewTxStatusHistoryProcessor()
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Status: "pending", SenderShard: 1, ReceiverShard: 2, Timestamp: 5000},
+			{Hash: "tx2", Status: "success", SenderShard: 0, ReceiverShard: 1, Timestamp: 5000},
+			{Hash: "tx3", Status: "fail", SenderShard: 1, ReceiverShard: 1, ErrorEvent: true, Timestamp: 5000},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "scr1", OriginalTxHash: "tx4", SenderShard: 1, ReceiverShard: 0, Timestamp: 5000},
+			{Hash: "scr2", OriginalTxHash: "tx5", SenderShard: 0, ReceiverShard: 1, GasRefunded: 10, Timestamp: 5000},
+			{Hash: "scr3", OriginalTxHash: "tx6", SenderShard: 1, ReceiverShard: 1, Status: "fail", Timestamp: 5000},
+		},
+	}
+	logsData := &data.PreparedLogsResults{
+		TxHashStatusInfo: map[string]*outport.StatusInfo{
+			"tx6": {Status: "fail", ErrorEvent: true},
+			"tx7": {CompletedEvent: true},
+			"tx8": {},
+		},
+	}
+
+	transitions := tsh.PrepareStatusTransitions(preparedResults, logsData, "h1", 5000, 1)
+	require.Equal(t, []*data.TxStatusTransition{
+		newTransition("scr1", TypeScResult, "pending", CauseSourceExecution, 1, "h1", 5000),
+		newTransition("scr2", TypeScResult, "success", CauseDestinationExecution, 1, "h1", 5000),
+		newTransition("scr3", TypeScResult, "fail", CauseSignalError, 1, "h1", 5000),
+		newTransition("tx1", TypeTransaction, "pending", CauseSourceExecution, 1, "h1", 5000),
+		newTransition("tx2", TypeTransaction, "success", CauseDestinationExecution, 1, "h1", 5000),
+		newTransition("tx3", TypeTransaction, "fail", CauseSignalError, 1, "h1", 5000),
+		newTransition("tx5", TypeTransaction, "", CauseRefund, 1, "h1", 5000),
+		newTransition("tx6", TypeTransaction, "fail", CauseSignalError, 1, "h1", 5000),
+		newTransition("tx7", TypeTransaction, "", CauseCompletedTxEvent, 1, "h1", 5000),
+	}, transitions)
+}
+
+func TestTxStatusHistoryProcessor_PrepareStatusTransitionsNilResults(t *testing.T) {
+	t.Parallel()
+
+	tsh, _ := NewTxStatusHistoryProcessor()
+
+	require.Empty(t, tsh.PrepareStatusTransitions(nil, nil, "h1", 5000, 1))
+	require.Len(t, tsh.PrepareStatusTransitions(&data.PreparedResults{Transactions: []*data.Transaction{{Hash: "tx1"}}}, nil, "h1", 5000, 1), 1)
+}
+
+func TestTxStatusHistoryProcessor_PrepareRevertTransitions(t *testing.T) {
+	t.Parallel()
+
+	tsh, _ := NewTxStatusHistoryProcessor()
+
+	transitions := tsh.PrepareRevertTransitions([]string{"tx2", "tx1"}, []string{"scr1"}, "h1", 5000, 2)
+	require.Equal(t, []*data.TxStatusTransition{
+		newTransition("scr1", TypeScResult, "", CauseRevert, 2, "h1", 5000),
+		newTransition("tx1", TypeTransaction, "", CauseRevert, 2, "h1", 5000),
+		newTransition("tx2", TypeTransaction, "", CauseRevert, 2, "h1", 5000),
+	}, transitions)
+	require.Equal(t, "tx1-2-5000-h1-revert-", transitions[1].ID)
+}
+
+func TestTxStatusHistoryProcessor_PrepareStatusTransitionsOfTheBlockThatReplacesARevertedOne(t *testing.T) {
+	t.Parallel()
+
+	tsh, _ := NewTxStatusHistoryProcessor()
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Status: "success", SenderShard: 1, ReceiverShard: 1, Timestamp: 5000},
+		},
+	}
+
+	ids := make(map[string]struct{})
+	for _, transition := range tsh.PrepareStatusTransitions(preparedResults, nil, "h1", 5000, 1) {
+		ids[transition.ID] = struct{}{}
+	}
+	for _, transition := range tsh.PrepareRevertTransitions([]string{"tx1"}, nil, "h1", 5000, 1) {
+		ids[transition.ID] = struct{}{}
+	}
+	for _, transition := range tsh.PrepareStatusTransitions(preparedResults, nil, "h2", 5000, 1) {
+		ids[transition.ID] = struct{}{}
+	}
+	for _, transition := range tsh.PrepareStatusTransitions(preparedResults, nil, "h2", 5000, 1) {
+		ids[transition.ID] = struct{}{}
+	}
+
+	require.Equal(t, map[string]struct{}{
+		"tx1-1-5000-h1-sourceExecution-success": {},
+		"tx1-1-5000-h1-revert-":                 {},
+		"tx1-1-5000-h2-sourceExecution-success": {},
+	}, ids)
+}
@@ -0,0 +1,107 @@
+package txtraces
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+var traceLists = []string{"scResults", "events", "receipts", "refunds", "statusChanges"}
+
+// SerializeTxTraces will serialize the provided traces. The lists are merged with the already indexed ones based on the
+// ID of the items, so the pieces of a cross-shard transaction that arrive in different blocks end up in the same document.
+func (ttp *txTracesProcessor) SerializeTxTraces(traces map[string]*data.TxTrace, buffSlice *data.BufferSlice, index string) error {
+	for txHash, trace := range traces {
+		meta := []byte(fmt.Sprintf(`{"update":{ "_index":"%s","_id":"%s"}}%s`, index, converters.JsonEscape(txHash), "\n"))
+		marshaledTrace, err := json.Marshal(trace)
+		if err != nil {
+			return err
+		}
+
+		codeToExecute := `
+			for (entry in params.trace.entrySet()) {
+				String key = entry.getKey();
+				def value = entry.getValue();
+				if (value instanceof List) {
+					if (!ctx._source.containsKey(key) || ctx._source[key] == null) {
+						ctx._source[key] = new ArrayList();
+					}
+					List existing = ctx._source[key];
+					for (item in value) {
+						boolean found = false;
+						for (existingItem in existing) {
+							if (existingItem.get('id') == item.get('id')) {
+								found = true;
+								break;
+							}
+						}
+						if (!found) {
+							existing.add(item);
+						}
+					}
+					continue;
+				}
+				if (key == 'timestamp' && ctx._source.containsKey('timestamp')) {
+					continue;
+				}
+				if (key == 'status' && value == 'pending' && ctx._source.containsKey('status')) {
+					continue;
+				}
+				ctx._source[key] = value;
+			}
+`
+		serializedData := []byte(fmt.Sprintf(`{"scripted_upsert": true, "script": {"source": "%s","lang": "painless","params": {"trace": %s}},"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(marshaledTrace)),
+		)
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareTxTracesQueryInCaseOfRevert will prepare the update by query that removes from the traces the items added by the
+// reverted block. The items added by other blocks are kept, and the status is restored from the remaining status changes.
+func (ttp *txTracesProcessor) PrepareTxTracesQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := `
+		for (key in params.lists) {
+			if (ctx._source.containsKey(key) && ctx._source[key] != null) {
+				ctx._source[key].removeIf(item -> item.shardID == params.shardID && item.timestamp == params.timestamp);
+			}
+		}
+		def lastChange = null;
+		if (ctx._source.statusChanges != null) {
+			for (change in ctx._source.statusChanges) {
+				if (lastChange == null || change.timestamp >= lastChange.timestamp) {
+					lastChange = change;
+				}
+			}
+		}
+		if (lastChange != null) {
+			ctx._source.status = lastChange.status;
+		} else {
+			ctx._source.remove('status');
+		}
+`
+
+	nestedQueries := make([]string, 0, len(traceLists))
+	for _, list := range traceLists {
+		nestedQueries = append(nestedQueries, fmt.Sprintf(
+			`{"nested": {"path": "%s","query": {"bool": {"must": [{"match": {"%s.shardID": %d}},{"match": {"%s.timestamp": %d}}]}}}}`,
+			list, list, shardID, list, timestamp,
+		))
+	}
+
+	query := fmt.Sprintf(`{"query": {"bool": {"should": [%s]}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"lists": ["%s"], "shardID": %d, "timestamp": %d}}}`,
+		strings.Join(nestedQueries, ","), converters.FormatPainlessSource(codeToExecute), strings.Join(traceLists, `","`), shardID, timestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
@@ -0,0 +1,49 @@
+package txtraces
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestTxTracesProcessor_SerializeTxTraces(t *testing.T) {
+	t.Parallel()
+
+	ttp, _ := NewTxTracesProcessor()
+
+	traces := map[string]*data.TxTrace{
+		"tx1": {
+			ID:            "tx1",
+			Status:        "success",
+			ScResults:     []*data.TraceScResult{{ID: "scr1", PrevTxHash: "tx1", Sender: "contract", Receiver: "alice", Value: "1"}},
+			Events:        make([]*data.TraceEvent, 0),
+			Receipts:      make([]*data.TraceReceipt, 0),
+			Refunds:       make([]*data.TraceRefund, 0),
+			StatusChanges: []*data.TraceStatusChange{{ID: "success-0", Status: "success"}},
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := ttp.SerializeTxTraces(traces, buffSlice, "txtraces")
+	require.Nil(t, err)
+
+	lines := strings.Split(buffSlice.Buffers()[0].String(), "\n")
+	require.Equal(t, `{"update":{ "_index":"txtraces","_id":"tx1"}}`, lines[0])
+	require.True(t, strings.HasPrefix(lines[1], `{"scripted_upsert": true, "script": {"source": "for (entry in params.trace.entrySet()) {`))
+	require.True(t, strings.HasSuffix(lines[1], `"params": {"trace": {"status":"success","scResults":[{"id":"scr1","prevTxHash":"tx1","sender":"contract","receiver":"alice","value":"1","order":0,"shardID":0,"timestamp":0}],"events":[],"receipts":[],"refunds":[],"statusChanges":[{"id":"success-0","status":"success","shardID":0,"timestamp":0}]}}},"upsert": {}}`))
+}
+
+func TestTxTracesProcessor_PrepareTxTracesQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	ttp, _ := NewTxTracesProcessor()
+
+	query := ttp.PrepareTxTracesQueryInCaseOfRevert(5040, 1).String()
+	require.True(t, strings.HasPrefix(query, `{"query": {"bool": {"should": [{"nested": {"path": "scResults","query": {"bool": {"must": [{"match": {"scResults.shardID": 1}},{"match": {"scResults.timestamp": 5040}}]}}}},`))
+	require.Contains(t, query, `{"nested": {"path": "statusChanges","query": {"bool": {"must": [{"match": {"statusChanges.shardID": 1}},{"match": {"statusChanges.timestamp": 5040}}]}}}}]}}`)
+	require.Contains(t, query, `ctx._source[key].removeIf(item -> item.shardID == params.shardID && item.timestamp == params.timestamp);`)
+	require.True(t, strings.HasSuffix(query, `"params": {"lists": ["scResults","events","receipts","refunds","statusChanges"], "shardID": 1, "timestamp": 5040}}}`))
+}
@@ -0,0 +1,176 @@
+package txtraces
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type txTracesProcessor struct {
+}
+
+// NewTxTracesProcessor will create a new instance of txTracesProcessor
+func NewTxTracesProcessor() (*txTracesProcessor, error) {
+	return &txTracesProcessor{}, nil
+}
+
+// PrepareTxTraces will group all the pieces of the block by the original transaction hash. The returned traces contain
+// only the pieces of the current block, they are merged with the already indexed ones at serialization.
+func (ttp *txTracesProcessor) PrepareTxTraces(
+	preparedResults *data.PreparedResults,
+	logsData *data.PreparedLogsResults,
+	timestamp uint64,
+	selfShardID uint32,
+) map[string]*data.TxTrace {
+	traces := make(map[string]*data.TxTrace)
+	if preparedResults == nil {
+		return traces
+	}
+
+	for _, tx := range preparedResults.Transactions {
+		trace := getOrCreateTrace(traces, tx.Hash)
+		trace.Sender = tx.Sender
+		trace.Receiver = tx.Receiver
+		trace.Value = tx.Value
+		trace.Function = tx.Function
+		trace.Status = tx.Status
+		trace.Fee = tx.Fee
+		trace.FeeNum = tx.FeeNum
+		trace.GasUsed = tx.GasUsed
+		trace.InitialPaidFee = tx.InitialPaidFee
+		trace.Timestamp = tx.Timestamp
+		trace.StatusChanges = append(trace.StatusChanges, newStatusChange(tx.Status, selfShardID, tx.Timestamp))
+	}
+
+	scrs := make([]*data.ScResult, len(preparedResults.ScResults))
+	copy(scrs, preparedResults.ScResults)
+	sort.SliceStable(scrs, func(i, j int) bool {
+		return scrs[i].ExecutionOrder < scrs[j].ExecutionOrder
+	})
+	for _, scr := range scrs {
+		if scr.OriginalTxHash == "" {
+			continue
+		}
+
+		trace := getOrCreateTrace(traces, scr.OriginalTxHash)
+		trace.ScResults = append(trace.ScResults, &data.TraceScResult{
+			ID:            scr.Hash,
+			PrevTxHash:    scr.PrevTxHash,
+			Sender:        scr.Sender,
+			Receiver:      scr.Receiver,
+			Value:         scr.Value,
+			Function:      scr.Function,
+			CallType:      scr.CallType,
+			ReturnMessage: scr.ReturnMessage,
+			Order:         scr.ExecutionOrder,
+			ShardID:       selfShardID,
+			Timestamp:     scr.Timestamp,
+		})
+
+		isRefund := scr.GasRefunded > 0 || scr.ReturnMessage == data.GasRefundForRelayerMessage
+		if isRefund {
+			trace.Refunds = append(trace.Refunds, &data.TraceRefund{
+				ID:          scr.Hash,
+				Receiver:    scr.Receiver,
+				Value:       scr.Value,
+				GasRefunded: scr.GasRefunded,
+				ShardID:     selfShardID,
+				Timestamp:   scr.Timestamp,
+			})
+		}
+	}
+
+	for _, receipt := range preparedResults.Receipts {
+		trace := getOrCreateTrace(traces, receipt.TxHash)
+		trace.Receipts = append(trace.Receipts, &data.TraceReceipt{
+			ID:        receipt.Hash,
+			Sender:    receipt.Sender,
+			Value:     receipt.Value,
+			Data:      receipt.Data,
+			ShardID:   selfShardID,
+			Timestamp: receipt.Timestamp,
+		})
+	}
+
+	for txHash, feeData := range preparedResults.TxHashFee {
+		trace := getOrCreateTrace(traces, txHash)
+		trace.Fee = feeData.Fee
+		trace.FeeNum = feeData.FeeNum
+		trace.GasUsed = feeData.GasUsed
+	}
+
+	if logsData == nil {
+		return traces
+	}
+
+	for _, event := range logsData.DBEvents {
+		originalTxHash := event.OriginalTxHash
+		if originalTxHash == "" {
+			originalTxHash = event.TxHash
+		}
+
+		trace := getOrCreateTrace(traces, originalTxHash)
+		trace.Events = append(trace.Events, &data.TraceEvent{
+			ID:          event.ID,
+			TxHash:      event.TxHash,
+			Address:     event.Address,
+			Identifier:  event.Identifier,
+			Topics:      event.Topics,
+			Data:        event.Data,
+			DecodedData: event.DecodedData,
+			Order:       event.Order,
+			ShardID:     event.ShardID,
+			Timestamp:   event.Timestamp,
+		})
+	}
+
+	for txHash, statusInfo := range logsData.TxHashStatusInfo {
+		if statusInfo == nil || statusInfo.Status == "" {
+			continue
+		}
+
+		trace := getOrCreateTrace(traces, txHash)
+		trace.Status = statusInfo.Status
+		trace.StatusChanges = append(trace.StatusChanges, newStatusChange(statusInfo.Status, selfShardID, time.Duration(timestamp)))
+	}
+
+	return traces
+}
+
+func getOrCreateTrace(traces map[string]*data.TxTrace, txHash string) *data.TxTrace {
+	trace, found := traces[txHash]
+	if found {
+		return trace
+	}
+
+	trace = &data.TxTrace{
+		ID:            txHash,
+		ScResults:     make([]*data.TraceScResult, 0),
+		Events:        make([]*data.TraceEvent, 0),
+		Receipts:      make([]*data.TraceReceipt, 0),
+		Refunds:       make([]*data.TraceRefund, 0),
+		StatusChanges: make([]*data.TraceStatusChange, 0),
+	}
+	traces[txHash] = trace
+
+	return trace
+}
+
+// newStatusChange returns a status change with an ID based on the status and on the shard, so the same status reported
+// twice by a shard is kept only once
+func newStatusChange(status string, shardID uint32, timestamp time.Duration) *data.TraceStatusChange {
+	if status == "" {
+		status = transaction.TxStatusPending.String()
+	}
+
+	return &data.TraceStatusChange{
+		ID:        fmt.Sprintf("%s-%d", status, shardID),
+		Status:    status,
+		ShardID:   shardID,
+		Timestamp: timestamp,
+	}
+}
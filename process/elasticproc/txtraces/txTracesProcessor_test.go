@@ -0,0 +1,96 @@
+package txtraces
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/outport"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func TestNewTxTracesProcessor(t *testing.T) {
+	t.Parallel()
+
+	ttp, err := NewTxTracesProcessor()
+	require.Nil(t, err)
+	require.NotNil(t, ttp)
+}
+
+func TestTxTracesProcessor_PrepareTxTraces(t *testing.T) {
+	t.Parallel()
+
+	ttp, _ := NewTxTracesProcessor()
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{
+				Hash:      "tx1",
+				Sender:    "alice",
+				Receiver:  "contract",
+				Function:  "swap",
+				Status:    transaction.TxStatusSuccess.String(),
+				Fee:       "100",
+				Timestamp: time.Duration(5040),
+			},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "scr2", OriginalTxHash: "tx1", PrevTxHash: "scr1", ExecutionOrder: 2, GasRefunded: 10, Receiver: "alice"},
+			{Hash: "scr1", OriginalTxHash: "tx1", PrevTxHash: "tx1", ExecutionOrder: 1, Function: "getPrice"},
+			{Hash: "scr3", OriginalTxHash: "tx0", PrevTxHash: "tx0"},
+		},
+		Receipts: []*data.Receipt{
+			{Hash: "rec1", TxHash: "tx1", Value: "5"},
+		},
+		TxHashFee: map[string]*data.FeeData{
+			"tx0": {Fee: "50", GasUsed: 5},
+		},
+	}
+	logsData := &data.PreparedLogsResults{
+		DBEvents: []*data.LogEvent{
+			{ID: "ev1", TxHash: "tx1", Identifier: "swap"},
+			{ID: "ev2", TxHash: "scr3", OriginalTxHash: "tx0", Identifier: "signalError"},
+		},
+		TxHashStatusInfo: map[string]*outport.StatusInfo{
+			"tx0": {Status: transaction.TxStatusFail.String()},
+		},
+	}
+
+	traces := ttp.PrepareTxTraces(preparedResults, logsData, 5040, 1)
+	require.Len(t, traces, 2)
+
+	trace := traces["tx1"]
+	require.Equal(t, "alice", trace.Sender)
+	require.Equal(t, "swap", trace.Function)
+	require.Equal(t, "100", trace.Fee)
+	require.Len(t, trace.ScResults, 2)
+	require.Equal(t, "scr1", trace.ScResults[0].ID)
+	require.Equal(t, "scr2", trace.ScResults[1].ID)
+	require.Equal(t, uint32(1), trace.ScResults[0].ShardID)
+	require.Len(t, trace.Refunds, 1)
+	require.Equal(t, uint64(10), trace.Refunds[0].GasRefunded)
+	require.Len(t, trace.Receipts, 1)
+	require.Len(t, trace.Events, 1)
+	require.Equal(t, []*data.TraceStatusChange{
+		{ID: "success-1", Status: "success", ShardID: 1, Timestamp: 5040},
+	}, trace.StatusChanges)
+
+	trace = traces["tx0"]
+	require.Equal(t, "", trace.Sender)
+	require.Equal(t, "50", trace.Fee)
+	require.Equal(t, transaction.TxStatusFail.String(), trace.Status)
+	require.Len(t, trace.ScResults, 1)
+	require.Len(t, trace.Events, 1)
+	require.Equal(t, "ev2", trace.Events[0].ID)
+	require.Len(t, trace.StatusChanges, 1)
+}
+
+func TestTxTracesProcessor_PrepareTxTracesNilResults(t *testing.T) {
+	t.Parallel()
+
+	ttp, _ := NewTxTracesProcessor()
+	require.Len(t, ttp.PrepareTxTraces(nil, nil, 0, 0), 0)
+	require.Len(t, ttp.PrepareTxTraces(&data.PreparedResults{}, nil, 0, 0), 0)
+}
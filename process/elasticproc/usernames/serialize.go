@@ -0,0 +1,185 @@
+package usernames
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/converters"
+)
+
+// SerializeUsernames will serialize the provided usernames in a way that Elasticsearch expects a bulk request. A new
+// registration is added to the history of the username and replaces the current owner only if it is not older, in which
+// case the username is no longer superseded.
+func (up *usernamesProcessor) SerializeUsernames(usernames []*data.Username, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.username;
+		} else {
+			if (!ctx._source.containsKey('history') || ctx._source.history == null) {
+				ctx._source.history = new ArrayList();
+			}
+			for (change in params.username.history) {
+				boolean found = false;
+				for (existingChange in ctx._source.history) {
+					if (existingChange.txHash == change.txHash) {
+						found = true;
+						break;
+					}
+				}
+				if (!found) {
+					ctx._source.history.add(change);
+				}
+			}
+			if (ctx._source.timestamp <= params.username.timestamp) {
+				ctx._source.address = params.username.address;
+				ctx._source.txHash = params.username.txHash;
+				ctx._source.shardID = params.username.shardID;
+				ctx._source.timestamp = params.username.timestamp;
+				ctx._source.remove('supersededBy');
+				ctx._source.remove('supersededTimestamp');
+			}
+		}
+`
+	for _, username := range usernames {
+		meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(username.UserName), "\n"))
+		marshaledUsername, err := json.Marshal(username)
+		if err != nil {
+			return err
+		}
+
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": {"username": %s}},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(marshaledUsername))
+
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareSupersededUsernamesQuery will prepare the update by query that marks as superseded the older usernames of the
+// owners of the provided usernames. A single query is prepared for all the usernames of a block, every owner keeping
+// its latest registration.
+func (up *usernamesProcessor) PrepareSupersededUsernamesQuery(usernames []*data.Username) *bytes.Buffer {
+	registrations := make(map[string]*supersedingRegistration, len(usernames))
+	for _, username := range usernames {
+		registration, found := registrations[username.Address]
+		if found && uint64(username.Timestamp) < registration.Timestamp {
+			continue
+		}
+
+		registrations[username.Address] = &supersedingRegistration{
+			UserName:  username.UserName,
+			Timestamp: uint64(username.Timestamp),
+		}
+	}
+	if len(registrations) == 0 {
+		return nil
+	}
+
+	addresses := make([]string, 0, len(registrations))
+	for address := range registrations {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	serializedAddresses, _ := json.Marshal(addresses)
+	serializedRegistrations, _ := json.Marshal(registrations)
+
+	codeToExecute := `
+		def registration = params.registrations[ctx._source.address];
+		if (registration != null && ctx._source.userName != registration.userName && ctx._source.timestamp <= registration.timestamp) {
+			ctx._source.supersededBy = registration.userName;
+			ctx._source.supersededTimestamp = registration.timestamp;
+		} else {
+			ctx.op = 'noop';
+		}
+`
+
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"terms": {"address": %s}}],`+
+		`"must_not": [{"exists": {"field": "supersededBy"}}]}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"registrations": %s}}}`,
+		string(serializedAddresses), converters.FormatPainlessSource(codeToExecute), string(serializedRegistrations))
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareUsernamesQueryInCaseOfRevert will prepare the query that returns the usernames registered or superseded in
+// the reverted block
+func (up *usernamesProcessor) PrepareUsernamesQueryInCaseOfRevert(timestamp uint64, shardID uint32) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"should": [`+
+		`{"nested": {"path": "history", "query": {"bool": {"must": [{"term": {"history.timestamp": %d}},{"term": {"history.shardID": %d}}]}}}},`+
+		`{"bool": {"must": [{"term": {"supersededTimestamp": %d}},{"term": {"shardID": %d}}]}}`+
+		`], "minimum_should_match": 1}}}`, timestamp, shardID, timestamp, shardID))
+}
+
+// SerializeRevertedUsernames will serialize the usernames documents changed by a revert. The usernames left without
+// registrations are removed.
+func (up *usernamesProcessor) SerializeRevertedUsernames(usernames []*data.Username, buffSlice *data.BufferSlice, index string) error {
+	for _, username := range usernames {
+		if len(username.History) == 0 {
+			meta := []byte(fmt.Sprintf(`{ "delete" : { "_index": "%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(username.UserName), "\n"))
+			err := buffSlice.PutData(meta, nil)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index": "%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(username.UserName), "\n"))
+		serializedData, err := json.Marshal(username)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeAccountsUsernamesInCaseOfRevert will serialize the usernames that the accounts own after a revert. The
+// accounts documents hold the username of the altered accounts, so only the existing documents are changed.
+func (up *usernamesProcessor) SerializeAccountsUsernamesInCaseOfRevert(accountsUsernames map[string]string, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop';
+		} else {
+			ctx._source.userName = params.userName;
+		}
+`
+	addresses := make([]string, 0, len(accountsUsernames))
+	for address := range accountsUsernames {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		meta := []byte(fmt.Sprintf(`{ "update" : {"_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(address), "\n"))
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": {"userName": "%s"}},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), converters.JsonEscape(accountsUsernames[address]))
+
+		err := buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
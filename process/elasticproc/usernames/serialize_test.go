@@ -0,0 +1,102 @@
+package usernames
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func createUsernames() []*data.Username {
+	return []*data.Username{
+		{
+			UserName:  "alice.drt",
+			Address:   "drt1alice",
+			TxHash:    "tx1",
+			ShardID:   1,
+			Timestamp: 5000,
+			History:   []*data.UsernameChange{{Address: "drt1alice", TxHash: "tx1", ShardID: 1, Timestamp: 5000}},
+		},
+	}
+}
+
+func TestUsernamesProcessor_SerializeUsernames(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := up.SerializeUsernames(createUsernames(), buffSlice, "usernames")
+	require.Nil(t, err)
+
+	lines := strings.Split(buffSlice.Buffers()[0].String(), "\n")
+	require.Equal(t, `{ "update" : {"_index":"usernames", "_id" : "alice.drt" } }`, lines[0])
+	require.True(t, strings.HasPrefix(lines[1], `{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.username;} else {`))
+	require.True(t, strings.HasSuffix(lines[1], `"params": {"username": {"userName":"alice.drt","address":"drt1alice","txHash":"tx1","shardID":1,"timestamp":5000,"history":[{"address":"drt1alice","txHash":"tx1","shardID":1,"timestamp":5000}]}}},"upsert": {}}`))
+}
+
+func TestUsernamesProcessor_PrepareSupersededUsernamesQuery(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+
+	require.Nil(t, up.PrepareSupersededUsernamesQuery(nil))
+
+	usernames := append(createUsernames(),
+		&data.Username{UserName: "bob.drt", Address: "drt1bob", Timestamp: 5000},
+		&data.Username{UserName: "alice2.drt", Address: "drt1alice", Timestamp: 4000},
+	)
+	query := up.PrepareSupersededUsernamesQuery(usernames)
+	expectedQuery := `{"query": {"bool": {"must": [{"terms": {"address": ["drt1alice","drt1bob"]}}],"must_not": [{"exists": {"field": "supersededBy"}}]}},` +
+		`"script": {"source": "def registration = params.registrations[ctx._source.address];if (registration != null && ctx._source.userName != registration.userName && ctx._source.timestamp <= registration.timestamp) {ctx._source.supersededBy = registration.userName;ctx._source.supersededTimestamp = registration.timestamp;} else {ctx.op = 'noop';}","lang": "painless",` +
+		`"params": {"registrations": {"drt1alice":{"userName":"alice.drt","timestamp":5000},"drt1bob":{"userName":"bob.drt","timestamp":5000}}}}}`
+	require.Equal(t, expectedQuery, query.String())
+}
+
+func TestUsernamesProcessor_PrepareUsernamesQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+
+	query := up.PrepareUsernamesQueryInCaseOfRevert(5000, 1)
+	expectedQuery := `{"query": {"bool": {"should": [` +
+		`{"nested": {"path": "history", "query": {"bool": {"must": [{"term": {"history.timestamp": 5000}},{"term": {"history.shardID": 1}}]}}}},` +
+		`{"bool": {"must": [{"term": {"supersededTimestamp": 5000}},{"term": {"shardID": 1}}]}}], "minimum_should_match": 1}}}`
+	require.Equal(t, expectedQuery, string(query))
+}
+
+func TestUsernamesProcessor_SerializeRevertedUsernames(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+
+	usernames := append(createUsernames(), &data.Username{UserName: "bob.drt", Address: "drt1bob", History: []*data.UsernameChange{}})
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := up.SerializeRevertedUsernames(usernames, buffSlice, "usernames")
+	require.Nil(t, err)
+
+	expectedBuff := `{ "index" : { "_index": "usernames", "_id" : "alice.drt" } }
+{"userName":"alice.drt","address":"drt1alice","txHash":"tx1","shardID":1,"timestamp":5000,"history":[{"address":"drt1alice","txHash":"tx1","shardID":1,"timestamp":5000}]}
+{ "delete" : { "_index": "usernames", "_id" : "bob.drt" } }
+`
+	require.Equal(t, expectedBuff, buffSlice.Buffers()[0].String())
+}
+
+func TestUsernamesProcessor_SerializeAccountsUsernamesInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := up.SerializeAccountsUsernamesInCaseOfRevert(map[string]string{"drt1bob": "", "drt1alice": "alice.drt"}, buffSlice, "accounts")
+	require.Nil(t, err)
+
+	expectedBuff := `{ "update" : {"_index":"accounts", "_id" : "drt1alice" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {ctx._source.userName = params.userName;}","lang": "painless","params": {"userName": "alice.drt"}},"upsert": {}}
+{ "update" : {"_index":"accounts", "_id" : "drt1bob" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {ctx._source.userName = params.userName;}","lang": "painless","params": {"userName": ""}},"upsert": {}}
+`
+	require.Equal(t, expectedBuff, buffSlice.Buffers()[0].String())
+}
@@ -0,0 +1,159 @@
+package usernames
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type usernamesProcessor struct {
+}
+
+type supersedingRegistration struct {
+	UserName  string `json:"userName"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// NewUsernamesProcessor will create a new instance of usernamesProcessor
+func NewUsernamesProcessor() (*usernamesProcessor, error) {
+	return &usernamesProcessor{}, nil
+}
+
+// ExtractUsernames will extract the usernames registered in a block. A username is registered by a SetUserName call on
+// the account that receives it, which is usually a smart contract result sent by the DNS contract, so only the calls
+// executed in the shard of the account are taken into account.
+func (up *usernamesProcessor) ExtractUsernames(preparedResults *data.PreparedResults, timestamp uint64, selfShardID uint32) []*data.Username {
+	usernamesMap := make(map[string]*data.Username)
+	if preparedResults == nil {
+		return make([]*data.Username, 0)
+	}
+
+	for _, tx := range preparedResults.Transactions {
+		isExecuted := tx.ReceiverShard == selfShardID && tx.Status == transaction.TxStatusSuccess.String()
+		if !isExecuted {
+			continue
+		}
+
+		addUsername(usernamesMap, tx.Data, tx.Receiver, tx.Hash, selfShardID, timestamp)
+	}
+
+	for _, scr := range preparedResults.ScResults {
+		isExecuted := scr.ReceiverShard == selfShardID && scr.Status != transaction.TxStatusFail.String()
+		if !isExecuted {
+			continue
+		}
+
+		txHash := scr.OriginalTxHash
+		if txHash == "" {
+			txHash = scr.Hash
+		}
+		addUsername(usernamesMap, scr.Data, scr.Receiver, txHash, selfShardID, timestamp)
+	}
+
+	usernames := make([]*data.Username, 0, len(usernamesMap))
+	for _, username := range usernamesMap {
+		usernames = append(usernames, username)
+	}
+	sort.Slice(usernames, func(i, j int) bool {
+		return usernames[i].UserName < usernames[j].UserName
+	})
+
+	return usernames
+}
+
+func addUsername(usernamesMap map[string]*data.Username, txData []byte, address string, txHash string, shardID uint32, timestamp uint64) {
+	userName := extractUserNameFromData(txData)
+	if userName == "" || address == "" {
+		return
+	}
+
+	usernamesMap[userName] = &data.Username{
+		UserName:  userName,
+		Address:   address,
+		TxHash:    txHash,
+		ShardID:   shardID,
+		Timestamp: time.Duration(timestamp),
+		History: []*data.UsernameChange{
+			{
+				Address:   address,
+				TxHash:    txHash,
+				ShardID:   shardID,
+				Timestamp: time.Duration(timestamp),
+			},
+		},
+	}
+}
+
+// RevertUsernames will remove from the provided usernames documents the registrations of the reverted block. The current
+// owner of a username is moved back to its latest remaining registration, and a username without registrations is
+// left with an empty history, so it can be removed. The usernames superseded in the reverted block are restored. The
+// returned map holds the username that every account affected by the revert owns after it, empty if none.
+func (up *usernamesProcessor) RevertUsernames(usernames []*data.Username, timestamp uint64, shardID uint32) map[string]string {
+	accountsUsernames := make(map[string]string)
+	for _, username := range usernames {
+		history := make([]*data.UsernameChange, 0, len(username.History))
+		for _, change := range username.History {
+			isReverted := uint64(change.Timestamp) == timestamp && change.ShardID == shardID
+			if isReverted {
+				accountsUsernames[change.Address] = ""
+				continue
+			}
+
+			history = append(history, change)
+		}
+
+		username.History = history
+		setCurrentRegistration(username)
+	}
+
+	for _, username := range usernames {
+		isSupersededInRevertedBlock := uint64(username.SupersededTimestamp) == timestamp && username.ShardID == shardID
+		if !isSupersededInRevertedBlock || len(username.History) == 0 {
+			continue
+		}
+
+		username.SupersededBy = ""
+		username.SupersededTimestamp = 0
+		accountsUsernames[username.Address] = username.UserName
+	}
+
+	return accountsUsernames
+}
+
+func setCurrentRegistration(username *data.Username) {
+	var current *data.UsernameChange
+	for _, change := range username.History {
+		if current == nil || change.Timestamp >= current.Timestamp {
+			current = change
+		}
+	}
+	if current == nil {
+		return
+	}
+
+	username.Address = current.Address
+	username.TxHash = current.TxHash
+	username.ShardID = current.ShardID
+	username.Timestamp = current.Timestamp
+}
+
+// SetUserName@<hex encoded username>
+func extractUserNameFromData(txData []byte) string {
+	splitData := strings.Split(string(txData), data.AtSeparator)
+	if len(splitData) < 2 || splitData[0] != core.BuiltInFunctionSetUserName {
+		return ""
+	}
+
+	userName, err := hex.DecodeString(splitData[1])
+	if err != nil {
+		return ""
+	}
+
+	return string(userName)
+}
@@ -0,0 +1,119 @@
+package usernames
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func setUserNameData(userName string) []byte {
+	return []byte("SetUserName@" + hex.EncodeToString([]byte(userName)))
+}
+
+func TestUsernamesProcessor_ExtractUsernames(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Receiver: "drt1alice", ReceiverShard: 1, Status: "success", Data: setUserNameData("alice.drt")},
+			{Hash: "tx2", Receiver: "drt1bob", ReceiverShard: 1, Status: "fail", Data: setUserNameData("bob.drt")},
+			{Hash: "tx3", Receiver: "drt1carol", ReceiverShard: 0, Status: "pending", Data: setUserNameData("carol.drt")},
+			{Hash: "tx4", Receiver: "drt1dan", ReceiverShard: 1, Status: "success", Data: []byte("transfer@01")},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "scr1", OriginalTxHash: "tx5", Receiver: "drt1erin", ReceiverShard: 1, Data: setUserNameData("erin.drt")},
+			{Hash: "scr2", OriginalTxHash: "tx6", Receiver: "drt1frank", ReceiverShard: 1, Status: "fail", Data: setUserNameData("frank.drt")},
+			{Hash: "scr3", Receiver: "drt1grace", ReceiverShard: 1, Data: []byte("SetUserName@zz")},
+		},
+	}
+
+	usernames := up.ExtractUsernames(preparedResults, 5000, 1)
+	require.Equal(t, []*data.Username{
+		{
+			UserName:  "alice.drt",
+			Address:   "drt1alice",
+			TxHash:    "tx1",
+			ShardID:   1,
+			Timestamp: 5000,
+			History:   []*data.UsernameChange{{Address: "drt1alice", TxHash: "tx1", ShardID: 1, Timestamp: 5000}},
+		},
+		{
+			UserName:  "erin.drt",
+			Address:   "drt1erin",
+			TxHash:    "tx5",
+			ShardID:   1,
+			Timestamp: 5000,
+			History:   []*data.UsernameChange{{Address: "drt1erin", TxHash: "tx5", ShardID: 1, Timestamp: 5000}},
+		},
+	}, usernames)
+}
+
+func TestUsernamesProcessor_ExtractUsernamesNilResults(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+	require.Empty(t, up.ExtractUsernames(nil, 5000, 1))
+}
+
+func TestUsernamesProcessor_RevertUsernames(t *testing.T) {
+	t.Parallel()
+
+	up, _ := NewUsernamesProcessor()
+
+	usernames := []*data.Username{
+		{
+			UserName:  "alice.drt",
+			Address:   "drt1alice",
+			TxHash:    "tx2",
+			ShardID:   1,
+			Timestamp: 6000,
+			History:   []*data.UsernameChange{{Address: "drt1alice", TxHash: "tx2", ShardID: 1, Timestamp: 6000}},
+		},
+		{
+			UserName:            "old.drt",
+			Address:             "drt1alice",
+			TxHash:              "tx1",
+			ShardID:             1,
+			Timestamp:           5000,
+			SupersededBy:        "alice.drt",
+			SupersededTimestamp: 6000,
+			History:             []*data.UsernameChange{{Address: "drt1alice", TxHash: "tx1", ShardID: 1, Timestamp: 5000}},
+		},
+		{
+			UserName:  "bob.drt",
+			Address:   "drt1carol",
+			TxHash:    "tx4",
+			ShardID:   1,
+			Timestamp: 6000,
+			History: []*data.UsernameChange{
+				{Address: "drt1bob", TxHash: "tx3", ShardID: 0, Timestamp: 4000},
+				{Address: "drt1carol", TxHash: "tx4", ShardID: 1, Timestamp: 6000},
+			},
+		},
+	}
+
+	accountsUsernames := up.RevertUsernames(usernames, 6000, 1)
+	require.Equal(t, map[string]string{"drt1alice": "old.drt", "drt1carol": ""}, accountsUsernames)
+	require.Empty(t, usernames[0].History)
+	require.Equal(t, &data.Username{
+		UserName:  "old.drt",
+		Address:   "drt1alice",
+		TxHash:    "tx1",
+		ShardID:   1,
+		Timestamp: 5000,
+		History:   []*data.UsernameChange{{Address: "drt1alice", TxHash: "tx1", ShardID: 1, Timestamp: 5000}},
+	}, usernames[1])
+	require.Equal(t, &data.Username{
+		UserName:  "bob.drt",
+		Address:   "drt1bob",
+		TxHash:    "tx3",
+		ShardID:   0,
+		Timestamp: 4000,
+		History:   []*data.UsernameChange{{Address: "drt1bob", TxHash: "tx3", ShardID: 0, Timestamp: 4000}},
+	}, usernames[2])
+}
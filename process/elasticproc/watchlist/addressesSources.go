@@ -0,0 +1,91 @@
+package watchlist
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+const (
+	commentPrefix        = "#"
+	matchAllAddressQuery = `{"query": {"match_all": {}}}`
+)
+
+// fileAddressesSource reads the watched addresses from a file that holds an address per line. The empty lines and the
+// lines starting with "#" are ignored. The file is read again only if it was modified since the last load
+type fileAddressesSource struct {
+	filePath     string
+	lastModified time.Time
+}
+
+func (fas *fileAddressesSource) loadAddresses() (map[string]struct{}, bool, error) {
+	fileInfo, err := os.Stat(fas.filePath)
+	if err != nil {
+		return nil, false, err
+	}
+	if fileInfo.ModTime().Equal(fas.lastModified) {
+		return nil, false, nil
+	}
+
+	file, err := os.Open(fas.filePath)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	addresses := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+
+		addresses[line] = struct{}{}
+	}
+	err = scanner.Err()
+	if err != nil {
+		return nil, false, err
+	}
+
+	fas.lastModified = fileInfo.ModTime()
+
+	return addresses, true, nil
+}
+
+// indexAddressesSource reads the watched addresses from an Elasticsearch index, where the ID of every document is an address
+type indexAddressesSource struct {
+	dbClient DatabaseClientHandler
+	index    string
+}
+
+func (ias *indexAddressesSource) loadAddresses() (map[string]struct{}, bool, error) {
+	addresses := make(map[string]struct{})
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			addresses[hit.ID] = struct{}{}
+		}
+
+		return nil
+	}
+
+	err := ias.dbClient.DoScrollRequest(context.Background(), ias.index, []byte(matchAllAddressQuery), false, handlerFunc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return addresses, true, nil
+}
@@ -0,0 +1,28 @@
+package watchlist
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+type disabledAddressesFilter struct{}
+
+// NewDisabledAddressesFilter will create a new instance of disabledAddressesFilter
+func NewDisabledAddressesFilter() *disabledAddressesFilter {
+	return &disabledAddressesFilter{}
+}
+
+// FilterTransactionsData does nothing
+func (daf *disabledAddressesFilter) FilterTransactionsData(_ *data.PreparedResults, _ *data.PreparedLogsResults) {
+}
+
+// FilterAlteredAccounts returns the provided altered accounts
+func (daf *disabledAddressesFilter) FilterAlteredAccounts(alteredAccounts map[string]*alteredAccount.AlteredAccount) map[string]*alteredAccount.AlteredAccount {
+	return alteredAccounts
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (daf *disabledAddressesFilter) IsInterfaceNil() bool {
+	return daf == nil
+}
@@ -0,0 +1,6 @@
+package watchlist
+
+import "errors"
+
+// ErrInvalidAddressesSource signals that the source of the watched addresses is not properly configured
+var ErrInvalidAddressesSource = errors.New("invalid watched addresses source")
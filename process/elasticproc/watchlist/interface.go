@@ -0,0 +1,15 @@
+package watchlist
+
+import (
+	"context"
+)
+
+// DatabaseClientHandler defines the actions that the watched addresses index source needs from the database client
+type DatabaseClientHandler interface {
+	DoScrollRequest(ctx context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
+	IsInterfaceNil() bool
+}
+
+type addressesSource interface {
+	loadAddresses() (map[string]struct{}, bool, error)
+}
@@ -0,0 +1,334 @@
+package watchlist
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+	"github.com/TerraDharitri/drt-go-chain-core/data/outport"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+)
+
+var log = logger.GetOrCreate("indexer/process/watchlist")
+
+const (
+	// FileSource is the source of the watched addresses that reads them from a file
+	FileSource = "file"
+	// IndexSource is the source of the watched addresses that reads them from an Elasticsearch index
+	IndexSource = "index"
+
+	customEventTxHashField         = "txHash"
+	customEventOriginalTxHashField = "originalTxHash"
+	customEventAddressField        = "address"
+)
+
+// ArgsWatchedAddressesFilter holds all the components needed to create a new watched addresses filter
+type ArgsWatchedAddressesFilter struct {
+	Source         string
+	FilePath       string
+	Index          string
+	DBClient       DatabaseClientHandler
+	ReloadInterval time.Duration
+}
+
+type watchedAddressesFilter struct {
+	source         addressesSource
+	reloadInterval time.Duration
+
+	mut        sync.RWMutex
+	addresses  map[string]struct{}
+	nextReload time.Time
+	timeNow    func() time.Time
+}
+
+// NewWatchedAddressesFilter will create a new instance of watchedAddressesFilter. The watched addresses are loaded
+// when the filter is created and reloaded, at most once per reload interval, before filtering the data of a block
+func NewWatchedAddressesFilter(args ArgsWatchedAddressesFilter) (*watchedAddressesFilter, error) {
+	source, err := createAddressesSource(args)
+	if err != nil {
+		return nil, err
+	}
+
+	waf := &watchedAddressesFilter{
+		source:         source,
+		reloadInterval: args.ReloadInterval,
+		addresses:      make(map[string]struct{}),
+		timeNow:        time.Now,
+	}
+
+	addresses, _, err := source.loadAddresses()
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the watched addresses", err)
+	}
+	waf.setAddresses(addresses)
+
+	return waf, nil
+}
+
+func createAddressesSource(args ArgsWatchedAddressesFilter) (addressesSource, error) {
+	switch args.Source {
+	case FileSource:
+		if args.FilePath == "" {
+			return nil, fmt.Errorf("%w: empty file path", ErrInvalidAddressesSource)
+		}
+		return &fileAddressesSource{filePath: args.FilePath}, nil
+	case IndexSource:
+		if args.Index == "" {
+			return nil, fmt.Errorf("%w: empty index", ErrInvalidAddressesSource)
+		}
+		if check.IfNil(args.DBClient) {
+			return nil, dataindexer.ErrNilDatabaseClient
+		}
+		return &indexAddressesSource{dbClient: args.DBClient, index: args.Index}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown source %s", ErrInvalidAddressesSource, args.Source)
+	}
+}
+
+// FilterTransactionsData keeps only the data of the transactions that involve the watched addresses. A transaction is
+// kept with all its smart contract results, receipts, logs and events if any of them involves a watched address, so
+// the indexed transactions stay complete
+func (waf *watchedAddressesFilter) FilterTransactionsData(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults) {
+	if preparedResults == nil || logsData == nil {
+		return
+	}
+
+	waf.reloadIfNeeded()
+
+	waf.mut.RLock()
+	defer waf.mut.RUnlock()
+
+	txHashes := waf.getWatchedTxHashes(preparedResults, logsData)
+
+	transactions := make([]*data.Transaction, 0)
+	for _, tx := range preparedResults.Transactions {
+		if isTxHashWatched(txHashes, tx.Hash, "") {
+			transactions = append(transactions, tx)
+		}
+	}
+	preparedResults.Transactions = transactions
+
+	scrs := make([]*data.ScResult, 0)
+	for _, scr := range preparedResults.ScResults {
+		if isTxHashWatched(txHashes, scr.Hash, scr.OriginalTxHash) {
+			scrs = append(scrs, scr)
+		}
+	}
+	preparedResults.ScResults = scrs
+
+	receipts := make([]*data.Receipt, 0)
+	for _, receipt := range preparedResults.Receipts {
+		if isTxHashWatched(txHashes, receipt.TxHash, "") {
+			receipts = append(receipts, receipt)
+		}
+	}
+	preparedResults.Receipts = receipts
+
+	for txHash := range preparedResults.TxHashFee {
+		if !isTxHashWatched(txHashes, txHash, "") {
+			delete(preparedResults.TxHashFee, txHash)
+		}
+	}
+
+	waf.filterLogsData(txHashes, logsData)
+}
+
+func (waf *watchedAddressesFilter) getWatchedTxHashes(preparedResults *data.PreparedResults, logsData *data.PreparedLogsResults) map[string]struct{} {
+	txHashes := make(map[string]struct{})
+	for _, tx := range preparedResults.Transactions {
+		if waf.isWatched(tx.Sender) || waf.isWatched(tx.Receiver) || waf.isAnyWatched(tx.Receivers) {
+			txHashes[tx.Hash] = struct{}{}
+		}
+	}
+	for _, scr := range preparedResults.ScResults {
+		if waf.isWatched(scr.Sender) || waf.isWatched(scr.Receiver) || waf.isWatched(scr.OriginalSender) || waf.isAnyWatched(scr.Receivers) {
+			addTxHashes(txHashes, scr.Hash, scr.OriginalTxHash)
+		}
+	}
+	for _, dbLog := range logsData.DBLogs {
+		if waf.isWatched(dbLog.Address) {
+			addTxHashes(txHashes, dbLog.ID, dbLog.OriginalTxHash)
+		}
+	}
+	for _, event := range logsData.DBEvents {
+		if waf.isWatched(event.Address) {
+			addTxHashes(txHashes, event.TxHash, event.OriginalTxHash)
+		}
+	}
+
+	return txHashes
+}
+
+func (waf *watchedAddressesFilter) filterLogsData(txHashes map[string]struct{}, logsData *data.PreparedLogsResults) {
+	dbLogs := make([]*data.Logs, 0)
+	for _, dbLog := range logsData.DBLogs {
+		if isTxHashWatched(txHashes, dbLog.ID, dbLog.OriginalTxHash) {
+			dbLogs = append(dbLogs, dbLog)
+		}
+	}
+	logsData.DBLogs = dbLogs
+
+	events := make([]*data.LogEvent, 0)
+	for _, event := range logsData.DBEvents {
+		if isTxHashWatched(txHashes, event.TxHash, event.OriginalTxHash) {
+			events = append(events, event)
+		}
+	}
+	logsData.DBEvents = events
+
+	customEvents := make([]*data.CustomEvent, 0)
+	for _, customEvent := range logsData.CustomEvents {
+		if waf.isCustomEventWatched(txHashes, customEvent) {
+			customEvents = append(customEvents, customEvent)
+		}
+	}
+	logsData.CustomEvents = customEvents
+
+	logsData.TxHashStatusInfo = filterStatusInfo(txHashes, logsData.TxHashStatusInfo)
+
+	for key, delegator := range logsData.Delegators {
+		if !waf.isWatched(delegator.Address) && !waf.isWatched(delegator.Contract) {
+			delete(logsData.Delegators, key)
+		}
+	}
+
+	for key, provider := range logsData.Providers {
+		if !waf.isWatched(provider.Address) && !waf.isWatched(provider.Owner) {
+			delete(logsData.Providers, key)
+		}
+	}
+
+	for key, node := range logsData.Nodes {
+		if !waf.isWatched(node.Owner) && !waf.isWatched(node.Provider) {
+			delete(logsData.Nodes, key)
+		}
+	}
+}
+
+// a custom event is kept if it was logged by a watched transaction or by a watched contract. The custom events without
+// a transaction hash or an address cannot be linked to the watched addresses, so they are skipped
+func (waf *watchedAddressesFilter) isCustomEventWatched(txHashes map[string]struct{}, customEvent *data.CustomEvent) bool {
+	txHash := getCustomEventField(customEvent, customEventTxHashField)
+	originalTxHash := getCustomEventField(customEvent, customEventOriginalTxHashField)
+	if txHash != "" && isTxHashWatched(txHashes, txHash, originalTxHash) {
+		return true
+	}
+
+	return waf.isWatched(getCustomEventField(customEvent, customEventAddressField))
+}
+
+func getCustomEventField(customEvent *data.CustomEvent, field string) string {
+	value, ok := customEvent.Fields[field].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// FilterAlteredAccounts returns only the altered accounts of the watched addresses, which hold their balances and tokens
+func (waf *watchedAddressesFilter) FilterAlteredAccounts(alteredAccounts map[string]*alteredAccount.AlteredAccount) map[string]*alteredAccount.AlteredAccount {
+	waf.reloadIfNeeded()
+
+	waf.mut.RLock()
+	defer waf.mut.RUnlock()
+
+	watchedAccounts := make(map[string]*alteredAccount.AlteredAccount)
+	for address, account := range alteredAccounts {
+		if waf.isWatched(address) {
+			watchedAccounts[address] = account
+		}
+	}
+
+	return watchedAccounts
+}
+
+// a failed reload keeps the previous addresses, so the indexing is not stopped by a temporary issue of the source
+func (waf *watchedAddressesFilter) reloadIfNeeded() {
+	waf.mut.RLock()
+	shouldReload := !waf.timeNow().Before(waf.nextReload)
+	waf.mut.RUnlock()
+	if !shouldReload {
+		return
+	}
+
+	addresses, changed, err := waf.source.loadAddresses()
+	if err != nil {
+		log.Warn("watchedAddressesFilter: cannot reload the watched addresses, keeping the previous ones", "error", err)
+	}
+	if err != nil || !changed {
+		waf.mut.Lock()
+		waf.nextReload = waf.timeNow().Add(waf.reloadInterval)
+		waf.mut.Unlock()
+		return
+	}
+
+	waf.setAddresses(addresses)
+}
+
+func (waf *watchedAddressesFilter) setAddresses(addresses map[string]struct{}) {
+	waf.mut.Lock()
+	defer waf.mut.Unlock()
+
+	if addresses != nil {
+		waf.addresses = addresses
+		log.Info("watchedAddressesFilter: loaded the watched addresses", "num addresses", len(addresses))
+	}
+	waf.nextReload = waf.timeNow().Add(waf.reloadInterval)
+}
+
+func (waf *watchedAddressesFilter) isWatched(address string) bool {
+	_, found := waf.addresses[address]
+	return found
+}
+
+func (waf *watchedAddressesFilter) isAnyWatched(addresses []string) bool {
+	for _, address := range addresses {
+		if waf.isWatched(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addTxHashes(txHashes map[string]struct{}, hash string, originalTxHash string) {
+	txHashes[hash] = struct{}{}
+	if originalTxHash != "" {
+		txHashes[originalTxHash] = struct{}{}
+	}
+}
+
+func isTxHashWatched(txHashes map[string]struct{}, hash string, originalTxHash string) bool {
+	_, found := txHashes[hash]
+	if found {
+		return true
+	}
+	if originalTxHash == "" {
+		return false
+	}
+
+	_, found = txHashes[originalTxHash]
+	return found
+}
+
+func filterStatusInfo(txHashes map[string]struct{}, statusInfo map[string]*outport.StatusInfo) map[string]*outport.StatusInfo {
+	watchedStatusInfo := make(map[string]*outport.StatusInfo)
+	for txHash, info := range statusInfo {
+		if isTxHashWatched(txHashes, txHash, "") {
+			watchedStatusInfo[txHash] = info
+		}
+	}
+
+	return watchedStatusInfo
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (waf *watchedAddressesFilter) IsInterfaceNil() bool {
+	return waf == nil
+}
@@ -0,0 +1,271 @@
+package watchlist
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/alteredAccount"
+	"github.com/TerraDharitri/drt-go-chain-core/data/outport"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
+)
+
+func writeAddressesFile(t *testing.T, filePath string, content string, modTime time.Time) {
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(t, err)
+	err = os.Chtimes(filePath, modTime, modTime)
+	require.Nil(t, err)
+}
+
+func createFileFilter(t *testing.T, content string) (*watchedAddressesFilter, string) {
+	filePath := filepath.Join(t.TempDir(), "watched.txt")
+	writeAddressesFile(t, filePath, content, time.Unix(1000, 0))
+
+	waf, err := NewWatchedAddressesFilter(ArgsWatchedAddressesFilter{
+		Source:         FileSource,
+		FilePath:       filePath,
+		ReloadInterval: time.Minute,
+	})
+	require.Nil(t, err)
+
+	return waf, filePath
+}
+
+func TestNewWatchedAddressesFilter(t *testing.T) {
+	t.Parallel()
+
+	waf, err := NewWatchedAddressesFilter(ArgsWatchedAddressesFilter{Source: "unknown"})
+	require.Nil(t, waf)
+	require.True(t, errors.Is(err, ErrInvalidAddressesSource))
+
+	waf, err = NewWatchedAddressesFilter(ArgsWatchedAddressesFilter{Source: FileSource})
+	require.Nil(t, waf)
+	require.True(t, errors.Is(err, ErrInvalidAddressesSource))
+
+	waf, err = NewWatchedAddressesFilter(ArgsWatchedAddressesFilter{Source: IndexSource})
+	require.Nil(t, waf)
+	require.True(t, errors.Is(err, ErrInvalidAddressesSource))
+
+	waf, err = NewWatchedAddressesFilter(ArgsWatchedAddressesFilter{Source: IndexSource, Index: "watched"})
+	require.Nil(t, waf)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	waf, err = NewWatchedAddressesFilter(ArgsWatchedAddressesFilter{Source: FileSource, FilePath: filepath.Join(t.TempDir(), "missing.txt")})
+	require.Nil(t, waf)
+	require.NotNil(t, err)
+
+	waf, _ = createFileFilter(t, "# partners\naddr1\n\n  addr2  \n")
+	require.False(t, waf.IsInterfaceNil())
+	require.Equal(t, map[string]struct{}{"addr1": {}, "addr2": {}}, waf.addresses)
+}
+
+func TestWatchedAddressesFilter_IndexSource(t *testing.T) {
+	t.Parallel()
+
+	waf, err := NewWatchedAddressesFilter(ArgsWatchedAddressesFilter{
+		Source: IndexSource,
+		Index:  "watched",
+		DBClient: &mock.DatabaseWriterStub{
+			DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+				require.Equal(t, "watched", index)
+				require.False(t, withSource)
+				return handlerFunc([]byte(`{"hits": {"hits": [{"_id": "addr1"}, {"_id": "addr2"}]}}`))
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, map[string]struct{}{"addr1": {}, "addr2": {}}, waf.addresses)
+}
+
+func TestWatchedAddressesFilter_FilterTransactionsData(t *testing.T) {
+	t.Parallel()
+
+	waf, _ := createFileFilter(t, "watched\ncontract")
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Sender: "watched", Receiver: "other"},
+			{Hash: "tx2", Sender: "other", Receiver: "other"},
+			{Hash: "tx3", Sender: "other", Receiver: "other"},
+			{Hash: "tx4", Sender: "other", Receiver: "other", Receivers: []string{"contract"}},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "scr1", OriginalTxHash: "tx1", Sender: "other", Receiver: "other"},
+			{Hash: "scr2", OriginalTxHash: "tx2", Sender: "other", Receiver: "other"},
+			{Hash: "scr3", OriginalTxHash: "tx3", Sender: "contract", Receiver: "other"},
+		},
+		Receipts: []*data.Receipt{
+			{Hash: "r1", TxHash: "tx1"},
+			{Hash: "r2", TxHash: "tx2"},
+		},
+		TxHashFee: map[string]*data.FeeData{
+			"tx1": {},
+			"tx2": {},
+		},
+	}
+	logsData := &data.PreparedLogsResults{
+		DBLogs: []*data.Logs{
+			{ID: "tx2", Address: "other"},
+			{ID: "scr5", OriginalTxHash: "tx5", Address: "contract"},
+		},
+		DBEvents: []*data.LogEvent{
+			{TxHash: "tx1", Address: "other"},
+			{TxHash: "tx2", Address: "other"},
+			{TxHash: "scr5", OriginalTxHash: "tx5", Address: "contract"},
+		},
+		TxHashStatusInfo: map[string]*outport.StatusInfo{
+			"tx1": {},
+			"tx2": {},
+		},
+		Delegators: map[string]*data.Delegator{
+			"d1": {Address: "watched", Contract: "staking"},
+			"d2": {Address: "other", Contract: "contract"},
+			"d3": {Address: "other", Contract: "staking"},
+		},
+		Providers: map[string]*data.StakingProvider{
+			"contract": {Address: "contract", Owner: "other"},
+			"p2":       {Address: "p2", Owner: "watched"},
+			"p3":       {Address: "p3", Owner: "other"},
+		},
+		Nodes: map[string]*data.StakingNode{
+			"bls1": {BLSKey: "bls1", Owner: "watched"},
+			"bls2": {BLSKey: "bls2", Owner: "other", Provider: "contract"},
+			"bls3": {BLSKey: "bls3", Owner: "other", Provider: "p3"},
+			"bls4": {BLSKey: "bls4", Owner: "other"},
+		},
+	}
+
+	waf.FilterTransactionsData(preparedResults, logsData)
+
+	require.Equal(t, []*data.Transaction{
+		{Hash: "tx1", Sender: "watched", Receiver: "other"},
+		{Hash: "tx3", Sender: "other", Receiver: "other"},
+		{Hash: "tx4", Sender: "other", Receiver: "other", Receivers: []string{"contract"}},
+	}, preparedResults.Transactions)
+	require.Equal(t, []*data.ScResult{
+		{Hash: "scr1", OriginalTxHash: "tx1", Sender: "other", Receiver: "other"},
+		{Hash: "scr3", OriginalTxHash: "tx3", Sender: "contract", Receiver: "other"},
+	}, preparedResults.ScResults)
+	require.Equal(t, []*data.Receipt{{Hash: "r1", TxHash: "tx1"}}, preparedResults.Receipts)
+	require.Equal(t, map[string]*data.FeeData{"tx1": {}}, preparedResults.TxHashFee)
+
+	require.Equal(t, []*data.Logs{{ID: "scr5", OriginalTxHash: "tx5", Address: "contract"}}, logsData.DBLogs)
+	require.Equal(t, []*data.LogEvent{
+		{TxHash: "tx1", Address: "other"},
+		{TxHash: "scr5", OriginalTxHash: "tx5", Address: "contract"},
+	}, logsData.DBEvents)
+	require.Equal(t, map[string]*outport.StatusInfo{"tx1": {}}, logsData.TxHashStatusInfo)
+	require.Len(t, logsData.Delegators, 2)
+	require.NotNil(t, logsData.Delegators["d1"])
+	require.NotNil(t, logsData.Delegators["d2"])
+	require.Len(t, logsData.Providers, 2)
+	require.NotNil(t, logsData.Providers["contract"])
+	require.NotNil(t, logsData.Providers["p2"])
+	require.Len(t, logsData.Nodes, 2)
+	require.NotNil(t, logsData.Nodes["bls1"])
+	require.NotNil(t, logsData.Nodes["bls2"])
+}
+
+func TestWatchedAddressesFilter_FilterTransactionsDataCustomEvents(t *testing.T) {
+	t.Parallel()
+
+	waf, _ := createFileFilter(t, "watched\ncontract")
+
+	registry, err := logsevents.NewCustomEventsRegistry(logsevents.ArgsCustomEventsRegistry{
+		PubKeyConverter: mock.NewPubkeyConverterMock(32),
+		Rules: []logsevents.CustomEventRule{
+			{Name: "swaps", Identifier: "swap", Index: "swaps", Topics: []logsevents.CustomEventTopic{{Name: "amount", Decoder: "bigint"}}},
+		},
+	})
+	require.Nil(t, err)
+	ruleProcessor := registry.GetProcessors()[0]
+
+	event := &transaction.Event{Identifier: []byte("swap"), Topics: [][]byte{{1}}}
+	customEvents := make([]*data.CustomEvent, 0)
+	customEvents = append(customEvents, ruleProcessor.ProcessEvent(event, &data.LogEvent{ID: "tx1-0-0", TxHash: "tx1", Address: "other", Identifier: "swap"})...)
+	customEvents = append(customEvents, ruleProcessor.ProcessEvent(event, &data.LogEvent{ID: "tx2-0-0", TxHash: "tx2", Address: "other", Identifier: "swap"})...)
+	customEvents = append(customEvents, ruleProcessor.ProcessEvent(event, &data.LogEvent{ID: "scr3-0-0", TxHash: "scr3", OriginalTxHash: "tx3", Address: "other", Identifier: "swap"})...)
+	customEvents = append(customEvents, ruleProcessor.ProcessEvent(event, &data.LogEvent{ID: "tx4-0-0", TxHash: "tx4", Address: "contract", Identifier: "swap"})...)
+	customEvents = append(customEvents, &data.CustomEvent{Index: "plugin", ID: "doc", Fields: map[string]interface{}{"amount": "1"}})
+	require.Len(t, customEvents, 5)
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Sender: "watched", Receiver: "other"},
+			{Hash: "tx2", Sender: "other", Receiver: "other"},
+			{Hash: "tx3", Sender: "other", Receiver: "watched"},
+		},
+	}
+	logsData := &data.PreparedLogsResults{
+		CustomEvents: customEvents,
+	}
+
+	waf.FilterTransactionsData(preparedResults, logsData)
+
+	require.Len(t, logsData.CustomEvents, 3)
+	require.Equal(t, "tx1-0-0", logsData.CustomEvents[0].ID)
+	require.Equal(t, "scr3-0-0", logsData.CustomEvents[1].ID)
+	require.Equal(t, "tx4-0-0", logsData.CustomEvents[2].ID)
+}
+
+func TestWatchedAddressesFilter_FilterAlteredAccounts(t *testing.T) {
+	t.Parallel()
+
+	waf, _ := createFileFilter(t, "watched")
+
+	filtered := waf.FilterAlteredAccounts(map[string]*alteredAccount.AlteredAccount{
+		"watched": {Address: "watched"},
+		"other":   {Address: "other"},
+	})
+	require.Equal(t, map[string]*alteredAccount.AlteredAccount{"watched": {Address: "watched"}}, filtered)
+}
+
+func TestWatchedAddressesFilter_Reload(t *testing.T) {
+	t.Parallel()
+
+	waf, filePath := createFileFilter(t, "addr1")
+	currentTime := time.Now()
+	waf.timeNow = func() time.Time {
+		return currentTime
+	}
+	waf.nextReload = currentTime.Add(time.Minute)
+
+	writeAddressesFile(t, filePath, "addr2", time.Unix(2000, 0))
+	accounts := map[string]*alteredAccount.AlteredAccount{"addr1": {}, "addr2": {}}
+	require.Len(t, waf.FilterAlteredAccounts(accounts), 1)
+	require.NotNil(t, waf.FilterAlteredAccounts(accounts)["addr1"])
+
+	// the reload interval passed, the modified file is read again
+	currentTime = currentTime.Add(time.Minute)
+	require.NotNil(t, waf.FilterAlteredAccounts(accounts)["addr2"])
+	require.Nil(t, waf.FilterAlteredAccounts(accounts)["addr1"])
+
+	// a failed reload keeps the previous addresses
+	require.Nil(t, os.Remove(filePath))
+	currentTime = currentTime.Add(time.Minute)
+	filtered := waf.FilterAlteredAccounts(accounts)
+	require.Len(t, filtered, 1)
+	require.NotNil(t, filtered["addr2"])
+}
+
+func TestDisabledAddressesFilter(t *testing.T) {
+	t.Parallel()
+
+	daf := NewDisabledAddressesFilter()
+	require.False(t, daf.IsInterfaceNil())
+
+	preparedResults := &data.PreparedResults{Transactions: []*data.Transaction{{Hash: "tx1"}}}
+	daf.FilterTransactionsData(preparedResults, &data.PreparedLogsResults{})
+	require.Len(t, preparedResults.Transactions, 1)
+
+	accounts := map[string]*alteredAccount.AlteredAccount{"addr1": {}}
+	require.Equal(t, accounts, daf.FilterAlteredAccounts(accounts))
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
-	"github.com/TerraDharitri/drt-go-chain-core/data/block"
 	"github.com/TerraDharitri/drt-go-chain-core/hashing"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
@@ -19,7 +18,9 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/factory/runType"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/abi"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/factory"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/elasticproc/logsevents"
 )
 
 var log = logger.GetOrCreate("indexer/factory")
@@ -27,27 +28,38 @@ var log = logger.GetOrCreate("indexer/factory")
 // ArgsIndexerFactory holds all dependencies required by the data indexer factory in order to create
 // new instances
 type ArgsIndexerFactory struct {
-	Enabled                  bool
-	UseKibana                bool
-	ImportDB                 bool
-	Sovereign                bool
-	DCDTPrefix               string
-	MainChainElastic         factory.ElasticConfig
-	Denomination             int
-	BulkRequestMaxSize       int
-	Url                      string
-	UserName                 string
-	Password                 string
-	TemplatesPath            string
-	Version                  string
-	EnabledIndexes           []string
-	HeaderMarshaller         marshal.Marshalizer
-	Marshalizer              marshal.Marshalizer
-	Hasher                   hashing.Hasher
-	AddressPubkeyConverter   core.PubkeyConverter
-	ValidatorPubkeyConverter core.PubkeyConverter
-	StatusMetrics            indexerCore.StatusMetricsHandler
-	RunTypeComponents        runType.RunTypeComponentsHandler
+	Enabled                       bool
+	UseKibana                     bool
+	ImportDB                      bool
+	RunType                       string
+	IndexNamespace                string
+	DCDTPrefix                    string
+	MainChainElastic              factory.ElasticConfig
+	BridgeContractAddress         string
+	Denomination                  int
+	GuardianActivationEpochsDelay uint32
+	BulkRequestMaxSize            int
+	Url                           string
+	UserName                      string
+	Password                      string
+	TemplatesPath                 string
+	Version                       string
+	EnabledIndexes                []string
+	HeaderMarshaller              marshal.Marshalizer
+	Marshalizer                   marshal.Marshalizer
+	Hasher                        hashing.Hasher
+	AddressPubkeyConverter        core.PubkeyConverter
+	ValidatorPubkeyConverter      core.PubkeyConverter
+	SecondaryPubkeyConverter      core.PubkeyConverter
+	StatusMetrics                 indexerCore.StatusMetricsHandler
+	RunTypeComponents             runType.RunTypeComponentsHandler
+	DataPublisher                 elasticproc.DataPublisher
+	CustomEventsPlugins           []string
+	CustomEventRules              []logsevents.CustomEventRule
+	ContractAbis                  []abi.ContractAbi
+	WatchedAddresses              factory.WatchedAddressesConfig
+	FieldsProjection              []client.FieldsProjectionRule
+	PayloadsOffloading            factory.PayloadsOffloadingConfig
 }
 
 // NewIndexer will create a new instance of Indexer
@@ -57,21 +69,23 @@ func NewIndexer(args ArgsIndexerFactory) (dataindexer.Indexer, error) {
 		return nil, err
 	}
 
-	if args.Sovereign {
-		args.RunTypeComponents, err = createManagedRunTypeComponents(runType.NewSovereignRunTypeComponentsFactory(args.MainChainElastic, args.DCDTPrefix))
-	} else {
-		args.RunTypeComponents, err = createManagedRunTypeComponents(runType.NewRunTypeComponentsFactory())
-	}
+	runTypeComponentsFactory, err := runType.CreateRunTypeComponentsFactory(args.RunType, runType.ArgsRunTypeComponentsFactory{
+		MainChainElastic:       args.MainChainElastic,
+		DCDTPrefix:             args.DCDTPrefix,
+		BridgeContractAddress:  args.BridgeContractAddress,
+		AddressPubkeyConverter: args.AddressPubkeyConverter,
+		StatusMetrics:          args.StatusMetrics,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	elasticProcessor, err := createElasticProcessor(args)
+	args.RunTypeComponents, err = createManagedRunTypeComponents(runTypeComponentsFactory)
 	if err != nil {
 		return nil, err
 	}
 
-	blockContainer, err := createBlockCreatorsContainer()
+	elasticProcessor, err := createElasticProcessor(args)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +93,7 @@ func NewIndexer(args ArgsIndexerFactory) (dataindexer.Indexer, error) {
 	arguments := dataindexer.ArgDataIndexer{
 		HeaderMarshaller: args.HeaderMarshaller,
 		ElasticProcessor: elasticProcessor,
-		BlockContainer:   blockContainer,
+		BlockContainer:   args.RunTypeComponents.BlockCreatorsContainer(),
 	}
 
 	return dataindexer.NewDataIndexer(arguments)
@@ -96,6 +110,11 @@ func createManagedRunTypeComponents(factory runType.RunTypeComponentsCreator) (r
 		return nil, err
 	}
 
+	err = managedRunTypeComponents.CheckSubcomponents()
+	if err != nil {
+		return nil, err
+	}
+
 	return managedRunTypeComponents, nil
 }
 
@@ -106,20 +125,33 @@ func createElasticProcessor(args ArgsIndexerFactory) (dataindexer.ElasticProcess
 	}
 
 	argsElasticProcFac := factory.ArgElasticProcessorFactory{
-		Marshalizer:              args.Marshalizer,
-		Hasher:                   args.Hasher,
-		AddressPubkeyConverter:   args.AddressPubkeyConverter,
-		ValidatorPubkeyConverter: args.ValidatorPubkeyConverter,
-		UseKibana:                args.UseKibana,
-		DBClient:                 databaseClient,
-		Denomination:             args.Denomination,
-		EnabledIndexes:           args.EnabledIndexes,
-		BulkRequestMaxSize:       args.BulkRequestMaxSize,
-		ImportDB:                 args.ImportDB,
-		Version:                  args.Version,
-		TxHashExtractor:          args.RunTypeComponents.TxHashExtractorCreator(),
-		RewardTxData:             args.RunTypeComponents.RewardTxDataCreator(),
-		IndexTokensHandler:       args.RunTypeComponents.IndexTokensHandlerCreator(),
+		Marshalizer:                   args.Marshalizer,
+		Hasher:                        args.Hasher,
+		AddressPubkeyConverter:        args.AddressPubkeyConverter,
+		ValidatorPubkeyConverter:      args.ValidatorPubkeyConverter,
+		SecondaryPubkeyConverter:      args.SecondaryPubkeyConverter,
+		WatchedAddresses:              args.WatchedAddresses,
+		PayloadsOffloading:            args.PayloadsOffloading,
+		UseKibana:                     args.UseKibana,
+		DBClient:                      databaseClient,
+		Denomination:                  args.Denomination,
+		GuardianActivationEpochsDelay: args.GuardianActivationEpochsDelay,
+		EnabledIndexes:                args.EnabledIndexes,
+		SupportedIndexes:              args.RunTypeComponents.SupportedIndexes(),
+		BulkRequestMaxSize:            args.BulkRequestMaxSize,
+		ImportDB:                      args.ImportDB,
+		Version:                       args.Version,
+		TxHashExtractor:               args.RunTypeComponents.TxHashExtractorCreator(),
+		RewardTxData:                  args.RunTypeComponents.RewardTxDataCreator(),
+		IndexTokensHandler:            args.RunTypeComponents.IndexTokensHandlerCreator(),
+		BridgeTransfersHandler:        args.RunTypeComponents.BridgeTransfersHandlerCreator(),
+		BlockProcessorCreator:         args.RunTypeComponents.BlockProcessorCreator(),
+		AccountsProcessorCreator:      args.RunTypeComponents.AccountsProcessorCreator(),
+		EventsProcessorsCreator:       args.RunTypeComponents.EventsProcessorsCreator(),
+		DataPublisher:                 args.DataPublisher,
+		CustomEventsPlugins:           args.CustomEventsPlugins,
+		CustomEventRules:              args.CustomEventRules,
+		ContractAbis:                  args.ContractAbis,
 	}
 
 	return factory.CreateElasticProcessor(argsElasticProcFac)
@@ -135,17 +167,33 @@ func createElasticClient(args ArgsIndexerFactory) (elasticproc.DatabaseClientHan
 		RetryBackoff:  client.RetryBackOff,
 	}
 
-	if check.IfNil(args.StatusMetrics) {
-		return client.NewElasticClient(argsEsClient)
+	if !check.IfNil(args.StatusMetrics) {
+		transportMetrics, err := transport.NewMetricsTransport(args.StatusMetrics)
+		if err != nil {
+			return nil, err
+		}
+		argsEsClient.Transport = transportMetrics
 	}
 
-	transportMetrics, err := transport.NewMetricsTransport(args.StatusMetrics)
+	var esClient elasticproc.DatabaseClientHandler
+	esClient, err := client.NewElasticClient(argsEsClient)
 	if err != nil {
 		return nil, err
 	}
-	argsEsClient.Transport = transportMetrics
 
-	return client.NewElasticClient(argsEsClient)
+	if args.IndexNamespace != "" {
+		esClient, err = client.NewNamespacedElasticClient(esClient, args.IndexNamespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args.FieldsProjection) == 0 {
+		return esClient, nil
+	}
+
+	// the fields projection wraps the namespaced client, so the rules use the names of the indexes without the namespace
+	return client.NewFieldsProjectionElasticClient(esClient, args.Hasher, args.FieldsProjection)
 }
 
 func checkDataIndexerParams(arguments ArgsIndexerFactory) error {
@@ -167,28 +215,9 @@ func checkDataIndexerParams(arguments ArgsIndexerFactory) error {
 	if check.IfNil(arguments.HeaderMarshaller) {
 		return fmt.Errorf("%w: header marshaller", dataindexer.ErrNilMarshalizer)
 	}
-
-	return nil
-}
-
-func createBlockCreatorsContainer() (dataindexer.BlockContainerHandler, error) {
-	container := block.NewEmptyBlockCreatorsContainer()
-	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.SovereignChainHeader, block.NewEmptySovereignHeaderCreator())
-	if err != nil {
-		return nil, err
+	if check.IfNil(arguments.DataPublisher) {
+		return dataindexer.ErrNilDataPublisher
 	}
 
-	return container, nil
+	return nil
 }
@@ -4,8 +4,10 @@ import (
 	errorsGo "errors"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/client"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/mock"
 	"github.com/TerraDharitri/drt-go-chain-es-indexer/process/dataindexer"
 	"github.com/stretchr/testify/require"
@@ -26,6 +28,7 @@ func createMockIndexerFactoryArgs() ArgsIndexerFactory {
 		ValidatorPubkeyConverter: &mock.PubkeyConverterMock{},
 		TemplatesPath:            "../testdata",
 		EnabledIndexes:           []string{"blocks", "transactions", "miniblocks", "validators", "round", "accounts", "rating"},
+		DataPublisher:            &mock.DataPublisherStub{},
 	}
 }
 
@@ -89,6 +92,15 @@ func TestNewIndexerFactory(t *testing.T) {
 			},
 			exError: dataindexer.ErrNilUrl,
 		},
+		{
+			name: "NilDataPublisher",
+			argsFunc: func() ArgsIndexerFactory {
+				args := createMockIndexerFactoryArgs()
+				args.DataPublisher = nil
+				return args
+			},
+			exError: dataindexer.ErrNilDataPublisher,
+		},
 		{
 			name: "All arguments ok",
 			argsFunc: func() ArgsIndexerFactory {
@@ -117,3 +129,44 @@ func TestIndexerFactoryCreate_ElasticIndexer(t *testing.T) {
 	err = elasticIndexer.Close()
 	require.NoError(t, err)
 }
+
+func TestIndexerFactoryCreate_UnknownRunType(t *testing.T) {
+	args := createMockIndexerFactoryArgs()
+	args.RunType = "unknown"
+
+	elasticIndexer, err := NewIndexer(args)
+	require.Nil(t, elasticIndexer)
+	require.ErrorContains(t, err, "unknown run type")
+}
+
+func TestIndexerFactoryCreate_InvalidFieldsProjection(t *testing.T) {
+	args := createMockIndexerFactoryArgs()
+	args.FieldsProjection = []client.FieldsProjectionRule{{Drop: []string{"data"}}}
+
+	elasticIndexer, err := NewIndexer(args)
+	require.Nil(t, elasticIndexer)
+	require.True(t, errorsGo.Is(err, dataindexer.ErrInvalidFieldsProjectionRule))
+}
+
+func TestIndexerFactoryCreate_WithIndexNamespace(t *testing.T) {
+	var mut sync.Mutex
+	paths := make([]string, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mut.Lock()
+		paths = append(paths, r.URL.Path)
+		mut.Unlock()
+	}))
+	args := createMockIndexerFactoryArgs()
+	args.Url = ts.URL
+	args.IndexNamespace = "sovereign"
+
+	elasticIndexer, err := NewIndexer(args)
+	require.NoError(t, err)
+	require.Nil(t, elasticIndexer.Close())
+
+	mut.Lock()
+	defer mut.Unlock()
+	require.Contains(t, paths, "/_template/sovereign-blocks")
+	require.Contains(t, paths, "/sovereign-blocks-000001")
+	require.NotContains(t, paths, "/_template/blocks")
+}
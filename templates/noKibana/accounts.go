@@ -31,6 +31,9 @@ var Accounts = Object{
 				"address": Object{
 					"type": "keyword",
 				},
+				"addressSecondary": Object{
+					"type": "keyword",
+				},
 				"balance": Object{
 					"type": "keyword",
 				},
@@ -56,6 +59,35 @@ var Accounts = Object{
 				"developerRewardsNum": Object{
 					"type": "double",
 				},
+				"guardian": Object{
+					"properties": Object{
+						"isGuarded": Object{
+							"type": "boolean",
+						},
+						"activeGuardian": Object{
+							"type": "keyword",
+						},
+						"activeServiceUID": Object{
+							"type": "keyword",
+						},
+						"pendingGuardian": Object{
+							"type": "keyword",
+						},
+						"pendingServiceUID": Object{
+							"type": "keyword",
+						},
+						"pendingActivationEpoch": Object{
+							"type": "long",
+						},
+						"txHash": Object{
+							"type": "keyword",
+						},
+						"timestamp": Object{
+							"type":   "date",
+							"format": "epoch_second",
+						},
+					},
+				},
 			},
 		},
 	},
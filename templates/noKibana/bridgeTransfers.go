@@ -0,0 +1,73 @@
+package noKibana
+
+// BridgeTransfers will hold the configuration for the bridge transfers index
+var BridgeTransfers = Object{
+	"index_patterns": Array{
+		"bridgetransfers-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   3,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"destinationTxHash": Object{
+					"type": "keyword",
+				},
+				"direction": Object{
+					"type": "keyword",
+				},
+				"executedShardID": Object{
+					"type": "long",
+				},
+				"executedTimestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"originTxHash": Object{
+					"type": "keyword",
+				},
+				"receiver": Object{
+					"type": "keyword",
+				},
+				"sender": Object{
+					"type": "keyword",
+				},
+				"shardID": Object{
+					"type": "long",
+				},
+				"status": Object{
+					"type": "keyword",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"tokens": Object{
+					"type": "nested",
+					"properties": Object{
+						"amount": Object{
+							"type": "keyword",
+						},
+						"identifier": Object{
+							"type": "keyword",
+						},
+						"isSovereignToken": Object{
+							"type": "boolean",
+						},
+						"nonce": Object{
+							"type": "double",
+						},
+						"prefix": Object{
+							"type": "keyword",
+						},
+						"token": Object{
+							"type": "keyword",
+						},
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,56 @@
+package noKibana
+
+// CustomEvents will return the configuration for a custom events index. The fields common to all the custom events are
+// mapped explicitly, while the decoded topics are mapped dynamically, the strings being saved as keywords.
+func CustomEvents(index string) Object {
+	return Object{
+		"index_patterns": Array{
+			index + "-*",
+		},
+		"template": Object{
+			"settings": Object{
+				"number_of_shards":   3,
+				"number_of_replicas": 0,
+			},
+			"mappings": Object{
+				"dynamic_templates": Array{
+					Object{
+						"strings_as_keywords": Object{
+							"match_mapping_type": "string",
+							"mapping": Object{
+								"type": "keyword",
+							},
+						},
+					},
+				},
+				"properties": Object{
+					"eventID": Object{
+						"type": "keyword",
+					},
+					"txHash": Object{
+						"type": "keyword",
+					},
+					"originalTxHash": Object{
+						"type": "keyword",
+					},
+					"address": Object{
+						"type": "keyword",
+					},
+					"identifier": Object{
+						"type": "keyword",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"order": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
+		},
+	}
+}
@@ -24,9 +24,17 @@ var Events = Object{
 				"address": Object{
 					"type": "keyword",
 				},
+				"addressSecondary": Object{
+					"type": "keyword",
+				},
 				"identifier": Object{
 					"type": "keyword",
 				},
+				"decodedData": Object{
+					"type":         "flattened",
+					"depth_limit":  3,
+					"ignore_above": 256,
+				},
 				"shardID": Object{
 					"type": "long",
 				},
@@ -0,0 +1,49 @@
+package noKibana
+
+// Guardians will hold the configuration for the guardians index
+var Guardians = Object{
+	"index_patterns": Array{
+		"guardians-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   3,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"address": Object{
+					"type": "keyword",
+				},
+				"isGuarded": Object{
+					"type": "boolean",
+				},
+				"activeGuardian": Object{
+					"type": "keyword",
+				},
+				"activeServiceUID": Object{
+					"type": "keyword",
+				},
+				"pendingGuardian": Object{
+					"type": "keyword",
+				},
+				"pendingServiceUID": Object{
+					"type": "keyword",
+				},
+				"pendingActivationEpoch": Object{
+					"type": "long",
+				},
+				"txHash": Object{
+					"type": "keyword",
+				},
+				"shardID": Object{
+					"type": "long",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,32 @@
+package noKibana
+
+// Payloads will hold the configuration for the payloads index
+var Payloads = Object{
+	"index_patterns": Array{
+		"payloads-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   3,
+			"number_of_replicas": 0,
+			"index": Object{
+				"codec": "best_compression",
+			},
+		},
+		"mappings": Object{
+			"properties": Object{
+				"data": Object{
+					"type":       "binary",
+					"doc_values": false,
+				},
+				"length": Object{
+					"type": "long",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+			},
+		},
+	},
+}
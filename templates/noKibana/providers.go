@@ -0,0 +1,43 @@
+package noKibana
+
+// Providers will hold the configuration for the providers index
+var Providers = Object{
+	"index_patterns": Array{
+		"providers-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   3,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"address": Object{
+					"type": "keyword",
+				},
+				"owner": Object{
+					"type": "keyword",
+				},
+				"serviceFee": Object{
+					"type": "long",
+				},
+				"totalDelegationCap": Object{
+					"type": "keyword",
+				},
+				"name": Object{
+					"type": "keyword",
+				},
+				"website": Object{
+					"type": "keyword",
+				},
+				"identity": Object{
+					"type": "keyword",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+			},
+		},
+	},
+}
@@ -30,6 +30,12 @@ var SCResults = Object{
 				"data": Object{
 					"type": "text",
 				},
+				"dataHash": Object{
+					"type": "keyword",
+				},
+				"dataLength": Object{
+					"type": "long",
+				},
 				"dcdtValues": Object{
 					"type": "keyword",
 				},
@@ -39,6 +45,11 @@ var SCResults = Object{
 				"function": Object{
 					"type": "keyword",
 				},
+				"decodedData": Object{
+					"type":         "flattened",
+					"depth_limit":  3,
+					"ignore_above": 256,
+				},
 				"gasLimit": Object{
 					"index": "false",
 					"type":  "double",
@@ -71,6 +82,9 @@ var SCResults = Object{
 				"receiver": Object{
 					"type": "keyword",
 				},
+				"receiverSecondary": Object{
+					"type": "keyword",
+				},
 				"receiverShard": Object{
 					"type": "long",
 				},
@@ -93,9 +107,15 @@ var SCResults = Object{
 				"sender": Object{
 					"type": "keyword",
 				},
+				"senderSecondary": Object{
+					"type": "keyword",
+				},
 				"senderShard": Object{
 					"type": "long",
 				},
+				"stuckPending": Object{
+					"type": "boolean",
+				},
 				"timestamp": Object{
 					"type":   "date",
 					"format": "epoch_second",
@@ -0,0 +1,59 @@
+package withKibana
+
+// AddressActivity will hold the configuration for the address activity index
+var AddressActivity = Object{
+	"index_patterns": Array{
+		"addressactivity-*",
+	},
+	"settings": Object{
+		"number_of_shards":   5,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"address": Object{
+				"type": "keyword",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"firstSeen": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"lastSeen": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"sentTxs": Object{
+				"type": "long",
+			},
+			"receivedTxs": Object{
+				"type": "long",
+			},
+			"scResults": Object{
+				"type": "long",
+			},
+			"fees": Object{
+				"type": "keyword",
+			},
+			"feesNum": Object{
+				"type": "double",
+			},
+			"tokens": Object{
+				"type": "keyword",
+			},
+			"contracts": Object{
+				"type": "keyword",
+			},
+			"previous": Object{
+				"type":    "object",
+				"enabled": false,
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
@@ -24,10 +24,18 @@ var Blocks = Object{
 				"index": "false",
 				"type":  "keyword",
 			},
+			"accumulatedFeesInEpoch": Object{
+				"index": "false",
+				"type":  "keyword",
+			},
 			"developerFees": Object{
 				"index": "false",
 				"type":  "keyword",
 			},
+			"developerFeesInEpoch": Object{
+				"index": "false",
+				"type":  "keyword",
+			},
 			"epoch": Object{
 				"type": "long",
 			},
@@ -135,6 +143,9 @@ var Blocks = Object{
 					},
 				},
 			},
+			"extendedShardHeaderHashes": Object{
+				"type": "keyword",
+			},
 			"gasPenalized": Object{
 				"type": "double",
 			},
@@ -144,6 +155,29 @@ var Blocks = Object{
 			"gasRefunded": Object{
 				"type": "double",
 			},
+			"lastFinalizedCrossChainHeader": Object{
+				"properties": Object{
+					"epoch": Object{
+						"index": "false",
+						"type":  "long",
+					},
+					"headerHash": Object{
+						"type": "keyword",
+					},
+					"nonce": Object{
+						"index": "false",
+						"type":  "double",
+					},
+					"round": Object{
+						"index": "false",
+						"type":  "double",
+					},
+					"shardID": Object{
+						"index": "false",
+						"type":  "long",
+					},
+				},
+			},
 			"maxGasLimit": Object{
 				"type": "double",
 			},
@@ -176,6 +210,27 @@ var Blocks = Object{
 				"index": "false",
 				"type":  "long",
 			},
+			"outGoingMiniBlockHeader": Object{
+				"properties": Object{
+					"aggregatedSignatureOutGoingOperations": Object{
+						"index": "false",
+						"type":  "keyword",
+					},
+					"hash": Object{
+						"type": "keyword",
+					},
+					"leaderSignatureOutGoingOperations": Object{
+						"index": "false",
+						"type":  "keyword",
+					},
+					"operationsHashes": Object{
+						"type": "keyword",
+					},
+					"outGoingOperationsHash": Object{
+						"type": "keyword",
+					},
+				},
+			},
 			"prevHash": Object{
 				"type": "keyword",
 			},
@@ -242,6 +297,10 @@ var Blocks = Object{
 				"index": "false",
 				"type":  "long",
 			},
+			"validatorStatsRootHash": Object{
+				"index": "false",
+				"type":  "keyword",
+			},
 			"validators": Object{
 				"index": "false",
 				"type":  "long",
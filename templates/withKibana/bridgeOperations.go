@@ -0,0 +1,52 @@
+package withKibana
+
+// BridgeOperations will hold the configuration for the bridge operations index
+var BridgeOperations = Object{
+	"index_patterns": Array{
+		"bridgeoperations-*",
+	},
+	"settings": Object{
+		"number_of_shards":   3,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"aggregatedSignature": Object{
+				"index": "false",
+				"type":  "keyword",
+			},
+			"blockHash": Object{
+				"type": "keyword",
+			},
+			"blockNonce": Object{
+				"type": "double",
+			},
+			"epoch": Object{
+				"type": "long",
+			},
+			"index": Object{
+				"type": "long",
+			},
+			"leaderSignature": Object{
+				"index": "false",
+				"type":  "keyword",
+			},
+			"outGoingMiniBlockHash": Object{
+				"type": "keyword",
+			},
+			"outGoingOperationsHash": Object{
+				"type": "keyword",
+			},
+			"round": Object{
+				"type": "double",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
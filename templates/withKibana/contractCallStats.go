@@ -0,0 +1,39 @@
+package withKibana
+
+// ContractCallStats will hold the configuration for the contract call stats index
+var ContractCallStats = Object{
+	"index_patterns": Array{
+		"contractcallstats-*",
+	},
+	"settings": Object{
+		"number_of_shards":   3,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"contract": Object{
+				"type": "keyword",
+			},
+			"caller": Object{
+				"type": "keyword",
+			},
+			"inboundCalls": Object{
+				"type": "long",
+			},
+			"uniqueCallers": Object{
+				"type": "long",
+			},
+			"failures": Object{
+				"type": "long",
+			},
+			"shardsTimestamps": Object{
+				"type":    "object",
+				"enabled": false,
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
@@ -0,0 +1,59 @@
+package withKibana
+
+// ContractCalls will hold the configuration for the contract calls index
+var ContractCalls = Object{
+	"index_patterns": Array{
+		"contractcalls-*",
+	},
+	"settings": Object{
+		"number_of_shards":   5,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"txHash": Object{
+				"type": "keyword",
+			},
+			"originalTxHash": Object{
+				"type": "keyword",
+			},
+			"prevTxHash": Object{
+				"type": "keyword",
+			},
+			"caller": Object{
+				"type": "keyword",
+			},
+			"callee": Object{
+				"type": "keyword",
+			},
+			"function": Object{
+				"type": "keyword",
+			},
+			"callType": Object{
+				"type": "keyword",
+			},
+			"value": Object{
+				"type": "keyword",
+			},
+			"valueNum": Object{
+				"type": "double",
+			},
+			"depth": Object{
+				"type": "long",
+			},
+			"gasLimit": Object{
+				"type": "double",
+			},
+			"success": Object{
+				"type": "boolean",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
@@ -0,0 +1,35 @@
+package withKibana
+
+// Nodes will hold the configuration for the nodes index
+var Nodes = Object{
+	"index_patterns": Array{
+		"nodes-*",
+	},
+	"settings": Object{
+		"number_of_shards":   3,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"blsKey": Object{
+				"type": "keyword",
+			},
+			"owner": Object{
+				"type": "keyword",
+			},
+			"provider": Object{
+				"type": "keyword",
+			},
+			"status": Object{
+				"type": "keyword",
+			},
+			"txHash": Object{
+				"type": "keyword",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
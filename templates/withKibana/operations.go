@@ -34,6 +34,12 @@ var Operations = Object{
 			"data": Object{
 				"type": "text",
 			},
+			"dataHash": Object{
+				"type": "keyword",
+			},
+			"dataLength": Object{
+				"type": "long",
+			},
 			"dcdtValues": Object{
 				"type": "keyword",
 			},
@@ -50,6 +56,11 @@ var Operations = Object{
 			"function": Object{
 				"type": "keyword",
 			},
+			"decodedData": Object{
+				"type":         "flattened",
+				"depth_limit":  3,
+				"ignore_above": 256,
+			},
 			"gasLimit": Object{
 				"index": "false",
 				"type":  "double",
@@ -102,6 +113,9 @@ var Operations = Object{
 			"receiver": Object{
 				"type": "keyword",
 			},
+			"receiverSecondary": Object{
+				"type": "keyword",
+			},
 			"receiverShard": Object{
 				"type": "long",
 			},
@@ -130,6 +144,9 @@ var Operations = Object{
 			"sender": Object{
 				"type": "keyword",
 			},
+			"senderSecondary": Object{
+				"type": "keyword",
+			},
 			"senderShard": Object{
 				"type": "long",
 			},
@@ -11,6 +11,89 @@ var Tokens = Object{
 	},
 	"mappings": Object{
 		"properties": Object{
+			"accountsCount": Object{
+				"type": "long",
+			},
+			"circulatingSupply": Object{
+				"type": "keyword",
+			},
+			"circulatingSupplyNum": Object{
+				"type": "double",
+			},
+			"collectionStats": Object{
+				"properties": Object{
+					"burned": Object{
+						"type": "keyword",
+					},
+					"firstMintTimestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+					"holders": Object{
+						"type": "long",
+					},
+					"holdersChanges": Object{
+						"properties": Object{
+							"holders": Object{
+								"type": "long",
+							},
+							"shardID": Object{
+								"type": "long",
+							},
+							"timestamp": Object{
+								"type": "long",
+							},
+						},
+					},
+					"lastMintTimestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+					"minted": Object{
+						"type": "keyword",
+					},
+					"nftsWithURIs": Object{
+						"type": "long",
+					},
+					"statsChanges": Object{
+						"properties": Object{
+							"burned": Object{
+								"type": "keyword",
+							},
+							"firstMintTimestamp": Object{
+								"type": "long",
+							},
+							"lastMintTimestamp": Object{
+								"type": "long",
+							},
+							"minted": Object{
+								"type": "keyword",
+							},
+							"nftsWithURIs": Object{
+								"type": "long",
+							},
+							"previousFirstMintTimestamp": Object{
+								"type": "long",
+							},
+							"previousLastMintTimestamp": Object{
+								"type": "long",
+							},
+							"shardID": Object{
+								"type": "long",
+							},
+							"supply": Object{
+								"type": "keyword",
+							},
+							"timestamp": Object{
+								"type": "long",
+							},
+						},
+					},
+					"supply": Object{
+						"type": "keyword",
+					},
+				},
+			},
 			"currentOwner": Object{
 				"type": "keyword",
 			},
@@ -52,6 +135,9 @@ var Tokens = Object{
 					},
 				},
 			},
+			"holdersCount": Object{
+				"type": "long",
+			},
 			"identifier": Object{
 				"type": "text",
 			},
@@ -81,6 +167,9 @@ var Tokens = Object{
 					},
 				},
 			},
+			"pendingResolution": Object{
+				"type": "boolean",
+			},
 			"properties": Object{
 				"properties": Object{
 					"canAddSpecialRoles": Object{
@@ -23,6 +23,12 @@ var Transactions = Object{
 			"data": Object{
 				"type": "text",
 			},
+			"dataHash": Object{
+				"type": "keyword",
+			},
+			"dataLength": Object{
+				"type": "long",
+			},
 			"dcdtValues": Object{
 				"type": "keyword",
 			},
@@ -39,6 +45,11 @@ var Transactions = Object{
 			"function": Object{
 				"type": "keyword",
 			},
+			"decodedData": Object{
+				"type":         "flattened",
+				"depth_limit":  3,
+				"ignore_above": 256,
+			},
 			"gasLimit": Object{
 				"index": "false",
 				"type":  "double",
@@ -82,6 +93,9 @@ var Transactions = Object{
 			"receiver": Object{
 				"type": "keyword",
 			},
+			"receiverSecondary": Object{
+				"type": "keyword",
+			},
 			"receiverShard": Object{
 				"type": "long",
 			},
@@ -100,6 +114,9 @@ var Transactions = Object{
 			"sender": Object{
 				"type": "keyword",
 			},
+			"senderSecondary": Object{
+				"type": "keyword",
+			},
 			"senderShard": Object{
 				"type": "long",
 			},
@@ -113,6 +130,9 @@ var Transactions = Object{
 			"status": Object{
 				"type": "keyword",
 			},
+			"stuckPending": Object{
+				"type": "boolean",
+			},
 			"timestamp": Object{
 				"type":   "date",
 				"format": "epoch_second",
@@ -0,0 +1,62 @@
+package withKibana
+
+// Transfers will hold the configuration for the transfers index
+var Transfers = Object{
+	"index_patterns": Array{
+		"transfers-*",
+	},
+	"settings": Object{
+		"number_of_shards":   5,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"txHash": Object{
+				"type": "keyword",
+			},
+			"originalTxHash": Object{
+				"type": "keyword",
+			},
+			"sender": Object{
+				"type": "keyword",
+			},
+			"receiver": Object{
+				"type": "keyword",
+			},
+			"senderShard": Object{
+				"type": "long",
+			},
+			"receiverShard": Object{
+				"type": "long",
+			},
+			"token": Object{
+				"type": "keyword",
+			},
+			"identifier": Object{
+				"type": "keyword",
+			},
+			"nonce": Object{
+				"type": "double",
+			},
+			"amount": Object{
+				"type": "keyword",
+			},
+			"amountNum": Object{
+				"type": "double",
+			},
+			"direction": Object{
+				"type": "keyword",
+			},
+			"source": Object{
+				"type": "keyword",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
@@ -0,0 +1,35 @@
+package withKibana
+
+// TxStatusHistory will hold the configuration for the transactions status history index
+var TxStatusHistory = Object{
+	"index_patterns": Array{
+		"txstatushistory-*",
+	},
+	"settings": Object{
+		"number_of_shards":   3,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"txHash": Object{
+				"type": "keyword",
+			},
+			"type": Object{
+				"type": "keyword",
+			},
+			"status": Object{
+				"type": "keyword",
+			},
+			"cause": Object{
+				"type": "keyword",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
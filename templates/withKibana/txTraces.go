@@ -0,0 +1,192 @@
+package withKibana
+
+// TxTraces will hold the configuration for the transaction traces index
+var TxTraces = Object{
+	"index_patterns": Array{
+		"txtraces-*",
+	},
+	"settings": Object{
+		"number_of_shards":   5,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"sender": Object{
+				"type": "keyword",
+			},
+			"receiver": Object{
+				"type": "keyword",
+			},
+			"value": Object{
+				"type": "keyword",
+			},
+			"function": Object{
+				"type": "keyword",
+			},
+			"status": Object{
+				"type": "keyword",
+			},
+			"fee": Object{
+				"type": "keyword",
+			},
+			"feeNum": Object{
+				"type": "double",
+			},
+			"gasUsed": Object{
+				"type": "double",
+			},
+			"initialPaidFee": Object{
+				"type": "keyword",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"scResults": Object{
+				"type": "nested",
+				"properties": Object{
+					"id": Object{
+						"type": "keyword",
+					},
+					"prevTxHash": Object{
+						"type": "keyword",
+					},
+					"sender": Object{
+						"type": "keyword",
+					},
+					"receiver": Object{
+						"type": "keyword",
+					},
+					"value": Object{
+						"type": "keyword",
+					},
+					"function": Object{
+						"type": "keyword",
+					},
+					"callType": Object{
+						"type": "keyword",
+					},
+					"returnMessage": Object{
+						"type": "text",
+					},
+					"order": Object{
+						"type": "long",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
+			"events": Object{
+				"type": "nested",
+				"properties": Object{
+					"id": Object{
+						"type": "keyword",
+					},
+					"txHash": Object{
+						"type": "keyword",
+					},
+					"address": Object{
+						"type": "keyword",
+					},
+					"identifier": Object{
+						"type": "keyword",
+					},
+					"topics": Object{
+						"type": "text",
+					},
+					"data": Object{
+						"index": "false",
+						"type":  "text",
+					},
+					"decodedData": Object{
+						"type":         "flattened",
+						"depth_limit":  3,
+						"ignore_above": 256,
+					},
+					"order": Object{
+						"type": "long",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
+			"receipts": Object{
+				"type": "nested",
+				"properties": Object{
+					"id": Object{
+						"type": "keyword",
+					},
+					"sender": Object{
+						"type": "keyword",
+					},
+					"value": Object{
+						"type": "keyword",
+					},
+					"data": Object{
+						"index": "false",
+						"type":  "text",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
+			"refunds": Object{
+				"type": "nested",
+				"properties": Object{
+					"id": Object{
+						"type": "keyword",
+					},
+					"receiver": Object{
+						"type": "keyword",
+					},
+					"value": Object{
+						"type": "keyword",
+					},
+					"gasRefunded": Object{
+						"type": "double",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
+			"statusChanges": Object{
+				"type": "nested",
+				"properties": Object{
+					"id": Object{
+						"type": "keyword",
+					},
+					"status": Object{
+						"type": "keyword",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
+		},
+	},
+}
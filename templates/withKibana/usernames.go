@@ -0,0 +1,57 @@
+package withKibana
+
+// Usernames will hold the configuration for the usernames index
+var Usernames = Object{
+	"index_patterns": Array{
+		"usernames-*",
+	},
+	"settings": Object{
+		"number_of_shards":   3,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"userName": Object{
+				"type": "keyword",
+			},
+			"address": Object{
+				"type": "keyword",
+			},
+			"txHash": Object{
+				"type": "keyword",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"supersededBy": Object{
+				"type": "keyword",
+			},
+			"supersededTimestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"history": Object{
+				"type": "nested",
+				"properties": Object{
+					"address": Object{
+						"type": "keyword",
+					},
+					"txHash": Object{
+						"type": "keyword",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
+		},
+	},
+}
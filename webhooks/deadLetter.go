@@ -0,0 +1,66 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+type deadLetterEntry struct {
+	Endpoint  string          `json:"endpoint"`
+	URL       string          `json:"url"`
+	Reason    string          `json:"reason"`
+	Timestamp int64           `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+type deadLetterFile struct {
+	mut  sync.Mutex
+	file *os.File
+}
+
+func newDeadLetterFile(path string) (*deadLetterFile, error) {
+	if path == "" {
+		return nil, ErrEmptyDeadLetterFile
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadLetterFile{
+		file: file,
+	}, nil
+}
+
+func (dlf *deadLetterFile) write(d *delivery, reason string) {
+	entry := &deadLetterEntry{
+		Endpoint:  d.endpoint.name,
+		URL:       d.endpoint.url,
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+		Payload:   d.payload,
+	}
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		log.Warn("cannot marshal dead letter entry", "endpoint", d.endpoint.name, "error", err)
+		return
+	}
+
+	dlf.mut.Lock()
+	defer dlf.mut.Unlock()
+
+	_, err = dlf.file.Write(append(entryBytes, '\n'))
+	if err != nil {
+		log.Error("cannot write in the dead letter file", "endpoint", d.endpoint.name, "error", err)
+	}
+}
+
+func (dlf *deadLetterFile) close() error {
+	dlf.mut.Lock()
+	defer dlf.mut.Unlock()
+
+	return dlf.file.Close()
+}
@@ -0,0 +1,24 @@
+package webhooks
+
+import "github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+
+type disabledNotifier struct{}
+
+// NewDisabledNotifier will create a new instance of disabledNotifier
+func NewDisabledNotifier() *disabledNotifier {
+	return &disabledNotifier{}
+}
+
+// Publish does nothing
+func (dn *disabledNotifier) Publish(_ *data.IndexedBlockData) {
+}
+
+// Close returns nil
+func (dn *disabledNotifier) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dn *disabledNotifier) IsInterfaceNil() bool {
+	return dn == nil
+}
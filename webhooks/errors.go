@@ -0,0 +1,27 @@
+package webhooks
+
+import "errors"
+
+// ErrInvalidRuleType signals that a watch rule has an unknown type
+var ErrInvalidRuleType = errors.New("invalid rule type")
+
+// ErrInvalidRule signals that a watch rule is not properly configured
+var ErrInvalidRule = errors.New("invalid rule")
+
+// ErrUnknownEndpoint signals that a watch rule references an endpoint that is not configured
+var ErrUnknownEndpoint = errors.New("unknown endpoint")
+
+// ErrInvalidEndpoint signals that an endpoint is not properly configured
+var ErrInvalidEndpoint = errors.New("invalid endpoint")
+
+// ErrInvalidQueueSize signals that an invalid queue size has been provided
+var ErrInvalidQueueSize = errors.New("invalid queue size")
+
+// ErrInvalidNumWorkers signals that an invalid number of workers has been provided
+var ErrInvalidNumWorkers = errors.New("invalid number of workers")
+
+// ErrEmptyDeadLetterFile signals that the dead letter file path is empty
+var ErrEmptyDeadLetterFile = errors.New("empty dead letter file path")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
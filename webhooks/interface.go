@@ -0,0 +1,8 @@
+package webhooks
+
+import "net/http"
+
+// HTTPClient defines what a client that sends the notifications should be able to do
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
@@ -0,0 +1,351 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+)
+
+// signatureHeader holds the hex encoded HMAC-SHA256 of the request body, computed with the endpoint secret
+const signatureHeader = "X-Indexer-Signature"
+
+var log = logger.GetOrCreate("webhooks")
+
+type endpoint struct {
+	name   string
+	url    string
+	secret []byte
+}
+
+type delivery struct {
+	endpoint *endpoint
+	payload  []byte
+}
+
+// notification is the JSON payload that is sent to the endpoints of a matched rule
+type notification struct {
+	ID          string               `json:"id"`
+	Pipeline    string               `json:"pipeline,omitempty"`
+	Rule        string               `json:"rule"`
+	Type        string               `json:"type"`
+	HeaderHash  string               `json:"headerHash"`
+	Nonce       uint64               `json:"nonce"`
+	ShardID     uint32               `json:"shardID"`
+	Timestamp   uint64               `json:"timestamp"`
+	Transaction *notifiedTransaction `json:"transaction,omitempty"`
+	ScResult    *notifiedScResult    `json:"scResult,omitempty"`
+	Event       *notifiedEvent       `json:"event,omitempty"`
+	Token       *data.TokenInfo      `json:"token,omitempty"`
+}
+
+type notifiedTransaction struct {
+	Hash string `json:"hash"`
+	*data.Transaction
+}
+
+type notifiedScResult struct {
+	Hash string `json:"hash"`
+	*data.ScResult
+}
+
+type notifiedEvent struct {
+	ID string `json:"id"`
+	*data.LogEvent
+}
+
+// ArgsWebhooksNotifier holds all the components needed to create a new instance of webhooksNotifier
+type ArgsWebhooksNotifier struct {
+	Config     config.WebhooksConfig
+	HTTPClient HTTPClient
+}
+
+type webhooksNotifier struct {
+	rules         []*rule
+	httpClient    HTTPClient
+	maxRetries    int
+	retryInterval time.Duration
+	queue         chan *delivery
+	deadLetter    *deadLetterFile
+
+	mutClosed sync.RWMutex
+	closed    bool
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewWebhooksNotifier will create a new instance of webhooksNotifier and will start the delivery workers
+func NewWebhooksNotifier(args ArgsWebhooksNotifier) (*webhooksNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make(map[string]*endpoint, len(args.Config.Endpoints))
+	for _, endpointCfg := range args.Config.Endpoints {
+		if endpointCfg.Name == "" || endpointCfg.URL == "" {
+			return nil, fmt.Errorf("%w: empty name or url", ErrInvalidEndpoint)
+		}
+		endpoints[endpointCfg.Name] = &endpoint{
+			name:   endpointCfg.Name,
+			url:    endpointCfg.URL,
+			secret: []byte(endpointCfg.Secret),
+		}
+	}
+
+	rules := make([]*rule, 0, len(args.Config.Rules))
+	for _, ruleCfg := range args.Config.Rules {
+		r, errRule := newRule(ruleCfg, endpoints)
+		if errRule != nil {
+			return nil, errRule
+		}
+		rules = append(rules, r)
+	}
+
+	deadLetter, err := newDeadLetterFile(args.Config.DeadLetterFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wn := &webhooksNotifier{
+		rules:         rules,
+		httpClient:    args.HTTPClient,
+		maxRetries:    args.Config.MaxRetries,
+		retryInterval: time.Duration(args.Config.RetryIntervalInSec) * time.Second,
+		queue:         make(chan *delivery, args.Config.QueueSize),
+		deadLetter:    deadLetter,
+		cancel:        cancel,
+	}
+
+	for i := 0; i < args.Config.NumWorkers; i++ {
+		wn.wg.Add(1)
+		go wn.processDeliveries(ctx)
+	}
+
+	return wn, nil
+}
+
+func checkArgs(args ArgsWebhooksNotifier) error {
+	if args.HTTPClient == nil {
+		return ErrNilHTTPClient
+	}
+	if args.Config.QueueSize < 1 {
+		return ErrInvalidQueueSize
+	}
+	if args.Config.NumWorkers < 1 {
+		return ErrInvalidNumWorkers
+	}
+	if args.Config.DeadLetterFile == "" {
+		return ErrEmptyDeadLetterFile
+	}
+
+	return nil
+}
+
+// Publish will match the indexed documents against the watch rules and will queue a notification for every match
+func (wn *webhooksNotifier) Publish(indexedData *data.IndexedBlockData) {
+	if indexedData == nil {
+		return
+	}
+
+	notifications := wn.createNotifications(indexedData)
+	if len(notifications) == 0 {
+		return
+	}
+
+	wn.mutClosed.RLock()
+	defer wn.mutClosed.RUnlock()
+
+	if wn.closed {
+		return
+	}
+
+	for _, n := range notifications {
+		payload, err := json.Marshal(n.notification)
+		if err != nil {
+			log.Warn("webhooksNotifier.Publish: cannot marshal notification", "rule", n.notification.Rule, "error", err)
+			continue
+		}
+
+		for _, e := range n.endpoints {
+			wn.enqueue(&delivery{endpoint: e, payload: payload})
+		}
+	}
+}
+
+func (wn *webhooksNotifier) enqueue(d *delivery) {
+	select {
+	case wn.queue <- d:
+	default:
+		log.Warn("webhooks queue is full, the notification will be written in the dead letter file", "endpoint", d.endpoint.name)
+		wn.deadLetter.write(d, "queue is full")
+	}
+}
+
+type matchedNotification struct {
+	notification *notification
+	endpoints    []*endpoint
+}
+
+func (wn *webhooksNotifier) createNotifications(indexedData *data.IndexedBlockData) []*matchedNotification {
+	notifications := make([]*matchedNotification, 0)
+	addNotification := func(r *rule, id string, n *notification) {
+		n.ID = r.name + "-" + id
+		if indexedData.Pipeline != "" {
+			// the pipelines index different chains, whose documents can have the same IDs
+			n.ID = indexedData.Pipeline + "-" + n.ID
+		}
+		n.Pipeline = indexedData.Pipeline
+		n.Rule = r.name
+		n.Type = r.ruleType
+		n.HeaderHash = indexedData.HeaderHash
+		n.Nonce = indexedData.Nonce
+		n.ShardID = indexedData.ShardID
+		n.Timestamp = indexedData.Timestamp
+		notifications = append(notifications, &matchedNotification{notification: n, endpoints: r.endpoints})
+	}
+
+	for _, r := range wn.rules {
+		for _, tx := range indexedData.Transactions {
+			// cross-shard transactions are indexed in both shards, the notification is sent only from the source shard
+			if tx.SenderShard != indexedData.ShardID {
+				continue
+			}
+			if r.matchTransfer(tx.Sender, tx.Receiver, tx.Receivers, tx.Value, tx.Tokens, tx.DCDTValues) {
+				addNotification(r, tx.Hash, &notification{Transaction: &notifiedTransaction{Hash: tx.Hash, Transaction: tx}})
+			}
+		}
+		for _, scr := range indexedData.ScResults {
+			if scr.SenderShard != indexedData.ShardID {
+				continue
+			}
+			if r.matchTransfer(scr.Sender, scr.Receiver, scr.Receivers, scr.Value, scr.Tokens, scr.DCDTValues) {
+				addNotification(r, scr.Hash, &notification{ScResult: &notifiedScResult{Hash: scr.Hash, ScResult: scr}})
+			}
+		}
+		for _, event := range indexedData.Events {
+			if r.matchEvent(event) {
+				addNotification(r, event.ID, &notification{Event: &notifiedEvent{ID: event.ID, LogEvent: event}})
+			}
+		}
+		for _, token := range indexedData.Tokens {
+			if r.matchToken(token) {
+				addNotification(r, token.Token, &notification{Token: token})
+			}
+		}
+	}
+
+	return notifications
+}
+
+func (wn *webhooksNotifier) processDeliveries(ctx context.Context) {
+	defer wn.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-wn.queue:
+			wn.deliver(ctx, d)
+		}
+	}
+}
+
+func (wn *webhooksNotifier) deliver(ctx context.Context, d *delivery) {
+	var err error
+	for attempt := 0; attempt <= wn.maxRetries; attempt++ {
+		err = wn.send(ctx, d)
+		if err == nil {
+			return
+		}
+
+		log.Debug("webhooksNotifier.deliver: cannot send notification", "endpoint", d.endpoint.name, "attempt", attempt+1, "error", err)
+		if attempt == wn.maxRetries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			wn.deadLetter.write(d, "indexer closing: "+err.Error())
+			return
+		case <-time.After(wn.retryInterval * time.Duration(attempt+1)):
+		}
+	}
+
+	log.Warn("cannot deliver webhook notification, it will be written in the dead letter file", "endpoint", d.endpoint.name, "error", err)
+	wn.deadLetter.write(d, err.Error())
+}
+
+func (wn *webhooksNotifier) send(ctx context.Context, d *delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.endpoint.url, bytes.NewReader(d.payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if len(d.endpoint.secret) > 0 {
+		req.Header.Set(signatureHeader, computeSignature(d.endpoint.secret, d.payload))
+	}
+
+	resp, err := wn.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func computeSignature(secret []byte, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Close will stop the delivery workers and will write the notifications that are still queued in the dead letter file
+func (wn *webhooksNotifier) Close() error {
+	wn.mutClosed.Lock()
+	if wn.closed {
+		wn.mutClosed.Unlock()
+		return nil
+	}
+	wn.closed = true
+	wn.mutClosed.Unlock()
+
+	wn.cancel()
+	wn.wg.Wait()
+
+	for {
+		select {
+		case d := <-wn.queue:
+			wn.deadLetter.write(d, "indexer closing")
+		default:
+			return wn.deadLetter.close()
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wn *webhooksNotifier) IsInterfaceNil() bool {
+	return wn == nil
+}
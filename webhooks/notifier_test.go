@@ -0,0 +1,273 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func createMockArgsWebhooksNotifier(t *testing.T, url string) ArgsWebhooksNotifier {
+	return ArgsWebhooksNotifier{
+		Config: config.WebhooksConfig{
+			Enabled:            true,
+			QueueSize:          10,
+			NumWorkers:         1,
+			MaxRetries:         1,
+			RetryIntervalInSec: 0,
+			DeadLetterFile:     filepath.Join(t.TempDir(), "dead-letter.jsonl"),
+			Endpoints: []config.WebhookEndpointConfig{
+				{Name: "compliance", URL: url, Secret: "secret"},
+			},
+			Rules: []config.WebhookRuleConfig{
+				{Name: "watched", Type: addressRuleType, Address: "alice", Endpoints: []string{"compliance"}},
+			},
+		},
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func createBlockData() *data.IndexedBlockData {
+	return &data.IndexedBlockData{
+		HeaderHash: "aabb",
+		Nonce:      10,
+		ShardID:    0,
+		Transactions: []*data.Transaction{
+			{Hash: "tx1", Sender: "alice", Receiver: "bob", SenderShard: 0},
+			{Hash: "tx2", Sender: "carol", Receiver: "bob", SenderShard: 0},
+			{Hash: "tx3", Sender: "alice", Receiver: "bob", SenderShard: 1},
+		},
+	}
+}
+
+func readDeadLetterEntries(t *testing.T, path string) []*deadLetterEntry {
+	content, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	entries := make([]*deadLetterEntry, 0)
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		if line == "" {
+			continue
+		}
+		entry := &deadLetterEntry{}
+		require.Nil(t, json.Unmarshal([]byte(line), entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestNewWebhooksNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil http client", func(t *testing.T) {
+		args := createMockArgsWebhooksNotifier(t, "http://localhost")
+		args.HTTPClient = nil
+
+		wn, err := NewWebhooksNotifier(args)
+		require.Nil(t, wn)
+		require.Equal(t, ErrNilHTTPClient, err)
+	})
+	t.Run("invalid queue size", func(t *testing.T) {
+		args := createMockArgsWebhooksNotifier(t, "http://localhost")
+		args.Config.QueueSize = 0
+
+		wn, err := NewWebhooksNotifier(args)
+		require.Nil(t, wn)
+		require.Equal(t, ErrInvalidQueueSize, err)
+	})
+	t.Run("invalid number of workers", func(t *testing.T) {
+		args := createMockArgsWebhooksNotifier(t, "http://localhost")
+		args.Config.NumWorkers = 0
+
+		wn, err := NewWebhooksNotifier(args)
+		require.Nil(t, wn)
+		require.Equal(t, ErrInvalidNumWorkers, err)
+	})
+	t.Run("empty dead letter file", func(t *testing.T) {
+		args := createMockArgsWebhooksNotifier(t, "http://localhost")
+		args.Config.DeadLetterFile = ""
+
+		wn, err := NewWebhooksNotifier(args)
+		require.Nil(t, wn)
+		require.Equal(t, ErrEmptyDeadLetterFile, err)
+	})
+	t.Run("invalid endpoint", func(t *testing.T) {
+		args := createMockArgsWebhooksNotifier(t, "")
+
+		wn, err := NewWebhooksNotifier(args)
+		require.Nil(t, wn)
+		require.True(t, errors.Is(err, ErrInvalidEndpoint))
+	})
+	t.Run("invalid rule", func(t *testing.T) {
+		args := createMockArgsWebhooksNotifier(t, "http://localhost")
+		args.Config.Rules[0].Endpoints = []string{"unknown"}
+
+		wn, err := NewWebhooksNotifier(args)
+		require.Nil(t, wn)
+		require.True(t, errors.Is(err, ErrUnknownEndpoint))
+	})
+	t.Run("should work", func(t *testing.T) {
+		wn, err := NewWebhooksNotifier(createMockArgsWebhooksNotifier(t, "http://localhost"))
+		require.Nil(t, err)
+		require.False(t, wn.IsInterfaceNil())
+		require.Nil(t, wn.Close())
+	})
+}
+
+func TestWebhooksNotifier_PublishShouldPostSignedNotifications(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan *http.Request, 10)
+	bodies := make(chan []byte, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	wn, err := NewWebhooksNotifier(createMockArgsWebhooksNotifier(t, server.URL))
+	require.Nil(t, err)
+
+	wn.Publish(createBlockData())
+
+	select {
+	case req := <-received:
+		body := <-bodies
+		require.Equal(t, http.MethodPost, req.Method)
+		require.Equal(t, computeSignature([]byte("secret"), body), req.Header.Get(signatureHeader))
+
+		n := &notification{}
+		require.Nil(t, json.Unmarshal(body, n))
+		require.Equal(t, "watched-tx1", n.ID)
+		require.Equal(t, addressRuleType, n.Type)
+		require.Equal(t, "aabb", n.HeaderHash)
+		require.Equal(t, uint64(10), n.Nonce)
+		require.Equal(t, "tx1", n.Transaction.Hash)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout while waiting for the notification")
+	}
+
+	// tx3 is a cross-shard transaction that is notified only from its source shard
+	select {
+	case <-received:
+		require.Fail(t, "should have received only one notification")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.Nil(t, wn.Close())
+}
+
+func TestWebhooksNotifier_FailedDeliveryShouldBeWrittenInDeadLetterFile(t *testing.T) {
+	t.Parallel()
+
+	numRequests := uint32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(&numRequests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	args := createMockArgsWebhooksNotifier(t, server.URL)
+	args.Config.MaxRetries = 2
+	wn, err := NewWebhooksNotifier(args)
+	require.Nil(t, err)
+
+	wn.Publish(createBlockData())
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&numRequests) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		return len(readDeadLetterEntries(t, args.Config.DeadLetterFile)) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.Nil(t, wn.Close())
+	entries := readDeadLetterEntries(t, args.Config.DeadLetterFile)
+	require.Len(t, entries, 1)
+	require.Equal(t, "compliance", entries[0].Endpoint)
+	require.Contains(t, entries[0].Reason, "500")
+}
+
+func TestWebhooksNotifier_FullQueueShouldWriteInDeadLetterFile(t *testing.T) {
+	t.Parallel()
+
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+
+	args := createMockArgsWebhooksNotifier(t, server.URL)
+	args.Config.QueueSize = 1
+	args.Config.Rules = append(args.Config.Rules,
+		config.WebhookRuleConfig{Name: "watched-bob", Type: addressRuleType, Address: "bob", Endpoints: []string{"compliance"}},
+	)
+	wn, err := NewWebhooksNotifier(args)
+	require.Nil(t, err)
+
+	// 3 notifications: one is being delivered, one is queued and the last one does not fit in the queue
+	wn.Publish(createBlockData())
+	require.Eventually(t, func() bool {
+		return len(readDeadLetterEntries(t, args.Config.DeadLetterFile)) >= 1
+	}, time.Second, 10*time.Millisecond)
+
+	close(unblock)
+	require.Nil(t, wn.Close())
+
+	// nothing is lost: the notifications are either delivered or written in the dead letter file
+	entries := readDeadLetterEntries(t, args.Config.DeadLetterFile)
+	require.GreaterOrEqual(t, len(entries), 1)
+	require.Equal(t, "queue is full", entries[0].Reason)
+}
+
+func TestWebhooksNotifier_PublishAfterCloseShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	wn, err := NewWebhooksNotifier(createMockArgsWebhooksNotifier(t, "http://localhost"))
+	require.Nil(t, err)
+	require.Nil(t, wn.Close())
+	require.Nil(t, wn.Close())
+
+	wn.Publish(createBlockData())
+	wn.Publish(nil)
+}
+
+func TestWebhooksNotifier_CreateNotificationsShouldTagThePipeline(t *testing.T) {
+	t.Parallel()
+
+	wn, err := NewWebhooksNotifier(createMockArgsWebhooksNotifier(t, "http://localhost"))
+	require.Nil(t, err)
+	defer func() {
+		require.Nil(t, wn.Close())
+	}()
+
+	blockData := createBlockData()
+	blockData.Pipeline = "sovereign"
+	notifications := wn.createNotifications(blockData)
+	require.Len(t, notifications, 1)
+	require.Equal(t, "sovereign-watched-tx1", notifications[0].notification.ID)
+	require.Equal(t, "sovereign", notifications[0].notification.Pipeline)
+}
+
+func TestDisabledNotifier(t *testing.T) {
+	t.Parallel()
+
+	dn := NewDisabledNotifier()
+	require.False(t, dn.IsInterfaceNil())
+	dn.Publish(&data.IndexedBlockData{})
+	require.Nil(t, dn.Close())
+}
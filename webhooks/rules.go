@@ -0,0 +1,150 @@
+package webhooks
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+const (
+	transferRuleType = "transfer"
+	addressRuleType  = "address"
+	eventRuleType    = "event"
+
+	// rewaToken is the token name used in the transfer rules for the native token
+	rewaToken = "REWA"
+)
+
+type rule struct {
+	name       string
+	ruleType   string
+	address    string
+	token      string
+	minAmount  *big.Int
+	identifier string
+	contract   string
+	endpoints  []*endpoint
+}
+
+func newRule(ruleCfg config.WebhookRuleConfig, endpoints map[string]*endpoint) (*rule, error) {
+	r := &rule{
+		name:       ruleCfg.Name,
+		ruleType:   ruleCfg.Type,
+		address:    ruleCfg.Address,
+		token:      ruleCfg.Token,
+		minAmount:  big.NewInt(0),
+		identifier: ruleCfg.Identifier,
+		contract:   ruleCfg.Contract,
+	}
+	if r.name == "" {
+		return nil, fmt.Errorf("%w: empty name", ErrInvalidRule)
+	}
+
+	switch r.ruleType {
+	case transferRuleType:
+		isTokenIdentifier := strings.Contains(r.token, "-")
+		if r.token != rewaToken && !isTokenIdentifier {
+			return nil, fmt.Errorf("%w: invalid token for rule %s", ErrInvalidRule, r.name)
+		}
+		if ruleCfg.MinAmount != "" {
+			var ok bool
+			r.minAmount, ok = big.NewInt(0).SetString(ruleCfg.MinAmount, 10)
+			if !ok {
+				return nil, fmt.Errorf("%w: invalid min amount for rule %s", ErrInvalidRule, r.name)
+			}
+		}
+	case addressRuleType:
+		if r.address == "" {
+			return nil, fmt.Errorf("%w: empty address for rule %s", ErrInvalidRule, r.name)
+		}
+	case eventRuleType:
+		if r.identifier == "" {
+			return nil, fmt.Errorf("%w: empty identifier for rule %s", ErrInvalidRule, r.name)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s for rule %s", ErrInvalidRuleType, r.ruleType, r.name)
+	}
+
+	if len(ruleCfg.Endpoints) == 0 {
+		return nil, fmt.Errorf("%w: no endpoints for rule %s", ErrInvalidRule, r.name)
+	}
+	for _, endpointName := range ruleCfg.Endpoints {
+		e, found := endpoints[endpointName]
+		if !found {
+			return nil, fmt.Errorf("%w: %s for rule %s", ErrUnknownEndpoint, endpointName, r.name)
+		}
+		r.endpoints = append(r.endpoints, e)
+	}
+
+	return r, nil
+}
+
+// matchTransfer is used for both the transactions and the smart contract results, which carry the same transfer information
+func (r *rule) matchTransfer(sender, receiver string, receivers []string, value string, tokens []string, dcdtValues []string) bool {
+	switch r.ruleType {
+	case transferRuleType:
+		if r.token == rewaToken {
+			return r.isAmountOverMin(value)
+		}
+		for idx, token := range tokens {
+			if !r.isWatchedToken(token) || idx >= len(dcdtValues) {
+				continue
+			}
+			if r.isAmountOverMin(dcdtValues[idx]) {
+				return true
+			}
+		}
+		return false
+	case addressRuleType:
+		if sender == r.address || receiver == r.address {
+			return true
+		}
+		for _, addr := range receivers {
+			if addr == r.address {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func (r *rule) matchEvent(event *data.LogEvent) bool {
+	if r.ruleType != eventRuleType || event.Identifier != r.identifier {
+		return false
+	}
+
+	return r.contract == "" || event.Address == r.contract
+}
+
+// isWatchedToken returns true for the token itself and, for a collection, for all its NFTs
+func (r *rule) isWatchedToken(token string) bool {
+	if token == r.token {
+		return true
+	}
+
+	lastSeparatorIdx := strings.LastIndex(token, "-")
+	return lastSeparatorIdx > 0 && token[:lastSeparatorIdx] == r.token
+}
+
+func (r *rule) isAmountOverMin(value string) bool {
+	amount, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || amount.Sign() <= 0 {
+		return false
+	}
+
+	return amount.Cmp(r.minAmount) >= 0
+}
+
+// matchToken notifies the address rules about the tokens issued or owned by the watched address
+func (r *rule) matchToken(token *data.TokenInfo) bool {
+	if r.ruleType != addressRuleType {
+		return false
+	}
+
+	return token.Issuer == r.address || token.CurrentOwner == r.address
+}
@@ -0,0 +1,113 @@
+package webhooks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/config"
+	"github.com/TerraDharitri/drt-go-chain-es-indexer/data"
+)
+
+func createEndpoints() map[string]*endpoint {
+	return map[string]*endpoint{
+		"compliance": {name: "compliance", url: "http://localhost"},
+	}
+}
+
+func TestNewRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Type: addressRuleType, Address: "alice", Endpoints: []string{"compliance"}}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrInvalidRule))
+	})
+	t.Run("unknown type", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: "block", Endpoints: []string{"compliance"}}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrInvalidRuleType))
+	})
+	t.Run("transfer rule with invalid token", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: transferRuleType, Token: "TKN", Endpoints: []string{"compliance"}}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrInvalidRule))
+	})
+	t.Run("transfer rule with invalid min amount", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: transferRuleType, Token: rewaToken, MinAmount: "1.5", Endpoints: []string{"compliance"}}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrInvalidRule))
+	})
+	t.Run("address rule without address", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: addressRuleType, Endpoints: []string{"compliance"}}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrInvalidRule))
+	})
+	t.Run("event rule without identifier", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: eventRuleType, Endpoints: []string{"compliance"}}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrInvalidRule))
+	})
+	t.Run("no endpoints", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: addressRuleType, Address: "alice"}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrInvalidRule))
+	})
+	t.Run("unknown endpoint", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: addressRuleType, Address: "alice", Endpoints: []string{"other"}}, createEndpoints())
+		require.Nil(t, r)
+		require.True(t, errors.Is(err, ErrUnknownEndpoint))
+	})
+	t.Run("should work", func(t *testing.T) {
+		r, err := newRule(config.WebhookRuleConfig{Name: "r", Type: transferRuleType, Token: "TKN-abcd", MinAmount: "100", Endpoints: []string{"compliance"}}, createEndpoints())
+		require.Nil(t, err)
+		require.Equal(t, "100", r.minAmount.String())
+		require.Len(t, r.endpoints, 1)
+	})
+}
+
+func TestRule_MatchTransfer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("native token", func(t *testing.T) {
+		r, _ := newRule(config.WebhookRuleConfig{Name: "r", Type: transferRuleType, Token: rewaToken, MinAmount: "100", Endpoints: []string{"compliance"}}, createEndpoints())
+
+		require.False(t, r.matchTransfer("alice", "bob", nil, "99", nil, nil))
+		require.True(t, r.matchTransfer("alice", "bob", nil, "100", nil, nil))
+		require.False(t, r.matchTransfer("alice", "bob", nil, "", []string{"TKN-abcd"}, []string{"1000"}))
+	})
+	t.Run("dcdt token and collection", func(t *testing.T) {
+		r, _ := newRule(config.WebhookRuleConfig{Name: "r", Type: transferRuleType, Token: "NFT-abcd", Endpoints: []string{"compliance"}}, createEndpoints())
+
+		require.True(t, r.matchTransfer("alice", "bob", nil, "0", []string{"NFT-abcd"}, []string{"1"}))
+		require.True(t, r.matchTransfer("alice", "bob", nil, "0", []string{"OTHER-1234", "NFT-abcd-01"}, []string{"1", "1"}))
+		require.False(t, r.matchTransfer("alice", "bob", nil, "0", []string{"NFT-abcd-01"}, []string{"0"}))
+		require.False(t, r.matchTransfer("alice", "bob", nil, "0", []string{"NFT-abcd-01"}, nil))
+		require.False(t, r.matchTransfer("alice", "bob", nil, "1000", nil, nil))
+	})
+	t.Run("address", func(t *testing.T) {
+		r, _ := newRule(config.WebhookRuleConfig{Name: "r", Type: addressRuleType, Address: "carol", Endpoints: []string{"compliance"}}, createEndpoints())
+
+		require.True(t, r.matchTransfer("carol", "bob", nil, "0", nil, nil))
+		require.True(t, r.matchTransfer("alice", "carol", nil, "0", nil, nil))
+		require.True(t, r.matchTransfer("alice", "alice", []string{"bob", "carol"}, "0", nil, nil))
+		require.False(t, r.matchTransfer("alice", "bob", nil, "0", nil, nil))
+	})
+}
+
+func TestRule_MatchEventAndToken(t *testing.T) {
+	t.Parallel()
+
+	eventRule, _ := newRule(config.WebhookRuleConfig{Name: "r", Type: eventRuleType, Identifier: "swap", Contract: "pair", Endpoints: []string{"compliance"}}, createEndpoints())
+	require.True(t, eventRule.matchEvent(&data.LogEvent{Identifier: "swap", Address: "pair"}))
+	require.False(t, eventRule.matchEvent(&data.LogEvent{Identifier: "swap", Address: "other"}))
+	require.False(t, eventRule.matchEvent(&data.LogEvent{Identifier: "addLiquidity", Address: "pair"}))
+	require.False(t, eventRule.matchToken(&data.TokenInfo{Issuer: "pair"}))
+
+	addressRule, _ := newRule(config.WebhookRuleConfig{Name: "r", Type: addressRuleType, Address: "alice", Endpoints: []string{"compliance"}}, createEndpoints())
+	require.True(t, addressRule.matchToken(&data.TokenInfo{Issuer: "alice"}))
+	require.True(t, addressRule.matchToken(&data.TokenInfo{CurrentOwner: "alice"}))
+	require.False(t, addressRule.matchToken(&data.TokenInfo{Issuer: "bob"}))
+	require.False(t, addressRule.matchEvent(&data.LogEvent{Identifier: "swap", Address: "alice"}))
+}